@@ -0,0 +1,45 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	server "github.com/inference-gateway/adk/server"
+	types "github.com/inference-gateway/adk/types"
+
+	tools "github.com/inference-gateway/google-calendar-agent/tools"
+)
+
+// agentCardFile is the agent card served at /.well-known/agent-card.json.
+const agentCardFile = ".well-known/agent-card.json"
+
+// agentCardSkills returns the skills the agent card advertises: the ones
+// declared in the card file, followed by one per calendar tool tb offers.
+// Tools switched off through SKILL_*_ENABLED or left out of
+// LLM_ENABLED_TOOLS are not in tb, so the card does not list them.
+func agentCardSkills(cardPath string, tb server.ToolBox) ([]types.AgentSkill, error) {
+	data, err := os.ReadFile(cardPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read agent card: %w", err)
+	}
+	var card types.AgentCard
+	if err := json.Unmarshal(data, &card); err != nil {
+		return nil, fmt.Errorf("failed to parse agent card: %w", err)
+	}
+
+	skills := card.Skills
+	for _, name := range tools.CalendarToolNames(tb) {
+		tool, ok := tb.GetTool(name)
+		if !ok {
+			continue
+		}
+		skills = append(skills, types.AgentSkill{
+			ID:          name,
+			Name:        name,
+			Description: tool.GetDescription(),
+			Tags:        []string{"calendar"},
+		})
+	}
+	return skills, nil
+}
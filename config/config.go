@@ -5,6 +5,8 @@
 package config
 
 import (
+	"time"
+
 	serverConfig "github.com/inference-gateway/adk/server/config"
 )
 
@@ -14,19 +16,105 @@ type Config struct {
 	A2A serverConfig.Config `env:",prefix=A2A_"`
 
 	// Custom configuration sections
+	CircuitBreaker CircuitBreakerConfig `env:",prefix=CIRCUIT_BREAKER_"`
 	Google         GoogleConfig         `env:",prefix=GOOGLE_"`
 	GoogleCalendar GoogleCalendarConfig `env:",prefix=GOOGLE_CALENDAR_"`
+	LLM            LLMConfig            `env:",prefix=LLM_"`
+	Log            LogConfig            `env:",prefix=LOG_"`
+	Notifier       NotifierConfig       `env:",prefix=NOTIFIER_"`
+	RateLimit      RateLimitConfig      `env:",prefix=RATE_LIMIT_"`
+	Server         ServerConfig         `env:",prefix=SERVER_"`
+	Skill          SkillConfig          `env:",prefix=SKILL_"`
+	SystemPrompt   SystemPromptConfig   `env:",prefix=SYSTEM_PROMPT_"`
+}
+
+// CircuitBreakerConfig represents the circuitBreaker configuration
+type CircuitBreakerConfig struct {
+	Cooldown         time.Duration `env:"COOLDOWN,default=30s"`
+	FailureThreshold int           `env:"FAILURE_THRESHOLD,default=5"`
 }
 
 // GoogleConfig represents the google configuration
 type GoogleConfig struct {
-	CredentialsPath    string `env:"CREDENTIALS_PATH"`
-	ServiceAccountJSON string `env:"SERVICE_ACCOUNT_JSON"`
+	CredentialsPath         string        `env:"CREDENTIALS_PATH"`
+	IdleConnTimeout         time.Duration `env:"IDLE_CONN_TIMEOUT,default=90s"`
+	ImpersonateSubject      string        `env:"IMPERSONATE_SUBJECT"`
+	MaxIdleConns            int           `env:"MAX_IDLE_CONNS,default=100"`
+	MaxIdleConnsPerHost     int           `env:"MAX_IDLE_CONNS_PER_HOST,default=10"`
+	OperationTimeout        time.Duration `env:"OPERATION_TIMEOUT,default=30s"`
+	RequireValidCredentials bool          `env:"REQUIRE_VALID_CREDENTIALS,default=false"`
+	ServiceAccountJSON      string        `env:"SERVICE_ACCOUNT_JSON"`
 }
 
 // GoogleCalendarConfig represents the googleCalendar configuration
 type GoogleCalendarConfig struct {
-	ID       string `env:"ID,default=primary"`
-	MockMode bool   `env:"MOCK_MODE,default=false"`
-	Timezone string `env:"TIMEZONE,default=UTC"`
+	AfternoonHours           string   `env:"AFTERNOON_HOURS,default=12:00-17:00"`
+	AutoCheckConflicts       bool     `env:"AUTO_CHECK_CONFLICTS,default=true"`
+	BatchConcurrency         int      `env:"BATCH_CONCURRENCY,default=4"`
+	DateFormat               string   `env:"DATE_FORMAT"`
+	DefaultAttendees         []string `env:"DEFAULT_ATTENDEES"`
+	DefaultReminderMinutes   int      `env:"DEFAULT_REMINDER_MINUTES,default=0"`
+	DescriptionTemplatesFile string   `env:"DESCRIPTION_TEMPLATES_FILE"`
+	EveningHours             string   `env:"EVENING_HOURS,default=17:00-21:00"`
+	EventTitlePrefix         string   `env:"EVENT_TITLE_PREFIX"`
+	EventTitleSuffix         string   `env:"EVENT_TITLE_SUFFIX"`
+	ID                       string   `env:"ID,default=primary"`
+	InvalidAttendees         string   `env:"INVALID_ATTENDEES,default=reject"`
+	Locale                   string   `env:"LOCALE,default=en"`
+	LongEventHours           int      `env:"LONG_EVENT_HOURS,default=4"`
+	MaxAttendees             int      `env:"MAX_ATTENDEES,default=50"`
+	MaxEventsInResponse      int      `env:"MAX_EVENTS_IN_RESPONSE,default=100"`
+	MinTravelMinutes         int      `env:"MIN_TRAVEL_MINUTES,default=15"`
+	MockMode                 bool     `env:"MOCK_MODE,default=false"`
+	MorningHours             string   `env:"MORNING_HOURS,default=08:00-12:00"`
+	Timezone                 string   `env:"TIMEZONE,default=UTC"`
+	VerifyWrites             bool     `env:"VERIFY_WRITES,default=false"`
+	WeekStart                string   `env:"WEEK_START,default=monday"`
+	WorkingHoursEnd          string   `env:"WORKING_HOURS_END,default=17:00"`
+	WorkingHoursStart        string   `env:"WORKING_HOURS_START,default=09:00"`
+}
+
+// LLMConfig represents the llm configuration
+type LLMConfig struct {
+	EnabledTools  []string `env:"ENABLED_TOOLS"`
+	FallbackModel string   `env:"FALLBACK_MODEL"`
+}
+
+// LogConfig represents the log configuration
+type LogConfig struct {
+	RedactPII bool `env:"REDACT_PII,default=false"`
+}
+
+// NotifierConfig represents the notifier configuration
+type NotifierConfig struct {
+	PollInterval     time.Duration `env:"POLL_INTERVAL,default=1m"`
+	ReminderLeadTime time.Duration `env:"REMINDER_LEAD_TIME,default=10m"`
+	WebhookURL       string        `env:"WEBHOOK_URL"`
+}
+
+// RateLimitConfig represents the rateLimit configuration
+type RateLimitConfig struct {
+	Burst int     `env:"BURST,default=10"`
+	RPS   float64 `env:"RPS,default=0"`
+}
+
+// ServerConfig represents the server configuration
+type ServerConfig struct {
+	ShutdownTimeout time.Duration `env:"SHUTDOWN_TIMEOUT,default=120s"`
+}
+
+// SkillConfig represents the skill configuration
+type SkillConfig struct {
+	CreateEnabled bool `env:"CREATE_ENABLED,default=true"`
+	DeleteEnabled bool `env:"DELETE_ENABLED,default=true"`
+	UpdateEnabled bool `env:"UPDATE_ENABLED,default=true"`
+}
+
+// SystemPromptConfig represents the systemPrompt configuration
+type SystemPromptConfig struct {
+	File             string `env:"FILE"`
+	HelpText         string `env:"HELP_TEXT"`
+	Mode             string `env:"MODE,default=append"`
+	ResponseLanguage string `env:"RESPONSE_LANGUAGE"`
+	Text             string `env:"TEXT"`
 }
@@ -0,0 +1,329 @@
+package google
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+
+	zap "go.uber.org/zap"
+	calendar "google.golang.org/api/calendar/v3"
+	googleapi "google.golang.org/api/googleapi"
+)
+
+// ErrCircuitOpen is returned without calling Google while the breaker is open.
+var ErrCircuitOpen = errors.New("calendar service temporarily unavailable")
+
+type breakerState int
+
+const (
+	breakerClosed breakerState = iota
+	breakerOpen
+	breakerHalfOpen
+)
+
+func (s breakerState) String() string {
+	switch s {
+	case breakerOpen:
+		return "open"
+	case breakerHalfOpen:
+		return "half-open"
+	default:
+		return "closed"
+	}
+}
+
+// CircuitBreaker wraps a CalendarService and fast-fails calls after a run of
+// consecutive outage errors. Once the cooldown has elapsed a single trial
+// call is let through: success closes the breaker, failure re-opens it.
+type CircuitBreaker struct {
+	next      CalendarService
+	logger    *zap.Logger
+	threshold int
+	cooldown  time.Duration
+	now       func() time.Time
+
+	mu       sync.Mutex
+	state    breakerState
+	failures int
+	openedAt time.Time
+	probing  bool
+}
+
+// NewCircuitBreaker wraps next with a breaker that opens after threshold
+// consecutive failures and half-opens after cooldown. A threshold of zero or
+// less disables the breaker and returns next unchanged.
+func NewCircuitBreaker(logger *zap.Logger, next CalendarService, threshold int, cooldown time.Duration) CalendarService {
+	if threshold <= 0 {
+		return next
+	}
+	return &CircuitBreaker{
+		next:      next,
+		logger:    logger,
+		threshold: threshold,
+		cooldown:  cooldown,
+		now:       time.Now,
+	}
+}
+
+// allow reports whether a call may proceed, moving an open breaker to
+// half-open once the cooldown has elapsed.
+func (b *CircuitBreaker) allow() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch b.state {
+	case breakerOpen:
+		if b.now().Sub(b.openedAt) < b.cooldown {
+			return ErrCircuitOpen
+		}
+		b.transition(breakerHalfOpen)
+		b.probing = true
+		return nil
+	case breakerHalfOpen:
+		if b.probing {
+			return ErrCircuitOpen
+		}
+		b.probing = true
+		return nil
+	default:
+		return nil
+	}
+}
+
+// record updates the breaker with the outcome of a call that was allowed.
+// Only a successful call closes a half-open breaker: a trial that was
+// cancelled or had its credentials rejected says nothing about whether
+// Google recovered, so the breaker stays half-open for the next call.
+func (b *CircuitBreaker) record(err error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.probing = false
+	if !isOutage(err) {
+		if err != nil && b.state == breakerHalfOpen {
+			return
+		}
+		b.failures = 0
+		if b.state != breakerClosed {
+			b.transition(breakerClosed)
+		}
+		return
+	}
+
+	b.failures++
+	if b.state == breakerHalfOpen || b.failures >= b.threshold {
+		b.openedAt = b.now()
+		if b.state != breakerOpen {
+			b.transition(breakerOpen)
+		}
+	}
+}
+
+// recordCacheable is record for calls the wrapped service may answer from
+// its cache. Their successes may never have reached Google, so only their
+// failures are counted.
+func (b *CircuitBreaker) recordCacheable(err error) {
+	if err != nil {
+		b.record(err)
+		return
+	}
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.probing = false
+}
+
+func (b *CircuitBreaker) transition(to breakerState) {
+	b.logger.Warn("calendar circuit breaker state changed",
+		zap.String("component", "google-calendar-service"),
+		zap.String("from", b.state.String()),
+		zap.String("to", to.String()),
+		zap.Int("failures", b.failures))
+	b.state = to
+}
+
+// isOutage reports whether err points at Google being unavailable rather
+// than at the request itself, so that a missing event or a bad argument does
+// not trip the breaker. Calls the caller cancelled say nothing about Google,
+// and rejected credentials are a configuration problem that reports itself.
+func isOutage(err error) bool {
+	if err == nil || errors.Is(err, context.Canceled) || IsAuthError(err) {
+		return false
+	}
+	var apiErr *googleapi.Error
+	if errors.As(err, &apiErr) {
+		return apiErr.Code >= http.StatusInternalServerError || apiErr.Code == http.StatusTooManyRequests
+	}
+	return true
+}
+
+// ListEvents implements CalendarService
+func (b *CircuitBreaker) ListEvents(ctx context.Context, calendarID string, timeMin, timeMax time.Time) ([]*calendar.Event, error) {
+	if err := b.allow(); err != nil {
+		return nil, err
+	}
+	events, err := b.next.ListEvents(ctx, calendarID, timeMin, timeMax)
+	b.record(err)
+	return events, err
+}
+
+// ListEventsByProperty implements CalendarService
+func (b *CircuitBreaker) ListEventsByProperty(ctx context.Context, calendarID, property string, shared bool, timeMin, timeMax time.Time) ([]*calendar.Event, error) {
+	if err := b.allow(); err != nil {
+		return nil, err
+	}
+	events, err := b.next.ListEventsByProperty(ctx, calendarID, property, shared, timeMin, timeMax)
+	b.record(err)
+	return events, err
+}
+
+// ListEventsPage implements CalendarService
+func (b *CircuitBreaker) ListEventsPage(ctx context.Context, calendarID string, timeMin, timeMax time.Time, pageSize int64, pageToken string) ([]*calendar.Event, string, error) {
+	if err := b.allow(); err != nil {
+		return nil, "", err
+	}
+	events, next, err := b.next.ListEventsPage(ctx, calendarID, timeMin, timeMax, pageSize, pageToken)
+	b.record(err)
+	return events, next, err
+}
+
+// ListEventsByType implements CalendarService
+func (b *CircuitBreaker) ListEventsByType(ctx context.Context, calendarID string, eventTypes []string, timeMin, timeMax time.Time) ([]*calendar.Event, error) {
+	if err := b.allow(); err != nil {
+		return nil, err
+	}
+	events, err := b.next.ListEventsByType(ctx, calendarID, eventTypes, timeMin, timeMax)
+	b.record(err)
+	return events, err
+}
+
+// ListRecurringSeries implements CalendarService
+func (b *CircuitBreaker) ListRecurringSeries(ctx context.Context, calendarID string, timeMin, timeMax time.Time) ([]*calendar.Event, error) {
+	if err := b.allow(); err != nil {
+		return nil, err
+	}
+	series, err := b.next.ListRecurringSeries(ctx, calendarID, timeMin, timeMax)
+	b.record(err)
+	return series, err
+}
+
+// CreateEvent implements CalendarService
+func (b *CircuitBreaker) CreateEvent(ctx context.Context, calendarID string, event *calendar.Event) (*calendar.Event, error) {
+	if err := b.allow(); err != nil {
+		return nil, err
+	}
+	created, err := b.next.CreateEvent(ctx, calendarID, event)
+	b.record(err)
+	return created, err
+}
+
+// UpdateEvent implements CalendarService
+func (b *CircuitBreaker) UpdateEvent(ctx context.Context, calendarID, eventID string, event *calendar.Event) (*calendar.Event, error) {
+	if err := b.allow(); err != nil {
+		return nil, err
+	}
+	updated, err := b.next.UpdateEvent(ctx, calendarID, eventID, event)
+	b.record(err)
+	return updated, err
+}
+
+// PatchEvent implements CalendarService
+func (b *CircuitBreaker) PatchEvent(ctx context.Context, calendarID, eventID string, event *calendar.Event) (*calendar.Event, error) {
+	if err := b.allow(); err != nil {
+		return nil, err
+	}
+	patched, err := b.next.PatchEvent(ctx, calendarID, eventID, event)
+	b.record(err)
+	return patched, err
+}
+
+// DeleteEvent implements CalendarService
+func (b *CircuitBreaker) DeleteEvent(ctx context.Context, calendarID, eventID string) error {
+	if err := b.allow(); err != nil {
+		return err
+	}
+	err := b.next.DeleteEvent(ctx, calendarID, eventID)
+	b.record(err)
+	return err
+}
+
+// GetEvent implements CalendarService
+func (b *CircuitBreaker) GetEvent(ctx context.Context, calendarID, eventID string) (*calendar.Event, error) {
+	if err := b.allow(); err != nil {
+		return nil, err
+	}
+	event, err := b.next.GetEvent(ctx, calendarID, eventID)
+	b.record(err)
+	return event, err
+}
+
+// ListCalendars implements CalendarService
+func (b *CircuitBreaker) ListCalendars(ctx context.Context) ([]*calendar.CalendarListEntry, error) {
+	if err := b.allow(); err != nil {
+		return nil, err
+	}
+	calendars, err := b.next.ListCalendars(ctx)
+	b.record(err)
+	return calendars, err
+}
+
+// CheckConflicts implements CalendarService
+func (b *CircuitBreaker) CheckConflicts(ctx context.Context, calendarID string, startTime, endTime time.Time) ([]*calendar.Event, error) {
+	if err := b.allow(); err != nil {
+		return nil, err
+	}
+	conflicts, err := b.next.CheckConflicts(ctx, calendarID, startTime, endTime)
+	b.record(err)
+	return conflicts, err
+}
+
+// QueryFreeBusy implements CalendarService
+func (b *CircuitBreaker) QueryFreeBusy(ctx context.Context, calendarIDs []string, timeMin, timeMax time.Time) (map[string]calendar.FreeBusyCalendar, error) {
+	if err := b.allow(); err != nil {
+		return nil, err
+	}
+	calendars, err := b.next.QueryFreeBusy(ctx, calendarIDs, timeMin, timeMax)
+	b.record(err)
+	return calendars, err
+}
+
+// GetColors implements CalendarService
+func (b *CircuitBreaker) GetColors(ctx context.Context) (*calendar.Colors, error) {
+	if err := b.allow(); err != nil {
+		return nil, err
+	}
+	colors, err := b.next.GetColors(ctx)
+	b.recordCacheable(err)
+	return colors, err
+}
+
+// GetCalendar implements CalendarService
+func (b *CircuitBreaker) GetCalendar(ctx context.Context, calendarID string) (*calendar.Calendar, error) {
+	if err := b.allow(); err != nil {
+		return nil, err
+	}
+	cal, err := b.next.GetCalendar(ctx, calendarID)
+	b.recordCacheable(err)
+	return cal, err
+}
+
+// GetCalendarSettings implements CalendarService
+func (b *CircuitBreaker) GetCalendarSettings(ctx context.Context) (string, string, error) {
+	if err := b.allow(); err != nil {
+		return "", "", err
+	}
+	timezone, weekStart, err := b.next.GetCalendarSettings(ctx)
+	b.record(err)
+	return timezone, weekStart, err
+}
+
+// GetCalendarID implements CalendarService
+func (b *CircuitBreaker) GetCalendarID() string {
+	return b.next.GetCalendarID()
+}
+
+// SetCalendarID implements CalendarService
+func (b *CircuitBreaker) SetCalendarID(calendarID string) {
+	b.next.SetCalendarID(calendarID)
+}
@@ -0,0 +1,205 @@
+package google
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	zap "go.uber.org/zap"
+	oauth2 "golang.org/x/oauth2"
+	googleapi "google.golang.org/api/googleapi"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	outage := fmt.Errorf("unable to get calendar: %w", &googleapi.Error{Code: http.StatusServiceUnavailable})
+	notFound := fmt.Errorf("unable to get calendar: %w", &googleapi.Error{Code: http.StatusNotFound})
+
+	svc := &probeCalendarService{}
+	now := time.Date(2026, 5, 23, 10, 0, 0, 0, time.UTC)
+	b := NewCircuitBreaker(zap.NewNop(), svc, 3, 30*time.Second).(*CircuitBreaker)
+	b.now = func() time.Time { return now }
+
+	call := func() error {
+		_, err := b.GetEvent(context.Background(), "primary", "evt-1")
+		return err
+	}
+
+	// Client errors never trip the breaker.
+	svc.err = notFound
+	for i := 0; i < 5; i++ {
+		if err := call(); !errors.Is(err, notFound) {
+			t.Fatalf("call %d: error = %v, want not found", i, err)
+		}
+	}
+	if b.state != breakerClosed {
+		t.Fatalf("state after client errors = %s, want closed", b.state)
+	}
+
+	// Neither do calls the caller cancelled.
+	svc.err = fmt.Errorf("unable to get calendar: %w", context.Canceled)
+	for i := 0; i < 5; i++ {
+		if err := call(); !errors.Is(err, context.Canceled) {
+			t.Fatalf("call %d: error = %v, want canceled", i, err)
+		}
+	}
+	if b.state != breakerClosed {
+		t.Fatalf("state after cancelled calls = %s, want closed", b.state)
+	}
+
+	// Nor do rejected credentials, which would otherwise be reported as
+	// Google being unreachable.
+	authFailures := []error{
+		&url.Error{Op: "Get", URL: "https://www.googleapis.com", Err: &oauth2.RetrieveError{Response: &http.Response{StatusCode: http.StatusBadRequest}}},
+		fmt.Errorf("unable to get calendar: %w", &googleapi.Error{Code: http.StatusUnauthorized}),
+	}
+	for _, authErr := range authFailures {
+		svc.err = authErr
+		for i := 0; i < 5; i++ {
+			if err := call(); errors.Is(err, ErrCircuitOpen) {
+				t.Fatalf("call %d fast-failed on a credentials error", i)
+			}
+		}
+	}
+	if b.state != breakerClosed {
+		t.Fatalf("state after credentials errors = %s, want closed", b.state)
+	}
+
+	// Consecutive outages open it.
+	svc.err = outage
+	for i := 0; i < 3; i++ {
+		if err := call(); errors.Is(err, ErrCircuitOpen) {
+			t.Fatalf("call %d fast-failed before reaching the threshold", i)
+		}
+	}
+	if b.state != breakerOpen {
+		t.Fatalf("state after %d outages = %s, want open", 3, b.state)
+	}
+
+	// While open, calls fail fast without reaching Google.
+	probes := svc.probes
+	if err := call(); !errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("open breaker error = %v, want %v", err, ErrCircuitOpen)
+	}
+	if svc.probes != probes {
+		t.Fatalf("open breaker called the service")
+	}
+
+	// After the cooldown a failed trial re-opens it.
+	now = now.Add(30 * time.Second)
+	if err := call(); errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("half-open trial was not let through")
+	}
+	if b.state != breakerOpen {
+		t.Fatalf("state after failed trial = %s, want open", b.state)
+	}
+	if err := call(); !errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("re-opened breaker error = %v, want %v", err, ErrCircuitOpen)
+	}
+
+	// A successful trial closes it again.
+	now = now.Add(30 * time.Second)
+	svc.err = nil
+	if err := call(); err != nil {
+		t.Fatalf("half-open trial error = %v", err)
+	}
+	if b.state != breakerClosed {
+		t.Fatalf("state after successful trial = %s, want closed", b.state)
+	}
+	if err := call(); err != nil {
+		t.Fatalf("closed breaker error = %v", err)
+	}
+}
+
+func TestCircuitBreakerHalfOpenAllowsSingleTrial(t *testing.T) {
+	now := time.Date(2026, 5, 23, 10, 0, 0, 0, time.UTC)
+	b := NewCircuitBreaker(zap.NewNop(), &probeCalendarService{}, 1, time.Minute).(*CircuitBreaker)
+	b.now = func() time.Time { return now }
+	b.state = breakerOpen
+	b.openedAt = now.Add(-time.Minute)
+
+	if err := b.allow(); err != nil {
+		t.Fatalf("first call after cooldown = %v, want trial", err)
+	}
+	if b.state != breakerHalfOpen {
+		t.Fatalf("state = %s, want half-open", b.state)
+	}
+	if err := b.allow(); !errors.Is(err, ErrCircuitOpen) {
+		t.Fatalf("second concurrent call = %v, want %v", err, ErrCircuitOpen)
+	}
+}
+
+func TestCircuitBreakerHalfOpenClosesOnlyOnSuccess(t *testing.T) {
+	tests := []struct {
+		name      string
+		err       error
+		call      func(b *CircuitBreaker) error
+		wantState breakerState
+	}{
+		{
+			name: "cancelled trial",
+			err:  fmt.Errorf("unable to get event: %w", context.Canceled),
+			call: func(b *CircuitBreaker) error {
+				_, err := b.GetEvent(context.Background(), "primary", "evt-1")
+				return err
+			},
+			wantState: breakerHalfOpen,
+		},
+		{
+			name: "trial with rejected credentials",
+			err:  fmt.Errorf("unable to get event: %w", &googleapi.Error{Code: http.StatusUnauthorized}),
+			call: func(b *CircuitBreaker) error {
+				_, err := b.GetEvent(context.Background(), "primary", "evt-1")
+				return err
+			},
+			wantState: breakerHalfOpen,
+		},
+		{
+			name: "trial the service may answer from its cache",
+			call: func(b *CircuitBreaker) error {
+				_, err := b.GetCalendar(context.Background(), "primary")
+				return err
+			},
+			wantState: breakerHalfOpen,
+		},
+		{
+			name: "successful trial",
+			call: func(b *CircuitBreaker) error {
+				_, err := b.GetEvent(context.Background(), "primary", "evt-1")
+				return err
+			},
+			wantState: breakerClosed,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			now := time.Date(2026, 5, 23, 10, 0, 0, 0, time.UTC)
+			svc := &probeCalendarService{err: tc.err}
+			b := NewCircuitBreaker(zap.NewNop(), svc, 1, time.Minute).(*CircuitBreaker)
+			b.now = func() time.Time { return now }
+			b.state = breakerOpen
+			b.openedAt = now.Add(-time.Minute)
+
+			if err := tc.call(b); errors.Is(err, ErrCircuitOpen) {
+				t.Fatalf("trial was not let through")
+			}
+			if b.state != tc.wantState {
+				t.Errorf("state after trial = %s, want %s", b.state, tc.wantState)
+			}
+			if err := b.allow(); err != nil {
+				t.Errorf("call after the trial = %v, want it let through", err)
+			}
+		})
+	}
+}
+
+func TestNewCircuitBreakerDisabled(t *testing.T) {
+	svc := &probeCalendarService{}
+	if got := NewCircuitBreaker(zap.NewNop(), svc, 0, time.Minute); got != CalendarService(svc) {
+		t.Errorf("threshold 0 should return the service unwrapped, got %T", got)
+	}
+}
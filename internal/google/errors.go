@@ -0,0 +1,73 @@
+package google
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+
+	auth "cloud.google.com/go/auth"
+	oauth2 "golang.org/x/oauth2"
+	googleapi "google.golang.org/api/googleapi"
+)
+
+// IsAuthError reports whether err means Google refused the agent's
+// credentials: a token exchange that was rejected, or a 401 or 403 from the
+// Calendar API that is not a rate limit.
+func IsAuthError(err error) bool {
+	if err == nil {
+		return false
+	}
+	var authErr *auth.Error
+	if errors.As(err, &authErr) && authErr.Response != nil {
+		return isAuthStatus(authErr.Response.StatusCode)
+	}
+	var retrieveErr *oauth2.RetrieveError
+	if errors.As(err, &retrieveErr) && retrieveErr.Response != nil {
+		return isAuthStatus(retrieveErr.Response.StatusCode)
+	}
+	var apiErr *googleapi.Error
+	if errors.As(err, &apiErr) {
+		return (apiErr.Code == http.StatusUnauthorized || apiErr.Code == http.StatusForbidden) && !isRateLimited(apiErr)
+	}
+	return false
+}
+
+// IsUnavailable reports whether err means Google Calendar could not be
+// reached or failed on its side: network errors, 5xx and 429 responses,
+// rate-limit 403s, and calls refused by an open circuit breaker. Errors
+// about the request itself, such as a missing event, are not included.
+func IsUnavailable(err error) bool {
+	if err == nil || errors.Is(err, context.Canceled) || IsAuthError(err) {
+		return false
+	}
+	if errors.Is(err, ErrCircuitOpen) {
+		return true
+	}
+	var apiErr *googleapi.Error
+	if errors.As(err, &apiErr) {
+		return apiErr.Code >= http.StatusInternalServerError || apiErr.Code == http.StatusTooManyRequests || isRateLimited(apiErr)
+	}
+	var netErr net.Error
+	return errors.As(err, &netErr)
+}
+
+// isAuthStatus reports whether a token endpoint status rejects the
+// credentials; invalid_grant and invalid_client come back as 400.
+func isAuthStatus(code int) bool {
+	return code == http.StatusBadRequest || code == http.StatusUnauthorized || code == http.StatusForbidden
+}
+
+// isRateLimited reports whether a 403 carries one of the quota reasons the
+// Calendar API uses instead of 429.
+func isRateLimited(apiErr *googleapi.Error) bool {
+	if apiErr.Code != http.StatusForbidden {
+		return false
+	}
+	for _, item := range apiErr.Errors {
+		if item.Reason == "rateLimitExceeded" || item.Reason == "userRateLimitExceeded" {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,50 @@
+package google
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"testing"
+
+	oauth2 "golang.org/x/oauth2"
+	googleapi "google.golang.org/api/googleapi"
+)
+
+func TestClassifyServiceErrors(t *testing.T) {
+	dialErr := &url.Error{Op: "Get", URL: "https://www.googleapis.com/calendar/v3", Err: &net.OpError{Op: "dial", Net: "tcp", Err: errors.New("connection refused")}}
+
+	tests := []struct {
+		name            string
+		err             error
+		wantAuth        bool
+		wantUnavailable bool
+	}{
+		{name: "nil"},
+		{name: "connection refused", err: fmt.Errorf("failed to list events: %w", dialErr), wantUnavailable: true},
+		{name: "server error", err: &googleapi.Error{Code: http.StatusServiceUnavailable}, wantUnavailable: true},
+		{name: "too many requests", err: &googleapi.Error{Code: http.StatusTooManyRequests}, wantUnavailable: true},
+		{name: "rate-limit 403", err: &googleapi.Error{Code: http.StatusForbidden, Errors: []googleapi.ErrorItem{{Reason: "rateLimitExceeded"}}}, wantUnavailable: true},
+		{name: "open circuit breaker", err: fmt.Errorf("failed to list events: %w", ErrCircuitOpen), wantUnavailable: true},
+		{name: "unauthorized", err: &googleapi.Error{Code: http.StatusUnauthorized}, wantAuth: true},
+		{name: "calendar not shared", err: fmt.Errorf("failed to get event: %w", &googleapi.Error{Code: http.StatusForbidden}), wantAuth: true},
+		{name: "rejected token exchange", err: &url.Error{Op: "Get", URL: "https://www.googleapis.com", Err: &oauth2.RetrieveError{Response: &http.Response{StatusCode: http.StatusBadRequest}}}, wantAuth: true},
+		{name: "missing event", err: &googleapi.Error{Code: http.StatusNotFound}},
+		{name: "bad request", err: &googleapi.Error{Code: http.StatusBadRequest}},
+		{name: "argument error", err: errors.New("timeMin must be a string, got float64")},
+		{name: "canceled request", err: &url.Error{Op: "Get", URL: "https://www.googleapis.com", Err: context.Canceled}},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := IsAuthError(tc.err); got != tc.wantAuth {
+				t.Errorf("IsAuthError = %v, want %v", got, tc.wantAuth)
+			}
+			if got := IsUnavailable(tc.err); got != tc.wantUnavailable {
+				t.Errorf("IsUnavailable = %v, want %v", got, tc.wantUnavailable)
+			}
+		})
+	}
+}
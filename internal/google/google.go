@@ -7,25 +7,43 @@ package google
 import (
 	"context"
 	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sync"
 	"time"
 
 	config "github.com/inference-gateway/google-calendar-agent/config"
 	zap "go.uber.org/zap"
+	googleoauth "golang.org/x/oauth2/google"
+	jwt "golang.org/x/oauth2/jwt"
 	calendar "google.golang.org/api/calendar/v3"
+	googleapi "google.golang.org/api/googleapi"
 	option "google.golang.org/api/option"
+	htransport "google.golang.org/api/transport/http"
 )
 
 // CalendarService represents the google dependency interface
 // Google Calendar API service for managing calendar events
 type CalendarService interface {
-	ListEvents(calendarID string, timeMin, timeMax time.Time) ([]*calendar.Event, error)
-	CreateEvent(calendarID string, event *calendar.Event) (*calendar.Event, error)
-	UpdateEvent(calendarID, eventID string, event *calendar.Event) (*calendar.Event, error)
-	DeleteEvent(calendarID, eventID string) error
-	GetEvent(calendarID, eventID string) (*calendar.Event, error)
-	ListCalendars() ([]*calendar.CalendarListEntry, error)
-	CheckConflicts(calendarID string, startTime, endTime time.Time) ([]*calendar.Event, error)
+	ListEvents(ctx context.Context, calendarID string, timeMin, timeMax time.Time) ([]*calendar.Event, error)
+	ListEventsByProperty(ctx context.Context, calendarID, property string, shared bool, timeMin, timeMax time.Time) ([]*calendar.Event, error)
+	ListEventsByType(ctx context.Context, calendarID string, eventTypes []string, timeMin, timeMax time.Time) ([]*calendar.Event, error)
+	ListEventsPage(ctx context.Context, calendarID string, timeMin, timeMax time.Time, pageSize int64, pageToken string) ([]*calendar.Event, string, error)
+	ListRecurringSeries(ctx context.Context, calendarID string, timeMin, timeMax time.Time) ([]*calendar.Event, error)
+	CreateEvent(ctx context.Context, calendarID string, event *calendar.Event) (*calendar.Event, error)
+	UpdateEvent(ctx context.Context, calendarID, eventID string, event *calendar.Event) (*calendar.Event, error)
+	PatchEvent(ctx context.Context, calendarID, eventID string, event *calendar.Event) (*calendar.Event, error)
+	DeleteEvent(ctx context.Context, calendarID, eventID string) error
+	GetEvent(ctx context.Context, calendarID, eventID string) (*calendar.Event, error)
+	ListCalendars(ctx context.Context) ([]*calendar.CalendarListEntry, error)
+	CheckConflicts(ctx context.Context, calendarID string, startTime, endTime time.Time) ([]*calendar.Event, error)
+	QueryFreeBusy(ctx context.Context, calendarIDs []string, timeMin, timeMax time.Time) (map[string]calendar.FreeBusyCalendar, error)
+	GetColors(ctx context.Context) (*calendar.Colors, error)
+	GetCalendar(ctx context.Context, calendarID string) (*calendar.Calendar, error)
+	GetCalendarSettings(ctx context.Context) (timezone string, weekStart string, err error)
 	GetCalendarID() string
+	SetCalendarID(calendarID string)
 }
 
 // NewServiceFactory creates a new instance of CalendarService
@@ -35,11 +53,38 @@ func NewServiceFactory(logger *zap.Logger, cfg *config.Config) (CalendarService,
 	// Check if we should use mock mode based on config
 	if shouldUseMockMode(cfg) {
 		logger.Info("Creating mock Google Calendar service")
-		return NewMockCalendarService(logger, cfg), nil
+		svc := NewInMemoryCalendarService(logger, cfg)
+		svc.SeedDemoEvents(time.Now())
+		return svc, nil
 	}
 
 	logger.Info("Creating real Google Calendar service")
-	return createRealCalendarService(context.Background(), logger, cfg)
+	ctx := context.Background()
+	svc, err := createRealCalendarService(ctx, logger, cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := verifyCredentials(ctx, logger, svc, cfg.Google.RequireValidCredentials); err != nil {
+		return nil, err
+	}
+	return NewCircuitBreaker(logger, svc, cfg.CircuitBreaker.FailureThreshold, cfg.CircuitBreaker.Cooldown), nil
+}
+
+// verifyCredentials probes the configured calendar when require is set so
+// that revoked or unshared credentials abort startup instead of failing on
+// the first tool call. Without require the probe is skipped.
+func verifyCredentials(ctx context.Context, logger *zap.Logger, svc CalendarService, require bool) error {
+	if !require {
+		return nil
+	}
+
+	calendarID := svc.GetCalendarID()
+	if _, err := svc.GetCalendar(ctx, calendarID); err != nil {
+		return fmt.Errorf("google credentials cannot access calendar %q: %w", calendarID, err)
+	}
+	logger.Info("verified Google credentials", zap.String("calendarID", calendarID))
+	return nil
 }
 
 // shouldUseMockMode determines if mock mode should be used based on config
@@ -49,25 +94,36 @@ func shouldUseMockMode(cfg *config.Config) bool {
 
 // createRealCalendarService creates a real Google Calendar service using config
 func createRealCalendarService(ctx context.Context, logger *zap.Logger, cfg *config.Config) (CalendarService, error) {
-	var opts []option.ClientOption
-
-	if cfg.Google.ServiceAccountJSON != "" {
-		opts = append(opts, option.WithCredentialsJSON([]byte(cfg.Google.ServiceAccountJSON)))
-	} else if cfg.Google.CredentialsPath != "" {
-		opts = append(opts, option.WithCredentialsFile(cfg.Google.CredentialsPath))
-	} else {
-		return nil, fmt.Errorf("no Google credentials found: set GOOGLE_SERVICE_ACCOUNT_JSON or GOOGLE_CREDENTIALS_PATH")
+	creds, err := resolveCredentials(logger, cfg.Google)
+	if err != nil {
+		return nil, err
 	}
+	logger.Info("using Google credentials", zap.String("source", creds.source), zap.String("path", creds.path))
 
 	scopes := []string{
 		calendar.CalendarReadonlyScope,
 		calendar.CalendarScope,
 	}
 
+	opts := []option.ClientOption{option.WithCredentialsJSON(creds.json)}
+	if cfg.Google.ImpersonateSubject != "" {
+		jwtCfg, err := impersonationConfig(creds.json, cfg.Google.ImpersonateSubject, scopes)
+		if err != nil {
+			return nil, err
+		}
+		logger.Info("impersonating Google Workspace user", zap.String("subject", jwtCfg.Subject))
+		opts = []option.ClientOption{option.WithTokenSource(jwtCfg.TokenSource(ctx))}
+	}
+
 	scopesOption := option.WithScopes(scopes...)
 	allOptions := append([]option.ClientOption{scopesOption}, opts...)
 
-	svc, err := calendar.NewService(ctx, allOptions...)
+	transport, err := htransport.NewTransport(ctx, newHTTPTransport(cfg.Google), allOptions...)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create google http transport: %w", err)
+	}
+
+	svc, err := calendar.NewService(ctx, option.WithHTTPClient(&http.Client{Transport: transport}))
 	if err != nil {
 		return nil, fmt.Errorf("unable to create google calendar service: %w", err)
 	}
@@ -75,30 +131,123 @@ func createRealCalendarService(ctx context.Context, logger *zap.Logger, cfg *con
 	return &CalendarServiceImpl{service: svc, logger: logger, config: cfg}, nil
 }
 
+// newHTTPTransport returns the base transport for Google API calls with
+// the configured idle-connection pool. Everything else matches
+// http.DefaultTransport.
+func newHTTPTransport(cfg config.GoogleConfig) *http.Transport {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.MaxIdleConns = cfg.MaxIdleConns
+	transport.MaxIdleConnsPerHost = cfg.MaxIdleConnsPerHost
+	transport.IdleConnTimeout = cfg.IdleConnTimeout
+	return transport
+}
+
+// credentialsKeyFile is the file looked up when GOOGLE_CREDENTIALS_PATH
+// points to a directory, e.g. a Kubernetes secret mount.
+const credentialsKeyFile = "key.json"
+
+// credentials holds the resolved service account JSON and where it came from
+type credentials struct {
+	json   []byte
+	source string
+	path   string
+}
+
+// resolveCredentials picks the credential source. A readable file at
+// CredentialsPath (or CredentialsPath/key.json when it is a directory) takes
+// precedence over the inline ServiceAccountJSON.
+func resolveCredentials(logger *zap.Logger, cfg config.GoogleConfig) (*credentials, error) {
+	var pathErr error
+	if cfg.CredentialsPath != "" {
+		path := cfg.CredentialsPath
+		if info, err := os.Stat(path); err == nil && info.IsDir() {
+			path = filepath.Join(path, credentialsKeyFile)
+		}
+
+		data, err := os.ReadFile(path)
+		if err == nil {
+			return &credentials{json: data, source: "file", path: path}, nil
+		}
+		pathErr = fmt.Errorf("unable to read GOOGLE_CREDENTIALS_PATH: %w", err)
+	}
+
+	if cfg.ServiceAccountJSON != "" {
+		if pathErr != nil {
+			logger.Warn("falling back to inline Google credentials", zap.Error(pathErr))
+		}
+		return &credentials{json: []byte(cfg.ServiceAccountJSON), source: "inline"}, nil
+	}
+
+	if pathErr != nil {
+		return nil, pathErr
+	}
+	return nil, fmt.Errorf("no Google credentials found: set GOOGLE_SERVICE_ACCOUNT_JSON or GOOGLE_CREDENTIALS_PATH")
+}
+
+// impersonationConfig builds a JWT config that uses domain-wide delegation
+// to act on behalf of subject
+func impersonationConfig(serviceAccountJSON []byte, subject string, scopes []string) (*jwt.Config, error) {
+	jwtCfg, err := googleoauth.JWTConfigFromJSON(serviceAccountJSON, scopes...)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse service account credentials for impersonation: %w", err)
+	}
+	jwtCfg.Subject = subject
+	return jwtCfg, nil
+}
+
 // CalendarServiceImpl implements the calendar service interface for Google Calendar API
 type CalendarServiceImpl struct {
 	service *calendar.Service
 	logger  *zap.Logger
 	config  *config.Config
+
+	colorsMu sync.Mutex
+	colors   *calendar.Colors
+
+	calendarsMu sync.Mutex
+	calendars   map[string]*calendar.Calendar
+
+	calendarIDMu sync.RWMutex
+	calendarID   string
 }
 
-// GetCalendarID returns the calendar ID from config or default to "primary"
+// GetCalendarID returns the calendar ID set at runtime, else the one from
+// config, else "primary"
 func (g *CalendarServiceImpl) GetCalendarID() string {
+	g.calendarIDMu.RLock()
+	defer g.calendarIDMu.RUnlock()
+	if g.calendarID != "" {
+		return g.calendarID
+	}
 	if g.config.GoogleCalendar.ID != "" {
 		return g.config.GoogleCalendar.ID
 	}
 	return "primary"
 }
 
+// SetCalendarID changes the calendar tools operate on until the process
+// restarts. The caller is expected to have checked that it exists.
+func (g *CalendarServiceImpl) SetCalendarID(calendarID string) {
+	g.calendarIDMu.Lock()
+	defer g.calendarIDMu.Unlock()
+	g.calendarID = calendarID
+}
+
 // CreateEvent creates a new event in the calendar
-func (g *CalendarServiceImpl) CreateEvent(calendarID string, event *calendar.Event) (*calendar.Event, error) {
+func (g *CalendarServiceImpl) CreateEvent(ctx context.Context, calendarID string, event *calendar.Event) (*calendar.Event, error) {
 	g.logger.Debug("creating event",
 		zap.String("component", "google-calendar-service"),
 		zap.String("operation", "create-event"),
 		zap.String("calendarID", calendarID),
 		zap.String("summary", event.Summary))
 
-	createdEvent, err := g.service.Events.Insert(calendarID, event).Do()
+	// Without these Google drops the conference and attachments of an event
+	// copied from another, such as a series split off "this and following".
+	createdEvent, err := g.service.Events.Insert(calendarID, event).
+		ConferenceDataVersion(1).
+		SupportsAttachments(true).
+		Context(ctx).
+		Do()
 	if err != nil {
 		g.logger.Error("failed to create event",
 			zap.String("component", "google-calendar-service"),
@@ -113,7 +262,7 @@ func (g *CalendarServiceImpl) CreateEvent(calendarID string, event *calendar.Eve
 }
 
 // ListEvents lists the events in the calendar
-func (g *CalendarServiceImpl) ListEvents(calendarID string, timeMin, timeMax time.Time) ([]*calendar.Event, error) {
+func (g *CalendarServiceImpl) ListEvents(ctx context.Context, calendarID string, timeMin, timeMax time.Time) ([]*calendar.Event, error) {
 	g.logger.Debug("listing events",
 		zap.String("component", "google-calendar-service"),
 		zap.String("operation", "list-events"),
@@ -130,7 +279,7 @@ func (g *CalendarServiceImpl) ListEvents(calendarID string, timeMin, timeMax tim
 		call = call.TimeMax(timeMax.Format(time.RFC3339))
 	}
 
-	events, err := call.Do()
+	events, err := call.Context(ctx).Do()
 	if err != nil {
 		g.logger.Error("failed to list events",
 			zap.String("component", "google-calendar-service"),
@@ -144,8 +293,168 @@ func (g *CalendarServiceImpl) ListEvents(calendarID string, timeMin, timeMax tim
 	return events.Items, nil
 }
 
+// ListEventsByProperty lists the events carrying an extended property,
+// given as "key=value". Shared properties are matched when shared is set,
+// private ones otherwise. Zero times leave that side of the range open.
+func (g *CalendarServiceImpl) ListEventsByProperty(ctx context.Context, calendarID, property string, shared bool, timeMin, timeMax time.Time) ([]*calendar.Event, error) {
+	g.logger.Debug("listing events by property",
+		zap.String("component", "google-calendar-service"),
+		zap.String("operation", "list-events-by-property"),
+		zap.String("calendarID", calendarID),
+		zap.String("property", property),
+		zap.Bool("shared", shared))
+
+	call := g.service.Events.List(calendarID).
+		SingleEvents(true).
+		OrderBy("startTime")
+
+	if shared {
+		call = call.SharedExtendedProperty(property)
+	} else {
+		call = call.PrivateExtendedProperty(property)
+	}
+	if !timeMin.IsZero() {
+		call = call.TimeMin(timeMin.Format(time.RFC3339))
+	}
+	if !timeMax.IsZero() {
+		call = call.TimeMax(timeMax.Format(time.RFC3339))
+	}
+
+	events, err := call.Context(ctx).Do()
+	if err != nil {
+		g.logger.Error("failed to list events by property",
+			zap.String("component", "google-calendar-service"),
+			zap.String("operation", "list-events-by-property"),
+			zap.String("calendarID", calendarID),
+			zap.Error(err))
+		return nil, fmt.Errorf("unable to list events by property: %w", err)
+	}
+
+	g.logger.Debug("Successfully listed events by property", zap.Int("count", len(events.Items)))
+	return events.Items, nil
+}
+
+// ListEventsPage lists one page of at most pageSize events in the calendar,
+// starting at pageToken (empty for the first page). It returns the token of
+// the next page, or "" on the last one.
+func (g *CalendarServiceImpl) ListEventsPage(ctx context.Context, calendarID string, timeMin, timeMax time.Time, pageSize int64, pageToken string) ([]*calendar.Event, string, error) {
+	g.logger.Debug("listing events page",
+		zap.String("component", "google-calendar-service"),
+		zap.String("operation", "list-events-page"),
+		zap.String("calendarID", calendarID),
+		zap.Int64("pageSize", pageSize),
+		zap.Bool("firstPage", pageToken == ""))
+
+	call := g.service.Events.List(calendarID).
+		TimeMin(timeMin.Format(time.RFC3339)).
+		SingleEvents(true).
+		OrderBy("startTime").
+		MaxResults(pageSize)
+
+	if !timeMax.IsZero() {
+		call = call.TimeMax(timeMax.Format(time.RFC3339))
+	}
+	if pageToken != "" {
+		call = call.PageToken(pageToken)
+	}
+
+	events, err := call.Context(ctx).Do()
+	if err != nil {
+		g.logger.Error("failed to list events page",
+			zap.String("component", "google-calendar-service"),
+			zap.String("operation", "list-events-page"),
+			zap.String("calendarID", calendarID),
+			zap.Error(err))
+		return nil, "", fmt.Errorf("unable to list events page: %w", err)
+	}
+
+	g.logger.Debug("Successfully listed events page", zap.Int("count", len(events.Items)))
+	return events.Items, events.NextPageToken, nil
+}
+
+// ListEventsByType lists the events in the calendar whose event type is one
+// of eventTypes, such as "focusTime" or "outOfOffice"
+func (g *CalendarServiceImpl) ListEventsByType(ctx context.Context, calendarID string, eventTypes []string, timeMin, timeMax time.Time) ([]*calendar.Event, error) {
+	g.logger.Debug("listing events by type",
+		zap.String("component", "google-calendar-service"),
+		zap.String("operation", "list-events-by-type"),
+		zap.String("calendarID", calendarID),
+		zap.Strings("eventTypes", eventTypes),
+		zap.Time("timeMin", timeMin),
+		zap.Time("timeMax", timeMax))
+
+	call := g.service.Events.List(calendarID).
+		TimeMin(timeMin.Format(time.RFC3339)).
+		EventTypes(eventTypes...).
+		SingleEvents(true).
+		OrderBy("startTime")
+
+	if !timeMax.IsZero() {
+		call = call.TimeMax(timeMax.Format(time.RFC3339))
+	}
+
+	events, err := call.Context(ctx).Do()
+	if err != nil {
+		g.logger.Error("failed to list events by type",
+			zap.String("component", "google-calendar-service"),
+			zap.String("operation", "list-events-by-type"),
+			zap.String("calendarID", calendarID),
+			zap.Error(err))
+		return nil, fmt.Errorf("unable to list events by type: %w", err)
+	}
+
+	g.logger.Debug("Successfully listed events by type", zap.Int("count", len(events.Items)))
+	return events.Items, nil
+}
+
+// ListRecurringSeries lists the recurring series masters with an occurrence
+// in the range, without expanding them into instances. Zero times leave
+// that side of the range open.
+func (g *CalendarServiceImpl) ListRecurringSeries(ctx context.Context, calendarID string, timeMin, timeMax time.Time) ([]*calendar.Event, error) {
+	g.logger.Debug("listing recurring series",
+		zap.String("component", "google-calendar-service"),
+		zap.String("operation", "list-recurring-series"),
+		zap.String("calendarID", calendarID),
+		zap.Time("timeMin", timeMin),
+		zap.Time("timeMax", timeMax))
+
+	call := g.service.Events.List(calendarID).
+		SingleEvents(false)
+
+	if !timeMin.IsZero() {
+		call = call.TimeMin(timeMin.Format(time.RFC3339))
+	}
+	if !timeMax.IsZero() {
+		call = call.TimeMax(timeMax.Format(time.RFC3339))
+	}
+
+	// Without singleEvents the list also holds one-off events and modified
+	// occurrences; only masters carry the recurrence rules. They can sit on
+	// any page, so every page is read.
+	series := []*calendar.Event{}
+	err := call.Pages(ctx, func(page *calendar.Events) error {
+		for _, event := range page.Items {
+			if len(event.Recurrence) > 0 {
+				series = append(series, event)
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		g.logger.Error("failed to list recurring series",
+			zap.String("component", "google-calendar-service"),
+			zap.String("operation", "list-recurring-series"),
+			zap.String("calendarID", calendarID),
+			zap.Error(err))
+		return nil, fmt.Errorf("unable to list recurring series: %w", err)
+	}
+
+	g.logger.Debug("Successfully listed recurring series", zap.Int("count", len(series)))
+	return series, nil
+}
+
 // UpdateEvent updates an event by ID in the calendar
-func (g *CalendarServiceImpl) UpdateEvent(calendarID, eventID string, event *calendar.Event) (*calendar.Event, error) {
+func (g *CalendarServiceImpl) UpdateEvent(ctx context.Context, calendarID, eventID string, event *calendar.Event) (*calendar.Event, error) {
 	g.logger.Debug("updating event",
 		zap.String("component", "google-calendar-service"),
 		zap.String("operation", "update-event"),
@@ -153,7 +462,7 @@ func (g *CalendarServiceImpl) UpdateEvent(calendarID, eventID string, event *cal
 		zap.String("eventID", eventID),
 		zap.String("summary", event.Summary))
 
-	updatedEvent, err := g.service.Events.Update(calendarID, eventID, event).Do()
+	updatedEvent, err := g.service.Events.Update(calendarID, eventID, event).Context(ctx).Do()
 	if err != nil {
 		g.logger.Error("failed to update event",
 			zap.String("component", "google-calendar-service"),
@@ -168,15 +477,40 @@ func (g *CalendarServiceImpl) UpdateEvent(calendarID, eventID string, event *cal
 	return updatedEvent, nil
 }
 
+// PatchEvent applies a partial update to an event. Fields listed in
+// event.NullFields are cleared on the stored event.
+func (g *CalendarServiceImpl) PatchEvent(ctx context.Context, calendarID, eventID string, event *calendar.Event) (*calendar.Event, error) {
+	g.logger.Debug("patching event",
+		zap.String("component", "google-calendar-service"),
+		zap.String("operation", "patch-event"),
+		zap.String("calendarID", calendarID),
+		zap.String("eventID", eventID),
+		zap.Strings("nullFields", event.NullFields))
+
+	patchedEvent, err := g.service.Events.Patch(calendarID, eventID, event).Context(ctx).Do()
+	if err != nil {
+		g.logger.Error("failed to patch event",
+			zap.String("component", "google-calendar-service"),
+			zap.String("operation", "patch-event"),
+			zap.String("calendarID", calendarID),
+			zap.String("eventID", eventID),
+			zap.Error(err))
+		return nil, fmt.Errorf("unable to patch event: %w", err)
+	}
+
+	g.logger.Debug("Successfully patched event", zap.String("eventId", patchedEvent.Id))
+	return patchedEvent, nil
+}
+
 // DeleteEvent deletes an event by ID
-func (g *CalendarServiceImpl) DeleteEvent(calendarID, eventID string) error {
+func (g *CalendarServiceImpl) DeleteEvent(ctx context.Context, calendarID, eventID string) error {
 	g.logger.Debug("deleting event",
 		zap.String("component", "google-calendar-service"),
 		zap.String("operation", "delete-event"),
 		zap.String("calendarID", calendarID),
 		zap.String("eventID", eventID))
 
-	err := g.service.Events.Delete(calendarID, eventID).Do()
+	err := g.service.Events.Delete(calendarID, eventID).Context(ctx).Do()
 	if err != nil {
 		g.logger.Error("failed to delete event",
 			zap.String("component", "google-calendar-service"),
@@ -192,14 +526,14 @@ func (g *CalendarServiceImpl) DeleteEvent(calendarID, eventID string) error {
 }
 
 // GetEvent get a specific event by ID
-func (g *CalendarServiceImpl) GetEvent(calendarID, eventID string) (*calendar.Event, error) {
+func (g *CalendarServiceImpl) GetEvent(ctx context.Context, calendarID, eventID string) (*calendar.Event, error) {
 	g.logger.Debug("getting event",
 		zap.String("component", "google-calendar-service"),
 		zap.String("operation", "get-event"),
 		zap.String("calendarID", calendarID),
 		zap.String("eventID", eventID))
 
-	event, err := g.service.Events.Get(calendarID, eventID).Do()
+	event, err := g.service.Events.Get(calendarID, eventID).Context(ctx).Do()
 	if err != nil {
 		g.logger.Error("failed to get event",
 			zap.String("component", "google-calendar-service"),
@@ -215,12 +549,12 @@ func (g *CalendarServiceImpl) GetEvent(calendarID, eventID string) (*calendar.Ev
 }
 
 // ListCalendars returns the list of calendars accessible to the configured credential
-func (g *CalendarServiceImpl) ListCalendars() ([]*calendar.CalendarListEntry, error) {
+func (g *CalendarServiceImpl) ListCalendars(ctx context.Context) ([]*calendar.CalendarListEntry, error) {
 	g.logger.Debug("listing calendars",
 		zap.String("component", "google-calendar-service"),
 		zap.String("operation", "list-calendars"))
 
-	list, err := g.service.CalendarList.List().Do()
+	list, err := g.service.CalendarList.List().Context(ctx).Do()
 	if err != nil {
 		g.logger.Error("failed to list calendars",
 			zap.String("component", "google-calendar-service"),
@@ -233,8 +567,15 @@ func (g *CalendarServiceImpl) ListCalendars() ([]*calendar.CalendarListEntry, er
 	return list.Items, nil
 }
 
+// BlocksTime reports whether an event makes its owner busy. Focus time and
+// out-of-office events block time like regular events; working location
+// events only record where the owner works.
+func BlocksTime(event *calendar.Event) bool {
+	return event.EventType != "workingLocation"
+}
+
 // CheckConflicts checks for conflicts of events in the calendar by given start and end time
-func (g *CalendarServiceImpl) CheckConflicts(calendarID string, startTime, endTime time.Time) ([]*calendar.Event, error) {
+func (g *CalendarServiceImpl) CheckConflicts(ctx context.Context, calendarID string, startTime, endTime time.Time) ([]*calendar.Event, error) {
 	g.logger.Debug("checking conflicts",
 		zap.String("component", "google-calendar-service"),
 		zap.String("operation", "check-conflicts"),
@@ -247,6 +588,7 @@ func (g *CalendarServiceImpl) CheckConflicts(calendarID string, startTime, endTi
 		TimeMax(endTime.Format(time.RFC3339)).
 		SingleEvents(true).
 		OrderBy("startTime").
+		Context(ctx).
 		Do()
 
 	if err != nil {
@@ -260,6 +602,9 @@ func (g *CalendarServiceImpl) CheckConflicts(calendarID string, startTime, endTi
 
 	var conflicts []*calendar.Event
 	for _, event := range events.Items {
+		if !BlocksTime(event) {
+			continue
+		}
 		if event.Start != nil && event.End != nil {
 			eventStart, err1 := time.Parse(time.RFC3339, event.Start.DateTime)
 			eventEnd, err2 := time.Parse(time.RFC3339, event.End.DateTime)
@@ -276,10 +621,132 @@ func (g *CalendarServiceImpl) CheckConflicts(calendarID string, startTime, endTi
 	return conflicts, nil
 }
 
-// MockCalendarService implements CalendarService for testing
+// QueryFreeBusy returns the busy periods of each calendar (or person's
+// primary calendar, by email) between timeMin and timeMax. Calendars the
+// credentials cannot see are returned with Errors set rather than failing
+// the whole query.
+func (g *CalendarServiceImpl) QueryFreeBusy(ctx context.Context, calendarIDs []string, timeMin, timeMax time.Time) (map[string]calendar.FreeBusyCalendar, error) {
+	g.logger.Debug("querying free/busy",
+		zap.String("component", "google-calendar-service"),
+		zap.String("operation", "query-free-busy"),
+		zap.Strings("calendarIDs", calendarIDs),
+		zap.Time("timeMin", timeMin),
+		zap.Time("timeMax", timeMax))
+
+	request := &calendar.FreeBusyRequest{
+		TimeMin: timeMin.Format(time.RFC3339),
+		TimeMax: timeMax.Format(time.RFC3339),
+	}
+	for _, id := range calendarIDs {
+		request.Items = append(request.Items, &calendar.FreeBusyRequestItem{Id: id})
+	}
+
+	response, err := g.service.Freebusy.Query(request).Context(ctx).Do()
+	if err != nil {
+		g.logger.Error("failed to query free/busy",
+			zap.String("component", "google-calendar-service"),
+			zap.String("operation", "query-free-busy"),
+			zap.Error(err))
+		return nil, fmt.Errorf("unable to query free/busy: %w", err)
+	}
+	return response.Calendars, nil
+}
+
+// GetColors returns the calendar and event color palette. The palette is
+// static per account, so it is fetched once and cached.
+func (g *CalendarServiceImpl) GetColors(ctx context.Context) (*calendar.Colors, error) {
+	g.colorsMu.Lock()
+	defer g.colorsMu.Unlock()
+
+	if g.colors != nil {
+		return g.colors, nil
+	}
+
+	g.logger.Debug("fetching color palette",
+		zap.String("component", "google-calendar-service"),
+		zap.String("operation", "get-colors"))
+
+	colors, err := g.service.Colors.Get().Context(ctx).Do()
+	if err != nil {
+		g.logger.Error("failed to fetch color palette",
+			zap.String("component", "google-calendar-service"),
+			zap.String("operation", "get-colors"),
+			zap.Error(err))
+		return nil, fmt.Errorf("unable to get colors: %w", err)
+	}
+
+	g.colors = colors
+	return colors, nil
+}
+
+// GetCalendar returns calendar metadata, resolving aliases such as
+// "primary" to the real calendar ID and summary. Results are cached since
+// they are only used to label logs and errors.
+func (g *CalendarServiceImpl) GetCalendar(ctx context.Context, calendarID string) (*calendar.Calendar, error) {
+	g.calendarsMu.Lock()
+	defer g.calendarsMu.Unlock()
+
+	if cal, ok := g.calendars[calendarID]; ok {
+		return cal, nil
+	}
+
+	cal, err := g.service.Calendars.Get(calendarID).Context(ctx).Do()
+	if err != nil {
+		g.logger.Debug("failed to get calendar",
+			zap.String("component", "google-calendar-service"),
+			zap.String("operation", "get-calendar"),
+			zap.String("calendarID", calendarID),
+			zap.Error(err))
+		return nil, fmt.Errorf("unable to get calendar: %w", err)
+	}
+
+	if g.calendars == nil {
+		g.calendars = make(map[string]*calendar.Calendar)
+	}
+	g.calendars[calendarID] = cal
+	return cal, nil
+}
+
+// GetCalendarSettings returns the authenticated user's timezone and first
+// day of the week ("0" for Sunday, "1" for Monday, "6" for Saturday) from
+// their Google Calendar settings.
+func (g *CalendarServiceImpl) GetCalendarSettings(ctx context.Context) (string, string, error) {
+	g.logger.Debug("listing calendar settings",
+		zap.String("component", "google-calendar-service"),
+		zap.String("operation", "list-settings"))
+
+	var timezone, weekStart string
+	err := g.service.Settings.List().Pages(ctx, func(page *calendar.Settings) error {
+		for _, item := range page.Items {
+			switch item.Id {
+			case "timezone":
+				timezone = item.Value
+			case "weekStart":
+				weekStart = item.Value
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		g.logger.Error("failed to list calendar settings",
+			zap.String("component", "google-calendar-service"),
+			zap.String("operation", "list-settings"),
+			zap.Error(err))
+		return "", "", fmt.Errorf("unable to list calendar settings: %w", err)
+	}
+
+	return timezone, weekStart, nil
+}
+
+// MockCalendarService provides the calendar metadata (ID, colors, settings)
+// served in mock mode. Events are kept by InMemoryCalendarService, which
+// embeds it.
 type MockCalendarService struct {
 	logger *zap.Logger
 	config *config.Config
+
+	calendarIDMu sync.RWMutex
+	calendarID   string
 }
 
 // NewMockCalendarService creates a new mock calendar service
@@ -290,118 +757,60 @@ func NewMockCalendarService(logger *zap.Logger, cfg *config.Config) *MockCalenda
 	}
 }
 
-// GetCalendarID returns the calendar ID from config or default to "primary"
+// GetCalendarID returns the calendar ID set at runtime, else the one from
+// config, else "primary"
 func (m *MockCalendarService) GetCalendarID() string {
+	m.calendarIDMu.RLock()
+	defer m.calendarIDMu.RUnlock()
+	if m.calendarID != "" {
+		return m.calendarID
+	}
 	if m.config.GoogleCalendar.ID != "" {
 		return m.config.GoogleCalendar.ID
 	}
 	return "primary"
 }
 
-// CreateEvent creates a mock event
-func (m *MockCalendarService) CreateEvent(calendarID string, event *calendar.Event) (*calendar.Event, error) {
-	m.logger.Debug("Mock: creating event", zap.String("summary", event.Summary))
-
-	event.Id = fmt.Sprintf("mock-event-%d", time.Now().Unix())
-	event.Status = "confirmed"
-
-	return event, nil
+// SetCalendarID changes the calendar the mock reports as the default
+func (m *MockCalendarService) SetCalendarID(calendarID string) {
+	m.calendarIDMu.Lock()
+	defer m.calendarIDMu.Unlock()
+	m.calendarID = calendarID
 }
 
-// Implement other mock methods...
-func (m *MockCalendarService) ListEvents(calendarID string, timeMin, timeMax time.Time) ([]*calendar.Event, error) {
-	m.logger.Debug("Mock: listing events", zap.String("calendarID", calendarID))
-
-	events := []*calendar.Event{
-		{
-			Id:          "mock-event-1",
-			Summary:     "Mock Meeting 1",
-			Description: "This is a mock meeting",
-			Status:      "confirmed",
-			Start: &calendar.EventDateTime{
-				DateTime: timeMin.Add(time.Hour).Format(time.RFC3339),
-			},
-			End: &calendar.EventDateTime{
-				DateTime: timeMin.Add(2 * time.Hour).Format(time.RFC3339),
-			},
-			Location: "Mock Office",
-			HtmlLink: "https://calendar.google.com/mock1",
-		},
-		{
-			Id:          "mock-event-2",
-			Summary:     "Mock Event 2",
-			Description: "Another mock event",
-			Status:      "confirmed",
-			Start: &calendar.EventDateTime{
-				DateTime: timeMin.Add(3 * time.Hour).Format(time.RFC3339),
-			},
-			End: &calendar.EventDateTime{
-				DateTime: timeMin.Add(4 * time.Hour).Format(time.RFC3339),
-			},
-			Location: "Mock Location 2",
-			HtmlLink: "https://calendar.google.com/mock2",
-		},
-	}
-
-	return events, nil
-}
-func (m *MockCalendarService) UpdateEvent(calendarID, eventID string, event *calendar.Event) (*calendar.Event, error) {
-	m.logger.Debug("Mock: updating event", zap.String("eventId", eventID), zap.String("summary", event.Summary))
-
-	event.Id = eventID
-	event.Status = "confirmed"
-
-	return event, nil
-}
-func (m *MockCalendarService) DeleteEvent(calendarID, eventID string) error {
-	return nil
-}
-func (m *MockCalendarService) GetEvent(calendarID, eventID string) (*calendar.Event, error) {
-	m.logger.Debug("Mock: getting event", zap.String("eventId", eventID))
-
-	return &calendar.Event{
-		Id:          eventID,
-		Summary:     "Mock Event",
-		Description: "This is a mock event for testing",
-		Status:      "confirmed",
-		Start: &calendar.EventDateTime{
-			DateTime: "2024-01-01T10:00:00Z",
-		},
-		End: &calendar.EventDateTime{
-			DateTime: "2024-01-01T11:00:00Z",
-		},
-		Location: "Mock Location",
-		HtmlLink: "https://calendar.google.com/mock",
+func (m *MockCalendarService) ListCalendars(ctx context.Context) ([]*calendar.CalendarListEntry, error) {
+	return []*calendar.CalendarListEntry{
+		{Id: "mock@example.com", Summary: "Mock Calendar", AccessRole: "owner", Primary: true},
 	}, nil
 }
-func (m *MockCalendarService) ListCalendars() ([]*calendar.CalendarListEntry, error) {
-	return []*calendar.CalendarListEntry{}, nil
+func (m *MockCalendarService) GetColors(ctx context.Context) (*calendar.Colors, error) {
+	event := map[string]calendar.ColorDefinition{}
+	for id, hex := range map[string]string{
+		"1": "#a4bdfc", "2": "#7ae7bf", "3": "#dbadff", "4": "#ff887c",
+		"5": "#fbd75b", "6": "#ffb878", "7": "#46d6db", "8": "#e1e1e1",
+		"9": "#5484ed", "10": "#51b749", "11": "#dc2127",
+	} {
+		event[id] = calendar.ColorDefinition{Background: hex, Foreground: "#1d1d1d"}
+	}
+	return &calendar.Colors{Event: event}, nil
 }
-func (m *MockCalendarService) CheckConflicts(calendarID string, startTime, endTime time.Time) ([]*calendar.Event, error) {
-	m.logger.Debug("Mock: checking conflicts",
-		zap.String("calendarID", calendarID),
-		zap.Time("startTime", startTime),
-		zap.Time("endTime", endTime))
 
-	mockConflictStart := time.Date(startTime.Year(), startTime.Month(), startTime.Day(), 10, 0, 0, 0, startTime.Location())
-	mockConflictEnd := mockConflictStart.Add(time.Hour)
-
-	if startTime.Before(mockConflictEnd) && endTime.After(mockConflictStart) {
-		return []*calendar.Event{
-			{
-				Id:      "mock-conflict-event",
-				Summary: "Mock Existing Meeting",
-				Status:  "confirmed",
-				Start: &calendar.EventDateTime{
-					DateTime: mockConflictStart.Format(time.RFC3339),
-				},
-				End: &calendar.EventDateTime{
-					DateTime: mockConflictEnd.Format(time.RFC3339),
-				},
-				Location: "Mock Conference Room",
-			},
-		}, nil
-	}
-
-	return []*calendar.Event{}, nil
+// GetCalendar returns the mock calendar for "primary", its own ID, the
+// configured calendar and the current default. Any other ID is not found,
+// as it is for Google, so switching to an unknown calendar fails in mock
+// mode too.
+func (m *MockCalendarService) GetCalendar(ctx context.Context, calendarID string) (*calendar.Calendar, error) {
+	switch calendarID {
+	case "primary", "mock@example.com":
+		return &calendar.Calendar{Id: "mock@example.com", Summary: "Mock Calendar"}, nil
+	case m.config.GoogleCalendar.ID, m.GetCalendarID():
+		return &calendar.Calendar{Id: calendarID, Summary: "Mock Calendar"}, nil
+	}
+	return nil, &googleapi.Error{
+		Code:    http.StatusNotFound,
+		Message: fmt.Sprintf("calendar %q not found", calendarID),
+	}
+}
+func (m *MockCalendarService) GetCalendarSettings(ctx context.Context) (string, string, error) {
+	return m.config.GoogleCalendar.Timezone, "1", nil
 }
@@ -0,0 +1,438 @@
+package google
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	config "github.com/inference-gateway/google-calendar-agent/config"
+	zap "go.uber.org/zap"
+	calendar "google.golang.org/api/calendar/v3"
+	googleapi "google.golang.org/api/googleapi"
+)
+
+// InMemoryCalendarService implements CalendarService on top of an in-memory
+// event store, so created events can be listed, updated and deleted again.
+// It backs mock mode; calendar metadata (colors, settings, calendar list)
+// comes from the embedded MockCalendarService.
+type InMemoryCalendarService struct {
+	*MockCalendarService
+
+	mu     sync.Mutex
+	nextID int
+	events map[string]map[string]*calendar.Event
+}
+
+// NewInMemoryCalendarService creates an empty in-memory calendar service
+func NewInMemoryCalendarService(logger *zap.Logger, cfg *config.Config) *InMemoryCalendarService {
+	return &InMemoryCalendarService{
+		MockCalendarService: NewMockCalendarService(logger, cfg),
+		events:              map[string]map[string]*calendar.Event{},
+	}
+}
+
+// SeedDemoEvents adds a few sample events on the day of now, in the
+// configured timezone, so a fresh mock-mode session has something to list
+// and a meeting at 10:00 to conflict with.
+func (m *InMemoryCalendarService) SeedDemoEvents(now time.Time) {
+	loc, err := time.LoadLocation(m.config.GoogleCalendar.Timezone)
+	if err != nil {
+		loc = time.UTC
+	}
+	day := now.In(loc)
+	at := func(hour, minute int) *calendar.EventDateTime {
+		t := time.Date(day.Year(), day.Month(), day.Day(), hour, minute, 0, 0, loc)
+		return &calendar.EventDateTime{DateTime: t.Format(time.RFC3339)}
+	}
+
+	calendarID := m.GetCalendarID()
+	for _, event := range []*calendar.Event{
+		{Summary: "Mock Existing Meeting", Location: "Mock Conference Room", Start: at(10, 0), End: at(11, 0)},
+		{Summary: "Mock Meeting 1", Description: "This is a mock meeting", Location: "Mock Office", Start: at(13, 0), End: at(14, 0)},
+		{Summary: "Mock Event 2", Description: "Another mock event", Location: "Mock Location 2", Start: at(15, 0), End: at(16, 0)},
+	} {
+		if _, err := m.CreateEvent(context.Background(), calendarID, event); err != nil {
+			m.logger.Warn("failed to seed demo event", zap.String("summary", event.Summary), zap.Error(err))
+		}
+	}
+}
+
+func notFound(calendarID, eventID string) error {
+	return &googleapi.Error{
+		Code:    http.StatusNotFound,
+		Message: fmt.Sprintf("event %q not found in calendar %q", eventID, calendarID),
+	}
+}
+
+// eventBounds returns the start and end of a timed or all-day event.
+// All-day dates are read as UTC midnight.
+func eventBounds(event *calendar.Event) (time.Time, time.Time, bool) {
+	if event.Start == nil || event.End == nil {
+		return time.Time{}, time.Time{}, false
+	}
+	parse := func(dt *calendar.EventDateTime) (time.Time, error) {
+		if dt.DateTime != "" {
+			return time.Parse(time.RFC3339, dt.DateTime)
+		}
+		return time.Parse("2006-01-02", dt.Date)
+	}
+	start, err1 := parse(event.Start)
+	end, err2 := parse(event.End)
+	if err1 != nil || err2 != nil {
+		return time.Time{}, time.Time{}, false
+	}
+	return start, end, true
+}
+
+// CreateEvent stores a copy of event under a new ID
+func (m *InMemoryCalendarService) CreateEvent(ctx context.Context, calendarID string, event *calendar.Event) (*calendar.Event, error) {
+	m.logger.Debug("InMemory: creating event", zap.String("summary", event.Summary))
+	if _, _, ok := eventBounds(event); !ok {
+		return nil, &googleapi.Error{Code: http.StatusBadRequest, Message: "event start and end are required"}
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.nextID++
+	stored := *event
+	stored.Id = fmt.Sprintf("memory-event-%d", m.nextID)
+	stored.Status = "confirmed"
+	stored.Created = time.Now().UTC().Format(time.RFC3339)
+	stored.Updated = stored.Created
+	if m.events[calendarID] == nil {
+		m.events[calendarID] = map[string]*calendar.Event{}
+	}
+	m.events[calendarID][stored.Id] = &stored
+
+	created := stored
+	return &created, nil
+}
+
+// ListEvents returns the stored events overlapping [timeMin, timeMax),
+// ordered by start time. Cancelled events are left out, as Google leaves
+// them out of listings unless showDeleted is set.
+func (m *InMemoryCalendarService) ListEvents(ctx context.Context, calendarID string, timeMin, timeMax time.Time) ([]*calendar.Event, error) {
+	m.logger.Debug("InMemory: listing events", zap.String("calendarID", calendarID))
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	type listed struct {
+		event *calendar.Event
+		start time.Time
+	}
+	var matches []listed
+	for _, event := range m.events[calendarID] {
+		if event.Status == "cancelled" {
+			continue
+		}
+		start, end, ok := eventBounds(event)
+		if !ok || !start.Before(timeMax) || !end.After(timeMin) {
+			continue
+		}
+		copied := *event
+		matches = append(matches, listed{event: &copied, start: start})
+	}
+	sort.Slice(matches, func(i, j int) bool {
+		if matches[i].start.Equal(matches[j].start) {
+			return matches[i].event.Id < matches[j].event.Id
+		}
+		return matches[i].start.Before(matches[j].start)
+	})
+
+	events := make([]*calendar.Event, 0, len(matches))
+	for _, match := range matches {
+		events = append(events, match.event)
+	}
+	return events, nil
+}
+
+// ListEventsByProperty returns the stored events whose private or shared
+// extended properties contain property ("key=value"), ordered by start
+// time. Zero times leave that side of the range open.
+func (m *InMemoryCalendarService) ListEventsByProperty(ctx context.Context, calendarID, property string, shared bool, timeMin, timeMax time.Time) ([]*calendar.Event, error) {
+	m.logger.Debug("InMemory: listing events by property", zap.String("calendarID", calendarID), zap.String("property", property))
+
+	key, value, ok := strings.Cut(property, "=")
+	if !ok {
+		return nil, &googleapi.Error{Code: http.StatusBadRequest, Message: "extended property must be key=value"}
+	}
+
+	if timeMax.IsZero() {
+		timeMax = time.Date(9999, 1, 1, 0, 0, 0, 0, time.UTC)
+	}
+	events, err := m.ListEvents(ctx, calendarID, timeMin, timeMax)
+	if err != nil {
+		return nil, err
+	}
+
+	matches := []*calendar.Event{}
+	for _, event := range events {
+		if event.ExtendedProperties == nil {
+			continue
+		}
+		props := event.ExtendedProperties.Private
+		if shared {
+			props = event.ExtendedProperties.Shared
+		}
+		if v, found := props[key]; found && v == value {
+			matches = append(matches, event)
+		}
+	}
+	return matches, nil
+}
+
+// ListEventsPage returns up to pageSize of the events ListEvents would
+// return, starting at the offset encoded in pageToken. The next token is
+// the offset of the following page, or "" after the last one.
+func (m *InMemoryCalendarService) ListEventsPage(ctx context.Context, calendarID string, timeMin, timeMax time.Time, pageSize int64, pageToken string) ([]*calendar.Event, string, error) {
+	offset := 0
+	if pageToken != "" {
+		n, err := strconv.Atoi(pageToken)
+		if err != nil || n < 0 {
+			return nil, "", &googleapi.Error{Code: http.StatusBadRequest, Message: "invalid page token"}
+		}
+		offset = n
+	}
+
+	if timeMax.IsZero() {
+		timeMax = time.Date(9999, 1, 1, 0, 0, 0, 0, time.UTC)
+	}
+	events, err := m.ListEvents(ctx, calendarID, timeMin, timeMax)
+	if err != nil {
+		return nil, "", err
+	}
+	if offset >= len(events) {
+		return []*calendar.Event{}, "", nil
+	}
+
+	end := offset + int(pageSize)
+	if pageSize <= 0 || end >= len(events) {
+		return events[offset:], "", nil
+	}
+	return events[offset:end], strconv.Itoa(end), nil
+}
+
+// ListEventsByType returns the stored events overlapping [timeMin,
+// timeMax) whose event type is one of eventTypes, ordered by start time.
+// Events without a type count as "default", as they do in Google Calendar.
+func (m *InMemoryCalendarService) ListEventsByType(ctx context.Context, calendarID string, eventTypes []string, timeMin, timeMax time.Time) ([]*calendar.Event, error) {
+	m.logger.Debug("InMemory: listing events by type", zap.String("calendarID", calendarID), zap.Strings("eventTypes", eventTypes))
+
+	if timeMax.IsZero() {
+		timeMax = time.Date(9999, 1, 1, 0, 0, 0, 0, time.UTC)
+	}
+	events, err := m.ListEvents(ctx, calendarID, timeMin, timeMax)
+	if err != nil {
+		return nil, err
+	}
+
+	matches := []*calendar.Event{}
+	for _, event := range events {
+		eventType := event.EventType
+		if eventType == "" {
+			eventType = "default"
+		}
+		if slices.Contains(eventTypes, eventType) {
+			matches = append(matches, event)
+		}
+	}
+	return matches, nil
+}
+
+// ListRecurringSeries returns the stored events with recurrence rules that
+// start before timeMax, ordered by start time. Rules are not expanded, so a
+// series is kept whenever it began before the range ends.
+func (m *InMemoryCalendarService) ListRecurringSeries(ctx context.Context, calendarID string, timeMin, timeMax time.Time) ([]*calendar.Event, error) {
+	m.logger.Debug("InMemory: listing recurring series", zap.String("calendarID", calendarID))
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	series := []*calendar.Event{}
+	for _, event := range m.events[calendarID] {
+		if len(event.Recurrence) == 0 || event.Status == "cancelled" {
+			continue
+		}
+		start, _, ok := eventBounds(event)
+		if !ok || (!timeMax.IsZero() && !start.Before(timeMax)) {
+			continue
+		}
+		copied := *event
+		series = append(series, &copied)
+	}
+	sort.Slice(series, func(i, j int) bool {
+		si, _, _ := eventBounds(series[i])
+		sj, _, _ := eventBounds(series[j])
+		if si.Equal(sj) {
+			return series[i].Id < series[j].Id
+		}
+		return si.Before(sj)
+	})
+	return series, nil
+}
+
+// UpdateEvent replaces a stored event
+func (m *InMemoryCalendarService) UpdateEvent(ctx context.Context, calendarID, eventID string, event *calendar.Event) (*calendar.Event, error) {
+	m.logger.Debug("InMemory: updating event", zap.String("eventId", eventID), zap.String("summary", event.Summary))
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	existing, ok := m.events[calendarID][eventID]
+	if !ok {
+		return nil, notFound(calendarID, eventID)
+	}
+	stored := *event
+	stored.Id = eventID
+	stored.Created = existing.Created
+	stored.Updated = time.Now().UTC().Format(time.RFC3339)
+	if stored.Status == "" {
+		stored.Status = existing.Status
+	}
+	m.events[calendarID][eventID] = &stored
+
+	updated := stored
+	return &updated, nil
+}
+
+// PatchEvent applies the non-empty fields of event, and clears the fields
+// named in event.NullFields, on a stored event
+func (m *InMemoryCalendarService) PatchEvent(ctx context.Context, calendarID, eventID string, event *calendar.Event) (*calendar.Event, error) {
+	m.logger.Debug("InMemory: patching event", zap.String("eventId", eventID), zap.Strings("nullFields", event.NullFields))
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	existing, ok := m.events[calendarID][eventID]
+	if !ok {
+		return nil, notFound(calendarID, eventID)
+	}
+	stored := *existing
+	if event.Summary != "" {
+		stored.Summary = event.Summary
+	}
+	if event.Description != "" {
+		stored.Description = event.Description
+	}
+	if event.Location != "" {
+		stored.Location = event.Location
+	}
+	if event.Start != nil {
+		stored.Start = event.Start
+	}
+	if event.End != nil {
+		stored.End = event.End
+	}
+	if event.Attendees != nil {
+		stored.Attendees = event.Attendees
+	}
+	if event.Recurrence != nil {
+		stored.Recurrence = event.Recurrence
+	}
+	if event.Reminders != nil {
+		stored.Reminders = event.Reminders
+	}
+	for _, field := range event.NullFields {
+		switch field {
+		case "Attendees":
+			stored.Attendees = nil
+		case "Description":
+			stored.Description = ""
+		case "Location":
+			stored.Location = ""
+		}
+	}
+	stored.Updated = time.Now().UTC().Format(time.RFC3339)
+	m.events[calendarID][eventID] = &stored
+
+	patched := stored
+	return &patched, nil
+}
+
+// DeleteEvent removes a stored event
+func (m *InMemoryCalendarService) DeleteEvent(ctx context.Context, calendarID, eventID string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if _, ok := m.events[calendarID][eventID]; !ok {
+		return notFound(calendarID, eventID)
+	}
+	delete(m.events[calendarID], eventID)
+	return nil
+}
+
+// GetEvent returns a stored event
+func (m *InMemoryCalendarService) GetEvent(ctx context.Context, calendarID, eventID string) (*calendar.Event, error) {
+	m.logger.Debug("InMemory: getting event", zap.String("eventId", eventID))
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	existing, ok := m.events[calendarID][eventID]
+	if !ok {
+		return nil, notFound(calendarID, eventID)
+	}
+	event := *existing
+	return &event, nil
+}
+
+// CheckConflicts returns the stored timed events that block time between
+// startTime and endTime
+func (m *InMemoryCalendarService) CheckConflicts(ctx context.Context, calendarID string, startTime, endTime time.Time) ([]*calendar.Event, error) {
+	events, err := m.ListEvents(ctx, calendarID, startTime, endTime)
+	if err != nil {
+		return nil, err
+	}
+
+	conflicts := []*calendar.Event{}
+	for _, event := range events {
+		if BlocksTime(event) && event.Start.DateTime != "" {
+			conflicts = append(conflicts, event)
+		}
+	}
+	return conflicts, nil
+}
+
+// QueryFreeBusy returns the busy periods of the stored calendars. Any other
+// calendar or email is reported as not found, as Google does for calendars
+// the credentials cannot see.
+func (m *InMemoryCalendarService) QueryFreeBusy(ctx context.Context, calendarIDs []string, timeMin, timeMax time.Time) (map[string]calendar.FreeBusyCalendar, error) {
+	calendars := map[string]calendar.FreeBusyCalendar{}
+	for _, id := range calendarIDs {
+		m.mu.Lock()
+		_, known := m.events[id]
+		m.mu.Unlock()
+		if !known && id != m.GetCalendarID() {
+			calendars[id] = calendar.FreeBusyCalendar{
+				Errors: []*calendar.Error{{Domain: "global", Reason: "notFound"}},
+			}
+			continue
+		}
+
+		events, err := m.ListEvents(ctx, id, timeMin, timeMax)
+		if err != nil {
+			return nil, err
+		}
+		var busy []*calendar.TimePeriod
+		for _, event := range events {
+			if !BlocksTime(event) {
+				continue
+			}
+			start, end, _ := eventBounds(event)
+			busy = append(busy, &calendar.TimePeriod{
+				Start: start.UTC().Format(time.RFC3339),
+				End:   end.UTC().Format(time.RFC3339),
+			})
+		}
+		calendars[id] = calendar.FreeBusyCalendar{Busy: busy}
+	}
+	return calendars, nil
+}
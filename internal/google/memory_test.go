@@ -0,0 +1,306 @@
+package google
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	config "github.com/inference-gateway/google-calendar-agent/config"
+	zap "go.uber.org/zap"
+	calendar "google.golang.org/api/calendar/v3"
+	googleapi "google.golang.org/api/googleapi"
+)
+
+func TestInMemoryCalendarServiceLifecycle(t *testing.T) {
+	svc := NewInMemoryCalendarService(zap.NewNop(), &config.Config{})
+	calendarID := svc.GetCalendarID()
+	day := time.Date(2026, 5, 23, 0, 0, 0, 0, time.UTC)
+
+	created, err := svc.CreateEvent(context.Background(), calendarID, &calendar.Event{
+		Summary:  "Design review",
+		Location: "Room 1",
+		Start:    &calendar.EventDateTime{DateTime: "2026-05-23T14:00:00Z"},
+		End:      &calendar.EventDateTime{DateTime: "2026-05-23T15:00:00Z"},
+	})
+	if err != nil {
+		t.Fatalf("CreateEvent: %v", err)
+	}
+	if created.Id == "" {
+		t.Fatal("CreateEvent did not assign an ID")
+	}
+	if _, err := svc.CreateEvent(context.Background(), calendarID, &calendar.Event{
+		Summary: "Standup",
+		Start:   &calendar.EventDateTime{DateTime: "2026-05-23T09:00:00Z"},
+		End:     &calendar.EventDateTime{DateTime: "2026-05-23T09:15:00Z"},
+	}); err != nil {
+		t.Fatalf("CreateEvent: %v", err)
+	}
+	if _, err := svc.CreateEvent(context.Background(), calendarID, &calendar.Event{
+		Summary: "Next week",
+		Start:   &calendar.EventDateTime{DateTime: "2026-05-30T09:00:00Z"},
+		End:     &calendar.EventDateTime{DateTime: "2026-05-30T10:00:00Z"},
+	}); err != nil {
+		t.Fatalf("CreateEvent: %v", err)
+	}
+
+	events, err := svc.ListEvents(context.Background(), calendarID, day, day.AddDate(0, 0, 1))
+	if err != nil {
+		t.Fatalf("ListEvents: %v", err)
+	}
+	if len(events) != 2 || events[0].Summary != "Standup" || events[1].Id != created.Id {
+		t.Fatalf("ListEvents = %v, want Standup then %s", summaries(events), created.Id)
+	}
+
+	conflicts, err := svc.CheckConflicts(context.Background(), calendarID, day.Add(14*time.Hour+30*time.Minute), day.Add(16*time.Hour))
+	if err != nil {
+		t.Fatalf("CheckConflicts: %v", err)
+	}
+	if len(conflicts) != 1 || conflicts[0].Id != created.Id {
+		t.Fatalf("CheckConflicts = %v, want %s", summaries(conflicts), created.Id)
+	}
+
+	created.Summary = "Design review (moved)"
+	created.Start = &calendar.EventDateTime{DateTime: "2026-05-23T16:00:00Z"}
+	created.End = &calendar.EventDateTime{DateTime: "2026-05-23T17:00:00Z"}
+	if _, err := svc.UpdateEvent(context.Background(), calendarID, created.Id, created); err != nil {
+		t.Fatalf("UpdateEvent: %v", err)
+	}
+	if _, err := svc.PatchEvent(context.Background(), calendarID, created.Id, &calendar.Event{NullFields: []string{"Location"}}); err != nil {
+		t.Fatalf("PatchEvent: %v", err)
+	}
+	got, err := svc.GetEvent(context.Background(), calendarID, created.Id)
+	if err != nil {
+		t.Fatalf("GetEvent: %v", err)
+	}
+	if got.Summary != "Design review (moved)" || got.Start.DateTime != "2026-05-23T16:00:00Z" || got.Location != "" {
+		t.Errorf("GetEvent = %+v, want moved event without location", got)
+	}
+	conflicts, err = svc.CheckConflicts(context.Background(), calendarID, day.Add(14*time.Hour+30*time.Minute), day.Add(16*time.Hour))
+	if err != nil {
+		t.Fatalf("CheckConflicts: %v", err)
+	}
+	if len(conflicts) != 0 {
+		t.Errorf("CheckConflicts after move = %v, want none", summaries(conflicts))
+	}
+
+	if err := svc.DeleteEvent(context.Background(), calendarID, created.Id); err != nil {
+		t.Fatalf("DeleteEvent: %v", err)
+	}
+	events, err = svc.ListEvents(context.Background(), calendarID, day, day.AddDate(0, 0, 1))
+	if err != nil {
+		t.Fatalf("ListEvents: %v", err)
+	}
+	if len(events) != 1 || events[0].Summary != "Standup" {
+		t.Errorf("ListEvents after delete = %v, want only Standup", summaries(events))
+	}
+
+	var apiErr *googleapi.Error
+	if _, err := svc.GetEvent(context.Background(), calendarID, created.Id); !errors.As(err, &apiErr) || apiErr.Code != http.StatusNotFound {
+		t.Errorf("GetEvent after delete error = %v, want 404", err)
+	}
+	if err := svc.DeleteEvent(context.Background(), calendarID, created.Id); !errors.As(err, &apiErr) || apiErr.Code != http.StatusNotFound {
+		t.Errorf("second DeleteEvent error = %v, want 404", err)
+	}
+}
+
+func TestInMemoryCalendarServiceReturnsCopies(t *testing.T) {
+	svc := NewInMemoryCalendarService(zap.NewNop(), &config.Config{})
+	created, err := svc.CreateEvent(context.Background(), "primary", &calendar.Event{
+		Summary: "Original",
+		Start:   &calendar.EventDateTime{DateTime: "2026-05-23T09:00:00Z"},
+		End:     &calendar.EventDateTime{DateTime: "2026-05-23T10:00:00Z"},
+	})
+	if err != nil {
+		t.Fatalf("CreateEvent: %v", err)
+	}
+	created.Summary = "Mutated"
+
+	got, err := svc.GetEvent(context.Background(), "primary", created.Id)
+	if err != nil {
+		t.Fatalf("GetEvent: %v", err)
+	}
+	if got.Summary != "Original" {
+		t.Errorf("Summary = %q, want the stored event unaffected by caller changes", got.Summary)
+	}
+}
+
+func TestMockModeKeepsCreatedEvents(t *testing.T) {
+	cfg := &config.Config{}
+	cfg.GoogleCalendar.MockMode = true
+	cfg.GoogleCalendar.Timezone = "UTC"
+
+	svc, err := NewServiceFactory(zap.NewNop(), cfg)
+	if err != nil {
+		t.Fatalf("NewServiceFactory: %v", err)
+	}
+	calendarID := svc.GetCalendarID()
+	start := time.Now().UTC().Truncate(time.Hour).AddDate(0, 0, 2)
+
+	created, err := svc.CreateEvent(context.Background(), calendarID, &calendar.Event{
+		Summary: "Demo booking",
+		Start:   &calendar.EventDateTime{DateTime: start.Format(time.RFC3339)},
+		End:     &calendar.EventDateTime{DateTime: start.Add(time.Hour).Format(time.RFC3339)},
+	})
+	if err != nil {
+		t.Fatalf("CreateEvent: %v", err)
+	}
+
+	events, err := svc.ListEvents(context.Background(), calendarID, start.Add(-time.Hour), start.Add(2*time.Hour))
+	if err != nil {
+		t.Fatalf("ListEvents: %v", err)
+	}
+	if len(events) != 1 || events[0].Id != created.Id {
+		t.Fatalf("ListEvents = %v, want the demo booking %s", summaries(events), created.Id)
+	}
+
+	today := time.Now().UTC().Truncate(24 * time.Hour)
+	seeded, err := svc.ListEvents(context.Background(), calendarID, today, today.AddDate(0, 0, 1))
+	if err != nil {
+		t.Fatalf("ListEvents: %v", err)
+	}
+	if len(seeded) != 3 {
+		t.Errorf("seeded events = %v, want 3 sample events today", summaries(seeded))
+	}
+}
+
+func summaries(events []*calendar.Event) []string {
+	var out []string
+	for _, e := range events {
+		out = append(out, e.Id+":"+e.Summary)
+	}
+	return out
+}
+
+func TestInMemoryListingsLeaveOutCancelledEvents(t *testing.T) {
+	svc := NewInMemoryCalendarService(zap.NewNop(), &config.Config{})
+	day := time.Date(2026, 5, 18, 0, 0, 0, 0, time.UTC)
+	kept, err := svc.CreateEvent(context.Background(), "primary", &calendar.Event{
+		Summary: "Design review",
+		Start:   &calendar.EventDateTime{DateTime: "2026-05-18T10:00:00Z"},
+		End:     &calendar.EventDateTime{DateTime: "2026-05-18T11:00:00Z"},
+	})
+	if err != nil {
+		t.Fatalf("CreateEvent: %v", err)
+	}
+	for _, event := range []*calendar.Event{
+		{
+			Summary: "Vendor pitch",
+			Start:   &calendar.EventDateTime{DateTime: "2026-05-18T13:00:00Z"},
+			End:     &calendar.EventDateTime{DateTime: "2026-05-18T14:00:00Z"},
+		},
+		{
+			Summary:    "Standup",
+			Recurrence: []string{"RRULE:FREQ=DAILY"},
+			Start:      &calendar.EventDateTime{DateTime: "2026-05-18T09:00:00Z"},
+			End:        &calendar.EventDateTime{DateTime: "2026-05-18T09:15:00Z"},
+		},
+	} {
+		created, err := svc.CreateEvent(context.Background(), "primary", event)
+		if err != nil {
+			t.Fatalf("CreateEvent: %v", err)
+		}
+		created.Status = "cancelled"
+		if _, err := svc.UpdateEvent(context.Background(), "primary", created.Id, created); err != nil {
+			t.Fatalf("UpdateEvent: %v", err)
+		}
+	}
+
+	events, err := svc.ListEvents(context.Background(), "primary", day, day.AddDate(0, 0, 1))
+	if err != nil {
+		t.Fatalf("ListEvents: %v", err)
+	}
+	if len(events) != 1 || events[0].Id != kept.Id {
+		t.Errorf("ListEvents = %v, want only the design review", summaries(events))
+	}
+	series, err := svc.ListRecurringSeries(context.Background(), "primary", day, time.Time{})
+	if err != nil {
+		t.Fatalf("ListRecurringSeries: %v", err)
+	}
+	if len(series) != 0 {
+		t.Errorf("ListRecurringSeries = %v, want the cancelled series left out", summaries(series))
+	}
+}
+
+func TestInMemoryListRecurringSeries(t *testing.T) {
+	svc := NewInMemoryCalendarService(zap.NewNop(), &config.Config{})
+	series, err := svc.CreateEvent(context.Background(), "primary", &calendar.Event{
+		Summary:    "Standup",
+		Recurrence: []string{"RRULE:FREQ=WEEKLY;BYDAY=MO"},
+		Start:      &calendar.EventDateTime{DateTime: "2026-05-04T09:00:00Z"},
+		End:        &calendar.EventDateTime{DateTime: "2026-05-04T09:15:00Z"},
+	})
+	if err != nil {
+		t.Fatalf("CreateEvent: %v", err)
+	}
+	if _, err := svc.CreateEvent(context.Background(), "primary", &calendar.Event{
+		Summary: "One-off",
+		Start:   &calendar.EventDateTime{DateTime: "2026-05-20T10:00:00Z"},
+		End:     &calendar.EventDateTime{DateTime: "2026-05-20T11:00:00Z"},
+	}); err != nil {
+		t.Fatalf("CreateEvent: %v", err)
+	}
+
+	got, err := svc.ListRecurringSeries(context.Background(), "primary", time.Date(2026, 5, 18, 0, 0, 0, 0, time.UTC), time.Time{})
+	if err != nil {
+		t.Fatalf("ListRecurringSeries: %v", err)
+	}
+	if len(got) != 1 || got[0].Id != series.Id {
+		t.Fatalf("ListRecurringSeries = %v, want only the standup series", got)
+	}
+
+	got, err = svc.ListRecurringSeries(context.Background(), "primary", time.Time{}, time.Date(2026, 5, 1, 0, 0, 0, 0, time.UTC))
+	if err != nil {
+		t.Fatalf("ListRecurringSeries: %v", err)
+	}
+	if len(got) != 0 {
+		t.Errorf("ListRecurringSeries before the series starts = %v, want none", got)
+	}
+}
+
+func TestInMemoryListEventsByType(t *testing.T) {
+	svc := NewInMemoryCalendarService(zap.NewNop(), &config.Config{})
+	focus, err := svc.CreateEvent(context.Background(), "primary", &calendar.Event{
+		Summary:   "Deep work",
+		EventType: "focusTime",
+		Start:     &calendar.EventDateTime{DateTime: "2026-05-18T09:00:00Z"},
+		End:       &calendar.EventDateTime{DateTime: "2026-05-18T11:00:00Z"},
+	})
+	if err != nil {
+		t.Fatalf("CreateEvent: %v", err)
+	}
+	meeting, err := svc.CreateEvent(context.Background(), "primary", &calendar.Event{
+		Summary: "Sync",
+		Start:   &calendar.EventDateTime{DateTime: "2026-05-18T13:00:00Z"},
+		End:     &calendar.EventDateTime{DateTime: "2026-05-18T13:30:00Z"},
+	})
+	if err != nil {
+		t.Fatalf("CreateEvent: %v", err)
+	}
+
+	timeMin := time.Date(2026, 5, 18, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		types []string
+		want  []string
+	}{
+		{types: []string{"focusTime"}, want: []string{focus.Id}},
+		{types: []string{"default"}, want: []string{meeting.Id}},
+		{types: []string{"default", "focusTime"}, want: []string{focus.Id, meeting.Id}},
+		{types: []string{"outOfOffice"}, want: nil},
+	}
+	for _, tc := range tests {
+		got, err := svc.ListEventsByType(context.Background(), "primary", tc.types, timeMin, time.Time{})
+		if err != nil {
+			t.Fatalf("ListEventsByType(%v): %v", tc.types, err)
+		}
+		var ids []string
+		for _, event := range got {
+			ids = append(ids, event.Id)
+		}
+		if strings.Join(ids, ",") != strings.Join(tc.want, ",") {
+			t.Errorf("ListEventsByType(%v) = %v, want %v", tc.types, ids, tc.want)
+		}
+	}
+}
@@ -0,0 +1,99 @@
+package llm
+
+import (
+	"context"
+
+	sdk "github.com/inference-gateway/sdk"
+	zap "go.uber.org/zap"
+
+	server "github.com/inference-gateway/adk/server"
+)
+
+// FallbackClient sends each request to a primary LLM client and, when that
+// fails, retries it once on a secondary client configured with another
+// model. The primary client has already exhausted its own retries by then,
+// so the fallback covers outages and rate limits of the primary model.
+type FallbackClient struct {
+	primary       server.LLMClient
+	fallback      server.LLMClient
+	fallbackModel string
+	logger        *zap.Logger
+}
+
+var _ server.LLMClient = (*FallbackClient)(nil)
+
+// NewFallbackClient wraps primary so failed requests are retried on
+// fallback, which serves fallbackModel.
+func NewFallbackClient(primary, fallback server.LLMClient, fallbackModel string, logger *zap.Logger) *FallbackClient {
+	return &FallbackClient{
+		primary:       primary,
+		fallback:      fallback,
+		fallbackModel: fallbackModel,
+		logger:        logger,
+	}
+}
+
+// CreateChatCompletion implements server.LLMClient.
+func (c *FallbackClient) CreateChatCompletion(ctx context.Context, messages []sdk.Message, tools ...sdk.ChatCompletionTool) (*sdk.CreateChatCompletionResponse, error) {
+	resp, err := c.primary.CreateChatCompletion(ctx, messages, tools...)
+	if err == nil || ctx.Err() != nil {
+		return resp, err
+	}
+	c.logFallback(err)
+	return c.fallback.CreateChatCompletion(ctx, messages, tools...)
+}
+
+// CreateStreamingChatCompletion implements server.LLMClient. The stream
+// falls back only if the primary fails before sending its first chunk;
+// once output has reached the caller, switching models would splice two
+// different answers together, so later errors are passed through.
+func (c *FallbackClient) CreateStreamingChatCompletion(ctx context.Context, messages []sdk.Message, tools ...sdk.ChatCompletionTool) (<-chan *sdk.CreateChatCompletionStreamResponse, <-chan error) {
+	chunks, errs := c.primary.CreateStreamingChatCompletion(ctx, messages, tools...)
+
+	out := make(chan *sdk.CreateChatCompletionStreamResponse)
+	outErrs := make(chan error, 1)
+	go func() {
+		defer close(out)
+		defer close(outErrs)
+
+		started := false
+		for chunks != nil || errs != nil {
+			select {
+			case chunk, ok := <-chunks:
+				if !ok {
+					chunks = nil
+					continue
+				}
+				started = true
+				select {
+				case out <- chunk:
+				case <-ctx.Done():
+					return
+				}
+			case err, ok := <-errs:
+				if !ok {
+					errs = nil
+					continue
+				}
+				if err == nil {
+					continue
+				}
+				if started || ctx.Err() != nil {
+					outErrs <- err
+					return
+				}
+				c.logFallback(err)
+				chunks, errs = c.fallback.CreateStreamingChatCompletion(ctx, messages, tools...)
+				started = true
+			}
+		}
+	}()
+
+	return out, outErrs
+}
+
+func (c *FallbackClient) logFallback(err error) {
+	c.logger.Warn("primary LLM request failed, retrying with fallback model",
+		zap.String("fallbackModel", c.fallbackModel),
+		zap.Error(err))
+}
@@ -0,0 +1,143 @@
+package llm
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	sdk "github.com/inference-gateway/sdk"
+	zap "go.uber.org/zap"
+	observer "go.uber.org/zap/zaptest/observer"
+
+	server "github.com/inference-gateway/adk/server"
+)
+
+// scriptedLLMClient answers with model as the response ID, or fails with
+// err. Streams send chunksBeforeErr chunks before failing.
+type scriptedLLMClient struct {
+	model           string
+	err             error
+	chunksBeforeErr int
+	calls           int
+}
+
+func (s *scriptedLLMClient) CreateChatCompletion(ctx context.Context, messages []sdk.Message, tools ...sdk.ChatCompletionTool) (*sdk.CreateChatCompletionResponse, error) {
+	s.calls++
+	if s.err != nil {
+		return nil, s.err
+	}
+	return &sdk.CreateChatCompletionResponse{ID: s.model}, nil
+}
+
+func (s *scriptedLLMClient) CreateStreamingChatCompletion(ctx context.Context, messages []sdk.Message, tools ...sdk.ChatCompletionTool) (<-chan *sdk.CreateChatCompletionStreamResponse, <-chan error) {
+	s.calls++
+	errs := make(chan error, 1)
+	if s.err == nil {
+		chunks := make(chan *sdk.CreateChatCompletionStreamResponse, 1)
+		chunks <- &sdk.CreateChatCompletionStreamResponse{ID: s.model}
+		close(chunks)
+		close(errs)
+		return chunks, errs
+	}
+	// Unbuffered, so every chunk is consumed before the error is sent.
+	chunks := make(chan *sdk.CreateChatCompletionStreamResponse)
+	go func() {
+		for i := 0; i < s.chunksBeforeErr; i++ {
+			chunks <- &sdk.CreateChatCompletionStreamResponse{ID: s.model}
+		}
+		close(chunks)
+		errs <- s.err
+		close(errs)
+	}()
+	return chunks, errs
+}
+
+var _ server.LLMClient = (*scriptedLLMClient)(nil)
+
+func TestFallbackClientCreateChatCompletion(t *testing.T) {
+	tests := []struct {
+		name          string
+		primaryErr    error
+		fallbackErr   error
+		wantID        string
+		wantErr       bool
+		wantFallbacks int
+	}{
+		{name: "primary succeeds", wantID: "primary"},
+		{name: "primary fails and fallback succeeds", primaryErr: errors.New("429 rate limited"), wantID: "fallback", wantFallbacks: 1},
+		{name: "both fail", primaryErr: errors.New("503"), fallbackErr: errors.New("503"), wantErr: true, wantFallbacks: 1},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			primary := &scriptedLLMClient{model: "primary", err: tc.primaryErr}
+			fallback := &scriptedLLMClient{model: "fallback", err: tc.fallbackErr}
+			core, logs := observer.New(zap.WarnLevel)
+			client := NewFallbackClient(primary, fallback, "gpt-4o-mini", zap.New(core))
+
+			resp, err := client.CreateChatCompletion(context.Background(), nil)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatal("expected error")
+				}
+			} else {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				if resp.ID != tc.wantID {
+					t.Errorf("answered by %q, want %q", resp.ID, tc.wantID)
+				}
+			}
+
+			if fallback.calls != tc.wantFallbacks {
+				t.Errorf("fallback calls = %d, want %d", fallback.calls, tc.wantFallbacks)
+			}
+			if got := logs.FilterMessage("primary LLM request failed, retrying with fallback model").Len(); got != tc.wantFallbacks {
+				t.Errorf("fallback log entries = %d, want %d", got, tc.wantFallbacks)
+			}
+		})
+	}
+}
+
+func TestFallbackClientCreateStreamingChatCompletion(t *testing.T) {
+	tests := []struct {
+		name            string
+		primaryErr      error
+		chunksBeforeErr int
+		wantIDs         []string
+		wantErr         bool
+		wantFallbacks   int
+	}{
+		{name: "primary streams", wantIDs: []string{"primary"}},
+		{name: "primary fails before output", primaryErr: errors.New("429"), wantIDs: []string{"fallback"}, wantFallbacks: 1},
+		{name: "primary fails mid-stream", primaryErr: errors.New("connection reset"), chunksBeforeErr: 1, wantIDs: []string{"primary"}, wantErr: true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			primary := &scriptedLLMClient{model: "primary", err: tc.primaryErr, chunksBeforeErr: tc.chunksBeforeErr}
+			fallback := &scriptedLLMClient{model: "fallback"}
+			client := NewFallbackClient(primary, fallback, "gpt-4o-mini", zap.NewNop())
+
+			chunks, errs := client.CreateStreamingChatCompletion(context.Background(), nil)
+			var ids []string
+			for chunk := range chunks {
+				ids = append(ids, chunk.ID)
+			}
+			var streamErr error
+			for err := range errs {
+				streamErr = err
+			}
+
+			if (streamErr != nil) != tc.wantErr {
+				t.Errorf("stream error = %v, wantErr %v", streamErr, tc.wantErr)
+			}
+			if len(ids) != len(tc.wantIDs) || (len(ids) > 0 && ids[0] != tc.wantIDs[0]) {
+				t.Errorf("chunks from %v, want %v", ids, tc.wantIDs)
+			}
+			if fallback.calls != tc.wantFallbacks {
+				t.Errorf("fallback calls = %d, want %d", fallback.calls, tc.wantFallbacks)
+			}
+		})
+	}
+}
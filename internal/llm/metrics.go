@@ -0,0 +1,132 @@
+package llm
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	sdk "github.com/inference-gateway/sdk"
+	attribute "go.opentelemetry.io/otel/attribute"
+	metric "go.opentelemetry.io/otel/metric"
+
+	server "github.com/inference-gateway/adk/server"
+)
+
+// InstrumentedClient wraps an LLM client and records call latency and
+// token usage per provider and model, so LLM cost can be attributed next to
+// the ADK's request metrics.
+type InstrumentedClient struct {
+	next       server.LLMClient
+	attrs      metric.MeasurementOption
+	latency    metric.Float64Histogram
+	prompt     metric.Int64Counter
+	completion metric.Int64Counter
+}
+
+var _ server.LLMClient = (*InstrumentedClient)(nil)
+
+// NewInstrumentedClient creates the wrapper and its instruments on meter.
+func NewInstrumentedClient(next server.LLMClient, meter metric.Meter, provider, model string) (*InstrumentedClient, error) {
+	latency, err := meter.Float64Histogram(
+		"llm.request.duration",
+		metric.WithDescription("Duration of chat completion calls to the LLM provider"),
+		metric.WithUnit("s"),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create LLM latency histogram: %w", err)
+	}
+
+	prompt, err := meter.Int64Counter(
+		"llm.prompt_tokens",
+		metric.WithDescription("Prompt tokens sent to the LLM provider"),
+		metric.WithUnit("{token}"),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create prompt tokens counter: %w", err)
+	}
+
+	completion, err := meter.Int64Counter(
+		"llm.completion_tokens",
+		metric.WithDescription("Completion tokens returned by the LLM provider"),
+		metric.WithUnit("{token}"),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create completion tokens counter: %w", err)
+	}
+
+	return &InstrumentedClient{
+		next: next,
+		attrs: metric.WithAttributes(
+			attribute.String("provider", provider),
+			attribute.String("model", model),
+		),
+		latency:    latency,
+		prompt:     prompt,
+		completion: completion,
+	}, nil
+}
+
+// CreateChatCompletion implements server.LLMClient.
+func (c *InstrumentedClient) CreateChatCompletion(ctx context.Context, messages []sdk.Message, tools ...sdk.ChatCompletionTool) (*sdk.CreateChatCompletionResponse, error) {
+	start := time.Now()
+	resp, err := c.next.CreateChatCompletion(ctx, messages, tools...)
+	c.latency.Record(ctx, time.Since(start).Seconds(), c.attrs)
+	if err == nil && resp != nil {
+		c.recordUsage(ctx, resp.Usage)
+	}
+	return resp, err
+}
+
+// CreateStreamingChatCompletion implements server.LLMClient. Latency covers
+// the whole stream and usage is taken from the chunk that reports it.
+func (c *InstrumentedClient) CreateStreamingChatCompletion(ctx context.Context, messages []sdk.Message, tools ...sdk.ChatCompletionTool) (<-chan *sdk.CreateChatCompletionStreamResponse, <-chan error) {
+	start := time.Now()
+	chunks, errs := c.next.CreateStreamingChatCompletion(ctx, messages, tools...)
+
+	out := make(chan *sdk.CreateChatCompletionStreamResponse)
+	outErrs := make(chan error, 1)
+	go func() {
+		defer close(out)
+		defer close(outErrs)
+		defer func() {
+			c.latency.Record(ctx, time.Since(start).Seconds(), c.attrs)
+		}()
+
+		for chunks != nil || errs != nil {
+			select {
+			case chunk, ok := <-chunks:
+				if !ok {
+					chunks = nil
+					continue
+				}
+				if chunk != nil {
+					c.recordUsage(ctx, chunk.Usage)
+				}
+				select {
+				case out <- chunk:
+				case <-ctx.Done():
+					return
+				}
+			case err, ok := <-errs:
+				if !ok {
+					errs = nil
+					continue
+				}
+				if err != nil {
+					outErrs <- err
+					return
+				}
+			}
+		}
+	}()
+
+	return out, outErrs
+}
+
+func (c *InstrumentedClient) recordUsage(ctx context.Context, usage *sdk.CompletionUsage) {
+	if usage == nil {
+		return
+	}
+	c.prompt.Add(ctx, usage.PromptTokens, c.attrs)
+	c.completion.Add(ctx, usage.CompletionTokens, c.attrs)
+}
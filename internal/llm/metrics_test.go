@@ -0,0 +1,104 @@
+package llm
+
+import (
+	"context"
+	"testing"
+
+	sdk "github.com/inference-gateway/sdk"
+	attribute "go.opentelemetry.io/otel/attribute"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	metricdata "go.opentelemetry.io/otel/sdk/metric/metricdata"
+
+	server "github.com/inference-gateway/adk/server"
+)
+
+// fakeLLMClient returns canned responses with the given usage.
+type fakeLLMClient struct {
+	usage *sdk.CompletionUsage
+}
+
+func (f *fakeLLMClient) CreateChatCompletion(ctx context.Context, messages []sdk.Message, tools ...sdk.ChatCompletionTool) (*sdk.CreateChatCompletionResponse, error) {
+	return &sdk.CreateChatCompletionResponse{Usage: f.usage}, nil
+}
+
+func (f *fakeLLMClient) CreateStreamingChatCompletion(ctx context.Context, messages []sdk.Message, tools ...sdk.ChatCompletionTool) (<-chan *sdk.CreateChatCompletionStreamResponse, <-chan error) {
+	chunks := make(chan *sdk.CreateChatCompletionStreamResponse, 2)
+	errs := make(chan error, 1)
+	chunks <- &sdk.CreateChatCompletionStreamResponse{}
+	chunks <- &sdk.CreateChatCompletionStreamResponse{Usage: f.usage}
+	close(chunks)
+	close(errs)
+	return chunks, errs
+}
+
+var _ server.LLMClient = (*fakeLLMClient)(nil)
+
+func collect(t *testing.T, reader *sdkmetric.ManualReader) map[string]metricdata.Aggregation {
+	t.Helper()
+	var rm metricdata.ResourceMetrics
+	if err := reader.Collect(context.Background(), &rm); err != nil {
+		t.Fatalf("collect: %v", err)
+	}
+	out := map[string]metricdata.Aggregation{}
+	for _, sm := range rm.ScopeMetrics {
+		for _, m := range sm.Metrics {
+			out[m.Name] = m.Data
+		}
+	}
+	return out
+}
+
+func sumValue(t *testing.T, data metricdata.Aggregation) int64 {
+	t.Helper()
+	sum, ok := data.(metricdata.Sum[int64])
+	if !ok || len(sum.DataPoints) != 1 {
+		t.Fatalf("unexpected sum data %#v", data)
+	}
+	dp := sum.DataPoints[0]
+	for _, kv := range []attribute.KeyValue{attribute.String("provider", "openai"), attribute.String("model", "gpt-4o")} {
+		if v, ok := dp.Attributes.Value(kv.Key); !ok || v != kv.Value {
+			t.Errorf("attribute %s = %v, want %v", kv.Key, v.Emit(), kv.Value.Emit())
+		}
+	}
+	return dp.Value
+}
+
+func TestInstrumentedClientRecordsUsage(t *testing.T) {
+	reader := sdkmetric.NewManualReader()
+	meter := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader)).Meter("test")
+
+	fake := &fakeLLMClient{usage: &sdk.CompletionUsage{PromptTokens: 120, CompletionTokens: 30, TotalTokens: 150}}
+	client, err := NewInstrumentedClient(fake, meter, "openai", "gpt-4o")
+	if err != nil {
+		t.Fatalf("NewInstrumentedClient: %v", err)
+	}
+
+	if _, err := client.CreateChatCompletion(context.Background(), nil); err != nil {
+		t.Fatalf("CreateChatCompletion: %v", err)
+	}
+
+	chunks, errs := client.CreateStreamingChatCompletion(context.Background(), nil)
+	var n int
+	for range chunks {
+		n++
+	}
+	for err := range errs {
+		t.Fatalf("stream error: %v", err)
+	}
+	if n != 2 {
+		t.Errorf("forwarded %d chunks, want 2", n)
+	}
+
+	metrics := collect(t, reader)
+	if got := sumValue(t, metrics["llm.prompt_tokens"]); got != 240 {
+		t.Errorf("prompt tokens = %d, want 240", got)
+	}
+	if got := sumValue(t, metrics["llm.completion_tokens"]); got != 60 {
+		t.Errorf("completion tokens = %d, want 60", got)
+	}
+
+	hist, ok := metrics["llm.request.duration"].(metricdata.Histogram[float64])
+	if !ok || len(hist.DataPoints) != 1 || hist.DataPoints[0].Count != 2 {
+		t.Errorf("latency histogram = %#v, want 2 observations", metrics["llm.request.duration"])
+	}
+}
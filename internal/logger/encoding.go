@@ -0,0 +1,86 @@
+package logger
+
+import (
+	"errors"
+	"sync/atomic"
+
+	zapcore "go.uber.org/zap/zapcore"
+)
+
+// EncodingSwitch selects which of a logger's two encoders, json or console,
+// writes its entries. It is safe to toggle while the logger is in use.
+type EncodingSwitch struct {
+	encodings [2]string
+	alternate atomic.Bool
+}
+
+// Encoding returns the encoding currently in use.
+func (s *EncodingSwitch) Encoding() string {
+	return s.name(s.alternate.Load())
+}
+
+// Toggle switches to the other encoding and returns it.
+func (s *EncodingSwitch) Toggle() string {
+	for {
+		alternate := s.alternate.Load()
+		if s.alternate.CompareAndSwap(alternate, !alternate) {
+			return s.name(!alternate)
+		}
+	}
+}
+
+func (s *EncodingSwitch) name(alternate bool) string {
+	if alternate {
+		return s.encodings[1]
+	}
+	return s.encodings[0]
+}
+
+// switchingCore writes each entry through the core its switch selects.
+// Both cores share the level and sink; only the encoder differs.
+type switchingCore struct {
+	cores    [2]zapcore.Core
+	encoding *EncodingSwitch
+}
+
+// newSwitchingCore returns a core that writes through primary, or through
+// alternate once the returned switch is toggled.
+func newSwitchingCore(primary, alternate zapcore.Core, encodings [2]string) (zapcore.Core, *EncodingSwitch) {
+	sw := &EncodingSwitch{encodings: encodings}
+	return &switchingCore{cores: [2]zapcore.Core{primary, alternate}, encoding: sw}, sw
+}
+
+func (c *switchingCore) active() zapcore.Core {
+	if c.encoding.alternate.Load() {
+		return c.cores[1]
+	}
+	return c.cores[0]
+}
+
+// Enabled reports whether the active core logs the level
+func (c *switchingCore) Enabled(level zapcore.Level) bool {
+	return c.active().Enabled(level)
+}
+
+// With adds structured context to both cores, so it survives a switch
+func (c *switchingCore) With(fields []zapcore.Field) zapcore.Core {
+	return &switchingCore{
+		cores:    [2]zapcore.Core{c.cores[0].With(fields), c.cores[1].With(fields)},
+		encoding: c.encoding,
+	}
+}
+
+// Check defers to the active core, keeping its sampling decisions
+func (c *switchingCore) Check(entry zapcore.Entry, checked *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	return c.active().Check(entry, checked)
+}
+
+// Write writes the entry through the active core
+func (c *switchingCore) Write(entry zapcore.Entry, fields []zapcore.Field) error {
+	return c.active().Write(entry, fields)
+}
+
+// Sync flushes both cores
+func (c *switchingCore) Sync() error {
+	return errors.Join(c.cores[0].Sync(), c.cores[1].Sync())
+}
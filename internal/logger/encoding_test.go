@@ -0,0 +1,82 @@
+package logger
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	zap "go.uber.org/zap"
+	zapcore "go.uber.org/zap/zapcore"
+
+	config "github.com/inference-gateway/google-calendar-agent/config"
+)
+
+func TestSwitchingCoreTogglesEncoder(t *testing.T) {
+	var buf bytes.Buffer
+	encoderConfig := zap.NewProductionEncoderConfig()
+	sink := zapcore.AddSync(&buf)
+	core, encoding := newSwitchingCore(
+		zapcore.NewCore(zapcore.NewJSONEncoder(encoderConfig), sink, zapcore.InfoLevel),
+		zapcore.NewCore(zapcore.NewConsoleEncoder(encoderConfig), sink, zapcore.InfoLevel),
+		[2]string{"json", "console"},
+	)
+	log := zap.New(core).With(zap.String("component", "test"))
+
+	log.Info("first")
+	if !json.Valid(bytes.TrimSpace(buf.Bytes())) {
+		t.Fatalf("line before toggling = %q, want json", buf.String())
+	}
+
+	if got := encoding.Toggle(); got != "console" {
+		t.Fatalf("Toggle() = %q, want console", got)
+	}
+	buf.Reset()
+	log.Info("second")
+	line := buf.String()
+	if json.Valid(bytes.TrimSpace(buf.Bytes())) || !strings.Contains(line, "\tsecond\t") {
+		t.Fatalf("line after toggling = %q, want console encoding", line)
+	}
+	if !strings.Contains(line, `"component": "test"`) {
+		t.Errorf("line after toggling = %q, want the logger's context kept", line)
+	}
+
+	if got := encoding.Toggle(); got != "json" {
+		t.Fatalf("second Toggle() = %q, want json", got)
+	}
+	buf.Reset()
+	log.Info("third")
+	if !json.Valid(bytes.TrimSpace(buf.Bytes())) {
+		t.Errorf("line after toggling back = %q, want json", buf.String())
+	}
+}
+
+func TestNewLoggerEncoding(t *testing.T) {
+	tests := []struct {
+		name      string
+		debug     bool
+		want      string
+		wantOther string
+	}{
+		{name: "production starts as json", want: "json", wantOther: "console"},
+		{name: "debug starts as console", debug: true, want: "console", wantOther: "json"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			cfg := &config.Config{}
+			cfg.A2A.Debug = tc.debug
+			_, encoding, err := NewLogger(context.Background(), cfg)
+			if err != nil {
+				t.Fatalf("NewLogger: %v", err)
+			}
+			if got := encoding.Encoding(); got != tc.want {
+				t.Errorf("Encoding() = %q, want %q", got, tc.want)
+			}
+			if got := encoding.Toggle(); got != tc.wantOther {
+				t.Errorf("Toggle() = %q, want %q", got, tc.wantOther)
+			}
+		})
+	}
+}
@@ -1,6 +1,6 @@
-// Code generated by ADL CLI v0.55.0. DO NOT EDIT.
-// This file was automatically generated from an ADL (Agent Definition Language) specification.
-// Manual changes to this file may be overwritten during regeneration.
+// Generated by ADL CLI v0.55.0 from an ADL (Agent Definition Language)
+// specification and maintained by hand since: it is listed in .adl-ignore,
+// so `task generate` no longer rewrites it.
 
 package logger
 
@@ -13,8 +13,9 @@ import (
 	config "github.com/inference-gateway/google-calendar-agent/config"
 )
 
-// NewLogger creates a new zap.Logger instance
-func NewLogger(ctx context.Context, cfg *config.Config) (*zap.Logger, error) {
+// NewLogger creates a new zap.Logger instance. The returned switch flips
+// its encoding between json and console at runtime.
+func NewLogger(ctx context.Context, cfg *config.Config) (*zap.Logger, *EncodingSwitch, error) {
 	var zapConfig zap.Config
 
 	if cfg.A2A.Debug {
@@ -28,10 +29,36 @@ func NewLogger(ctx context.Context, cfg *config.Config) (*zap.Logger, error) {
 	zapConfig.EncoderConfig.TimeKey = "timestamp"
 	zapConfig.EncoderConfig.EncodeTime = zapcore.ISO8601TimeEncoder
 
-	zapLogger, err := zapConfig.Build()
+	// Build the same logger with the other encoding and keep only its core;
+	// color codes are left out of json.
+	alternateConfig := zapConfig
+	alternateConfig.Encoding = "json"
+	if zapConfig.Encoding == "json" {
+		alternateConfig.Encoding = "console"
+	}
+	alternateConfig.EncoderConfig.EncodeLevel = zapcore.CapitalLevelEncoder
+	var alternateCore zapcore.Core
+	if _, err := alternateConfig.Build(zap.WrapCore(func(core zapcore.Core) zapcore.Core {
+		alternateCore = core
+		return core
+	})); err != nil {
+		return nil, nil, err
+	}
+
+	var encoding *EncodingSwitch
+	opts := []zap.Option{zap.WrapCore(func(core zapcore.Core) zapcore.Core {
+		var switching zapcore.Core
+		switching, encoding = newSwitchingCore(core, alternateCore, [2]string{zapConfig.Encoding, alternateConfig.Encoding})
+		return switching
+	})}
+	if cfg.Log.RedactPII {
+		opts = append(opts, zap.WrapCore(NewRedactingCore))
+	}
+
+	zapLogger, err := zapConfig.Build(opts...)
 	if err != nil {
-		return nil, err
+		return nil, nil, err
 	}
 
-	return zapLogger, nil
+	return zapLogger, encoding, nil
 }
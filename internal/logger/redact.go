@@ -0,0 +1,62 @@
+package logger
+
+import (
+	"errors"
+
+	zapcore "go.uber.org/zap/zapcore"
+
+	redact "github.com/inference-gateway/google-calendar-agent/internal/redact"
+)
+
+// redactingCore masks personal data in log fields before they are encoded:
+// email addresses in strings, errors and tool arguments are masked and
+// descriptions are shortened. Event IDs and summaries are kept so log lines
+// can still be matched to events.
+type redactingCore struct {
+	zapcore.Core
+}
+
+// NewRedactingCore wraps core so that every entry written through it is
+// redacted.
+func NewRedactingCore(core zapcore.Core) zapcore.Core {
+	return &redactingCore{Core: core}
+}
+
+// With adds redacted structured context to the core
+func (c *redactingCore) With(fields []zapcore.Field) zapcore.Core {
+	return &redactingCore{Core: c.Core.With(redactFields(fields))}
+}
+
+// Check adds this core to the checked entry when the level is enabled
+func (c *redactingCore) Check(entry zapcore.Entry, checked *zapcore.CheckedEntry) *zapcore.CheckedEntry {
+	if c.Enabled(entry.Level) {
+		return checked.AddCore(entry, c)
+	}
+	return checked
+}
+
+// Write redacts the fields and writes the entry to the wrapped core
+func (c *redactingCore) Write(entry zapcore.Entry, fields []zapcore.Field) error {
+	return c.Core.Write(entry, redactFields(fields))
+}
+
+func redactFields(fields []zapcore.Field) []zapcore.Field {
+	redacted := make([]zapcore.Field, len(fields))
+	for i, f := range fields {
+		switch f.Type {
+		case zapcore.StringType:
+			if f.Key == "description" {
+				f.String = redact.Description(f.String)
+			}
+			f.String = redact.Emails(f.String)
+		case zapcore.ReflectType:
+			f.Interface = redact.Value(f.Interface)
+		case zapcore.ErrorType:
+			if err, ok := f.Interface.(error); ok && err != nil {
+				f.Interface = errors.New(redact.Emails(err.Error()))
+			}
+		}
+		redacted[i] = f
+	}
+	return redacted
+}
@@ -0,0 +1,60 @@
+package logger
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	zap "go.uber.org/zap"
+	zapcore "go.uber.org/zap/zapcore"
+	observer "go.uber.org/zap/zaptest/observer"
+)
+
+func TestRedactingCore(t *testing.T) {
+	core, logs := observer.New(zapcore.DebugLevel)
+	log := zap.New(NewRedactingCore(core)).With(zap.String("organizer", "owner@example.com"))
+
+	log.Debug("creating calendar event",
+		zap.Any("args", map[string]any{
+			"summary":     "Design review",
+			"description": "Go through the hiring plan for the platform team",
+			"attendees":   []any{"alice@example.com", "bob@example.com"},
+		}),
+		zap.String("eventId", "evt-1"),
+		zap.String("description", "Private notes about the candidate"),
+		zap.Error(errors.New("invalid attendee carol@example.com")))
+
+	entries := logs.All()
+	if len(entries) != 1 {
+		t.Fatalf("got %d log lines, want 1", len(entries))
+	}
+	fields := entries[0].ContextMap()
+
+	args, ok := fields["args"].(map[string]any)
+	if !ok {
+		t.Fatalf("args = %T, want a map", fields["args"])
+	}
+	if args["summary"] != "Design review" {
+		t.Errorf("summary = %v, want it kept", args["summary"])
+	}
+	if args["description"] != "Go through the hirin…" {
+		t.Errorf("args description = %v, want it truncated", args["description"])
+	}
+	attendees, _ := args["attendees"].([]any)
+	if len(attendees) != 2 || attendees[0] != "a***@example.com" || attendees[1] != "b***@example.com" {
+		t.Errorf("attendees = %v, want masked emails", args["attendees"])
+	}
+
+	if fields["eventId"] != "evt-1" {
+		t.Errorf("eventId = %v, want it kept", fields["eventId"])
+	}
+	if fields["description"] != "Private notes about…" {
+		t.Errorf("description = %v, want it truncated", fields["description"])
+	}
+	if fields["organizer"] != "o***@example.com" {
+		t.Errorf("organizer = %v, want the With field masked", fields["organizer"])
+	}
+	if err, _ := fields["error"].(string); strings.Contains(err, "carol@example.com") {
+		t.Errorf("error = %q, want the email masked", err)
+	}
+}
@@ -0,0 +1,169 @@
+package notifier
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"net/http"
+	"sync"
+	"time"
+
+	zap "go.uber.org/zap"
+	calendar "google.golang.org/api/calendar/v3"
+
+	config "github.com/inference-gateway/google-calendar-agent/config"
+	google "github.com/inference-gateway/google-calendar-agent/internal/google"
+)
+
+// reminderEventType is the type field of every reminder payload, so a
+// receiver that handles several kinds of notification can tell them apart.
+const reminderEventType = "event.reminder"
+
+// ReminderPayload is the JSON body posted to the webhook for each reminder.
+type ReminderPayload struct {
+	Type              string `json:"type"`
+	CalendarID        string `json:"calendarId"`
+	EventID           string `json:"eventId"`
+	Summary           string `json:"summary"`
+	StartTime         string `json:"startTime"`
+	EndTime           string `json:"endTime,omitempty"`
+	Location          string `json:"location,omitempty"`
+	HTMLLink          string `json:"htmlLink,omitempty"`
+	MinutesUntilStart int    `json:"minutesUntilStart"`
+}
+
+// Reminder polls the default calendar and posts a webhook once for each
+// event that is about to start. Events are remembered by ID and start time,
+// so an event moved to a later time is announced again at its new time.
+type Reminder struct {
+	logger   *zap.Logger
+	google   google.CalendarService
+	client   *http.Client
+	url      string
+	lead     time.Duration
+	interval time.Duration
+	now      func() time.Time
+
+	mu   sync.Mutex
+	sent map[string]time.Time
+}
+
+// NewReminder creates a reminder notifier from cfg. It returns nil when no
+// webhook URL is configured.
+func NewReminder(logger *zap.Logger, svc google.CalendarService, cfg config.NotifierConfig) *Reminder {
+	if cfg.WebhookURL == "" {
+		return nil
+	}
+	return &Reminder{
+		logger:   logger,
+		google:   svc,
+		client:   &http.Client{Timeout: 10 * time.Second},
+		url:      cfg.WebhookURL,
+		lead:     cfg.ReminderLeadTime,
+		interval: cfg.PollInterval,
+		now:      time.Now,
+		sent:     make(map[string]time.Time),
+	}
+}
+
+// Run checks for upcoming events every poll interval until ctx is done.
+func (r *Reminder) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+	for {
+		if err := r.check(ctx); err != nil {
+			r.logger.Warn("failed to check for upcoming events", zap.Error(err))
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// check posts a reminder for every event starting within the lead time
+// that has not been announced yet. A failed post is retried on the next
+// check; all-day and cancelled events are skipped.
+func (r *Reminder) check(ctx context.Context) error {
+	now := r.now()
+	calendarID := r.google.GetCalendarID()
+	events, err := r.google.ListEvents(ctx, calendarID, now, now.Add(r.lead))
+	if err != nil {
+		return fmt.Errorf("failed to list upcoming events: %w", err)
+	}
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for key, start := range r.sent {
+		if start.Before(now) {
+			delete(r.sent, key)
+		}
+	}
+
+	for _, event := range events {
+		if event.Status == "cancelled" || event.Start == nil || event.Start.DateTime == "" {
+			continue
+		}
+		start, err := time.Parse(time.RFC3339, event.Start.DateTime)
+		if err != nil || start.Before(now) || start.Sub(now) > r.lead {
+			continue
+		}
+		key := event.Id + "@" + event.Start.DateTime
+		if _, done := r.sent[key]; done {
+			continue
+		}
+		if err := r.post(ctx, reminderPayload(calendarID, event, start.Sub(now))); err != nil {
+			r.logger.Warn("failed to send event reminder",
+				zap.String("eventId", event.Id),
+				zap.Error(err))
+			continue
+		}
+		r.sent[key] = start
+		r.logger.Info("sent event reminder",
+			zap.String("eventId", event.Id),
+			zap.String("startTime", event.Start.DateTime))
+	}
+	return nil
+}
+
+// post delivers one payload; any non-2xx response is an error.
+func (r *Reminder) post(ctx context.Context, payload ReminderPayload) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal reminder: %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, r.url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to build webhook request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to call webhook: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook returned status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+func reminderPayload(calendarID string, event *calendar.Event, until time.Duration) ReminderPayload {
+	payload := ReminderPayload{
+		Type:              reminderEventType,
+		CalendarID:        calendarID,
+		EventID:           event.Id,
+		Summary:           event.Summary,
+		StartTime:         event.Start.DateTime,
+		Location:          event.Location,
+		HTMLLink:          event.HtmlLink,
+		MinutesUntilStart: int(math.Ceil(until.Minutes())),
+	}
+	if event.End != nil {
+		payload.EndTime = event.End.DateTime
+	}
+	return payload
+}
@@ -0,0 +1,151 @@
+package notifier
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	zap "go.uber.org/zap"
+	calendar "google.golang.org/api/calendar/v3"
+
+	config "github.com/inference-gateway/google-calendar-agent/config"
+	google "github.com/inference-gateway/google-calendar-agent/internal/google"
+)
+
+func TestReminderCheck(t *testing.T) {
+	tests := []struct {
+		name      string
+		events    []*calendar.Event
+		wantCalls int
+		wantEvent string
+	}{
+		{
+			name: "event within the lead time fires once",
+			events: []*calendar.Event{{
+				Summary: "Standup",
+				Start:   &calendar.EventDateTime{DateTime: "2026-05-18T09:05:00Z"},
+				End:     &calendar.EventDateTime{DateTime: "2026-05-18T09:15:00Z"},
+			}},
+			wantCalls: 1,
+			wantEvent: "Standup",
+		},
+		{
+			name: "event beyond the lead time does not fire",
+			events: []*calendar.Event{{
+				Summary: "Review",
+				Start:   &calendar.EventDateTime{DateTime: "2026-05-18T10:00:00Z"},
+				End:     &calendar.EventDateTime{DateTime: "2026-05-18T11:00:00Z"},
+			}},
+		},
+		{
+			name: "all-day event does not fire",
+			events: []*calendar.Event{{
+				Summary: "Holiday",
+				Start:   &calendar.EventDateTime{Date: "2026-05-18"},
+				End:     &calendar.EventDateTime{Date: "2026-05-19"},
+			}},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			now := time.Date(2026, 5, 18, 9, 0, 0, 0, time.UTC)
+			var mu sync.Mutex
+			var received []ReminderPayload
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				var payload ReminderPayload
+				if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+					t.Errorf("failed to decode webhook body: %v", err)
+				}
+				mu.Lock()
+				received = append(received, payload)
+				mu.Unlock()
+			}))
+			defer srv.Close()
+
+			svc := google.NewInMemoryCalendarService(zap.NewNop(), &config.Config{})
+			for _, event := range tc.events {
+				if _, err := svc.CreateEvent(context.Background(), svc.GetCalendarID(), event); err != nil {
+					t.Fatalf("CreateEvent: %v", err)
+				}
+			}
+
+			r := NewReminder(zap.NewNop(), svc, config.NotifierConfig{
+				PollInterval:     time.Minute,
+				ReminderLeadTime: 10 * time.Minute,
+				WebhookURL:       srv.URL,
+			})
+			r.now = func() time.Time { return now }
+
+			// Later polls still see the event inside the window.
+			for i := 0; i < 3; i++ {
+				if err := r.check(context.Background()); err != nil {
+					t.Fatalf("check: %v", err)
+				}
+				now = now.Add(time.Minute)
+			}
+
+			if len(received) != tc.wantCalls {
+				t.Fatalf("webhook called %d times, want %d", len(received), tc.wantCalls)
+			}
+			if tc.wantCalls == 0 {
+				return
+			}
+			got := received[0]
+			if got.Type != reminderEventType || got.Summary != tc.wantEvent || got.EventID == "" {
+				t.Errorf("payload = %+v, want a %s reminder for %q", got, reminderEventType, tc.wantEvent)
+			}
+			if got.MinutesUntilStart != 5 {
+				t.Errorf("minutesUntilStart = %d, want 5", got.MinutesUntilStart)
+			}
+		})
+	}
+}
+
+func TestReminderRetriesFailedWebhook(t *testing.T) {
+	now := time.Date(2026, 5, 18, 9, 0, 0, 0, time.UTC)
+	calls := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls == 1 {
+			w.WriteHeader(http.StatusBadGateway)
+		}
+	}))
+	defer srv.Close()
+
+	svc := google.NewInMemoryCalendarService(zap.NewNop(), &config.Config{})
+	if _, err := svc.CreateEvent(context.Background(), svc.GetCalendarID(), &calendar.Event{
+		Summary: "Standup",
+		Start:   &calendar.EventDateTime{DateTime: "2026-05-18T09:05:00Z"},
+		End:     &calendar.EventDateTime{DateTime: "2026-05-18T09:15:00Z"},
+	}); err != nil {
+		t.Fatalf("CreateEvent: %v", err)
+	}
+
+	r := NewReminder(zap.NewNop(), svc, config.NotifierConfig{
+		PollInterval:     time.Minute,
+		ReminderLeadTime: 10 * time.Minute,
+		WebhookURL:       srv.URL,
+	})
+	r.now = func() time.Time { return now }
+
+	for i := 0; i < 3; i++ {
+		if err := r.check(context.Background()); err != nil {
+			t.Fatalf("check: %v", err)
+		}
+	}
+	if calls != 2 {
+		t.Errorf("webhook called %d times, want a failed call and one retry", calls)
+	}
+}
+
+func TestNewReminderDisabledWithoutURL(t *testing.T) {
+	svc := google.NewInMemoryCalendarService(zap.NewNop(), &config.Config{})
+	if r := NewReminder(zap.NewNop(), svc, config.NotifierConfig{}); r != nil {
+		t.Errorf("NewReminder without a webhook URL = %v, want nil", r)
+	}
+}
@@ -0,0 +1,132 @@
+package ratelimit
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	zap "go.uber.org/zap"
+
+	server "github.com/inference-gateway/adk/server"
+)
+
+// Limiter decides whether a caller identified by key may run another
+// tool call. Implementations must be safe for concurrent use so that a
+// shared backend (e.g. Redis) can replace the in-memory one.
+type Limiter interface {
+	// Allow consumes a token for key. When no token is available it
+	// returns false and how long the caller should wait before retrying.
+	Allow(key string) (bool, time.Duration)
+}
+
+// bucket is a single token bucket.
+type bucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// MemoryLimiter is a per-key token bucket limiter held in process
+// memory. Each key starts with a full bucket of burst tokens which refill
+// at rps tokens per second.
+type MemoryLimiter struct {
+	mu      sync.Mutex
+	rps     float64
+	burst   float64
+	buckets map[string]*bucket
+	now     func() time.Time
+}
+
+// NewMemoryLimiter creates an in-memory token bucket limiter. rps must be
+// positive. A burst below one is raised to one so that a single call is
+// always possible.
+func NewMemoryLimiter(rps float64, burst int) *MemoryLimiter {
+	if burst < 1 {
+		burst = 1
+	}
+	return &MemoryLimiter{
+		rps:     rps,
+		burst:   float64(burst),
+		buckets: make(map[string]*bucket),
+		now:     time.Now,
+	}
+}
+
+// Allow implements Limiter.
+func (l *MemoryLimiter) Allow(key string) (bool, time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := l.now()
+	b, ok := l.buckets[key]
+	if !ok {
+		l.evictIdle(now)
+		b = &bucket{tokens: l.burst, last: now}
+		l.buckets[key] = b
+	}
+
+	b.tokens = math.Min(l.burst, b.tokens+now.Sub(b.last).Seconds()*l.rps)
+	b.last = now
+
+	if b.tokens >= 1 {
+		b.tokens--
+		return true, 0
+	}
+
+	wait := time.Duration((1 - b.tokens) / l.rps * float64(time.Second))
+	return false, wait
+}
+
+// evictIdle drops buckets that have been idle long enough to refill
+// completely; they are indistinguishable from a fresh bucket, so
+// forgetting them bounds memory without changing behaviour.
+func (l *MemoryLimiter) evictIdle(now time.Time) {
+	full := time.Duration(l.burst / l.rps * float64(time.Second))
+	for key, b := range l.buckets {
+		if now.Sub(b.last) >= full {
+			delete(l.buckets, key)
+		}
+	}
+}
+
+// BeforeToolCallback returns an ADK callback that throttles tool calls
+// per A2A conversation (context ID). When a conversation runs out of
+// tokens the tool is skipped and the LLM receives a rate-limit result
+// carrying retryAfterSeconds, the tool-call equivalent of HTTP 429 with
+// a Retry-After header.
+func BeforeToolCallback(limiter Limiter, logger *zap.Logger) server.BeforeToolCallback {
+	return func(ctx context.Context, tool server.Tool, args map[string]any, toolCtx *server.ToolContext) map[string]any {
+		key := "anonymous"
+		if toolCtx != nil && toolCtx.ContextID != "" {
+			key = toolCtx.ContextID
+		}
+
+		allowed, retryAfter := limiter.Allow(key)
+		if allowed {
+			return nil
+		}
+
+		retrySeconds := int(math.Ceil(retryAfter.Seconds()))
+		toolName := ""
+		if tool != nil {
+			toolName = tool.GetName()
+		}
+		logger.Warn("tool call rate limited",
+			zap.String("contextId", key),
+			zap.String("tool", toolName),
+			zap.Int("retryAfterSeconds", retrySeconds))
+
+		result, err := json.Marshal(map[string]any{
+			"success":           false,
+			"error":             "rate limit exceeded",
+			"message":           fmt.Sprintf("Too many calendar requests in this conversation. Retry after %d seconds.", retrySeconds),
+			"retryAfterSeconds": retrySeconds,
+		})
+		if err != nil {
+			return map[string]any{"result": "rate limit exceeded"}
+		}
+		return map[string]any{"result": string(result)}
+	}
+}
@@ -0,0 +1,87 @@
+package ratelimit
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	zap "go.uber.org/zap"
+
+	server "github.com/inference-gateway/adk/server"
+)
+
+func TestMemoryLimiterAllow(t *testing.T) {
+	now := time.Date(2026, 5, 23, 10, 0, 0, 0, time.UTC)
+	l := NewMemoryLimiter(1, 3)
+	l.now = func() time.Time { return now }
+
+	for i := 0; i < 3; i++ {
+		if ok, _ := l.Allow("ctx-a"); !ok {
+			t.Fatalf("call %d within burst was throttled", i+1)
+		}
+	}
+
+	ok, retryAfter := l.Allow("ctx-a")
+	if ok {
+		t.Fatal("call beyond burst was allowed")
+	}
+	if retryAfter != time.Second {
+		t.Errorf("retryAfter = %s, want 1s", retryAfter)
+	}
+
+	if ok, _ := l.Allow("ctx-b"); !ok {
+		t.Error("a different key must have its own bucket")
+	}
+
+	now = now.Add(1500 * time.Millisecond)
+	if ok, _ := l.Allow("ctx-a"); !ok {
+		t.Error("call after refill was throttled")
+	}
+	if ok, _ := l.Allow("ctx-a"); ok {
+		t.Error("only one token should have refilled")
+	}
+}
+
+func TestMemoryLimiterEvictsIdleBuckets(t *testing.T) {
+	now := time.Date(2026, 5, 23, 10, 0, 0, 0, time.UTC)
+	l := NewMemoryLimiter(1, 2)
+	l.now = func() time.Time { return now }
+
+	l.Allow("ctx-a")
+	now = now.Add(time.Minute)
+	l.Allow("ctx-b")
+
+	if _, ok := l.buckets["ctx-a"]; ok {
+		t.Error("idle, fully refilled bucket was not evicted")
+	}
+}
+
+func TestBeforeToolCallback(t *testing.T) {
+	l := NewMemoryLimiter(0.5, 1)
+	now := time.Date(2026, 5, 23, 10, 0, 0, 0, time.UTC)
+	l.now = func() time.Time { return now }
+
+	cb := BeforeToolCallback(l, zap.NewNop())
+	tool := server.NewBasicTool("list_calendar_events", "", nil, nil)
+	toolCtx := &server.ToolContext{ContextID: "ctx-a"}
+
+	if override := cb(context.Background(), tool, nil, toolCtx); override != nil {
+		t.Fatalf("first call should run the tool, got override %v", override)
+	}
+
+	override := cb(context.Background(), tool, nil, toolCtx)
+	if override == nil {
+		t.Fatal("second call should be throttled")
+	}
+	var parsed map[string]any
+	if err := json.Unmarshal([]byte(override["result"].(string)), &parsed); err != nil {
+		t.Fatalf("failed to unmarshal override result: %v", err)
+	}
+	if parsed["success"] != false {
+		t.Errorf("success = %v, want false", parsed["success"])
+	}
+	if parsed["retryAfterSeconds"] != float64(2) {
+		t.Errorf("retryAfterSeconds = %v, want 2", parsed["retryAfterSeconds"])
+	}
+}
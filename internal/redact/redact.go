@@ -0,0 +1,72 @@
+// Package redact masks personal data before it reaches the logs.
+package redact
+
+import (
+	"regexp"
+	"strings"
+)
+
+// maxDescriptionRunes is how much of an event description Description keeps.
+const maxDescriptionRunes = 20
+
+// emailPattern finds email addresses inside free text.
+var emailPattern = regexp.MustCompile(`[A-Za-z0-9._%+\-]+@[A-Za-z0-9.\-]+\.[A-Za-z]{2,}`)
+
+// Email masks the local part of an address, keeping its first character
+// and the domain: "alice@example.com" becomes "a***@example.com".
+func Email(address string) string {
+	local, domain, ok := strings.Cut(address, "@")
+	if !ok || local == "" {
+		return address
+	}
+	return local[:1] + "***@" + domain
+}
+
+// Emails masks every email address found in text.
+func Emails(text string) string {
+	return emailPattern.ReplaceAllStringFunc(text, Email)
+}
+
+// Description shortens an event description to its first few characters,
+// enough to recognise it in a log without exposing its content.
+func Description(text string) string {
+	runes := []rune(text)
+	if len(runes) <= maxDescriptionRunes {
+		return text
+	}
+	return strings.TrimSpace(string(runes[:maxDescriptionRunes])) + "…"
+}
+
+// Value returns a copy of v with every email address in its strings masked
+// and every "description" entry shortened with Description. Maps and slices,
+// as decoded from JSON tool arguments, are walked recursively; other values
+// are returned unchanged.
+func Value(v any) any {
+	switch v := v.(type) {
+	case string:
+		return Emails(v)
+	case map[string]any:
+		masked := make(map[string]any, len(v))
+		for key, item := range v {
+			if text, ok := item.(string); ok && key == "description" {
+				item = Description(text)
+			}
+			masked[key] = Value(item)
+		}
+		return masked
+	case []any:
+		masked := make([]any, len(v))
+		for i, item := range v {
+			masked[i] = Value(item)
+		}
+		return masked
+	case []string:
+		masked := make([]string, len(v))
+		for i, item := range v {
+			masked[i] = Emails(item)
+		}
+		return masked
+	default:
+		return v
+	}
+}
@@ -0,0 +1,56 @@
+package redact
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestEmail(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{"alice@example.com", "a***@example.com"},
+		{"b@example.com", "b***@example.com"},
+		{"not-an-email", "not-an-email"},
+		{"@example.com", "@example.com"},
+	}
+	for _, tt := range tests {
+		if got := Email(tt.in); got != tt.want {
+			t.Errorf("Email(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestValue(t *testing.T) {
+	in := map[string]any{
+		"summary":     "Sync with bob@example.com",
+		"description": "Agenda: salary review for the whole team",
+		"attendees":   []any{"alice@example.com", "Carol <carol@example.org>"},
+		"duration":    float64(30),
+		"nested":      map[string]any{"emails": []string{"dave@example.net"}},
+	}
+	want := map[string]any{
+		"summary":     "Sync with b***@example.com",
+		"description": "Agenda: salary revie…",
+		"attendees":   []any{"a***@example.com", "Carol <c***@example.org>"},
+		"duration":    float64(30),
+		"nested":      map[string]any{"emails": []string{"d***@example.net"}},
+	}
+
+	if got := Value(in); !reflect.DeepEqual(got, want) {
+		t.Errorf("Value() = %v, want %v", got, want)
+	}
+	if in["summary"] != "Sync with bob@example.com" {
+		t.Error("Value() modified its input")
+	}
+}
+
+func TestDescription(t *testing.T) {
+	if got := Description("Short note"); got != "Short note" {
+		t.Errorf("Description() = %q, want it unchanged", got)
+	}
+	if got, want := Description("Discuss the Q3 layoffs with HR before Friday"), "Discuss the Q3 layof…"; got != want {
+		t.Errorf("Description() = %q, want %q", got, want)
+	}
+}
@@ -1,14 +1,18 @@
-// Code generated by ADL CLI v0.55.0. DO NOT EDIT.
-// This file was automatically generated from an ADL (Agent Definition Language) specification.
-// Manual changes to this file may be overwritten during regeneration.
+// Generated by ADL CLI v0.55.0 from an ADL (Agent Definition Language)
+// specification and maintained by hand since: it is listed in .adl-ignore,
+// so `task generate` no longer rewrites it. Register tools added to
+// agent.yaml below; the hand-written startup steps live in startup.go,
+// prompt.go and card.go.
 
 package main
 
 import (
 	"bytes"
 	"context"
+	"errors"
 	"fmt"
 	"log"
+	"net/http"
 	"os"
 	"os/signal"
 	"path/filepath"
@@ -174,13 +178,15 @@ func runStart(ctx context.Context) error {
 	// already loaded them - no separate OTel pass is required.
 
 	// Initialize logger
-	l, err := logger.NewLogger(ctx, &cfg)
+	l, logEncoding, err := logger.NewLogger(ctx, &cfg)
 	if err != nil {
 		return fmt.Errorf("failed to initialize logger: %w", err)
 	}
 
+	defer watchLogEncoding(l, logEncoding)()
+
 	l.Info("starting "+AgentName+" agent", zap.String("version", Version), zap.Bool("debug", cfg.A2A.Debug))
-	l.Debug("loaded configuration", zap.Any("config", cfg))
+	l.Debug("loaded configuration", zap.Any("config", redactedConfig(cfg)))
 
 	resolvedSkillsDir := skillsDir
 	if v := os.Getenv("A2A_SKILLS_DIR"); v != "" {
@@ -200,6 +206,8 @@ func runStart(ctx context.Context) error {
 		return fmt.Errorf("failed to initialize google service: %w", err)
 	}
 
+	preferCalendarTimezone(ctx, l, &cfg, googleSvc)
+
 	// Create toolbox with default tools (like input_required, create_artifact etc)
 	toolBox := server.NewDefaultToolBox(&cfg.A2A.AgentConfig.ToolBoxConfig)
 
@@ -251,66 +259,196 @@ func runStart(ctx context.Context) error {
 	toolBox.AddTool(getCurrentDatetimeTool)
 	l.Info("registered tool: get_current_datetime (Return the current date/time and the user's IANA timezone. Call this FIRST for any time-relative request (today, tomorrow, next Friday) before emitting RFC3339 timestamps to other calendar tools, so events land in the user's local timezone instead of an LLM-assumed default.)")
 
-	llmClient, err := server.NewOpenAICompatibleLLMClient(&cfg.A2A.AgentConfig, l)
+	// Register get_agenda tool
+	getAgendaTool := tools.NewGetAgendaTool(l, googleSvc)
+	toolBox.AddTool(getAgendaTool)
+	l.Info("registered tool: get_agenda (Get a formatted, color-coded agenda for a day with an emoji legend of event colors)")
+
+	// Register reschedule_to_next_available tool
+	rescheduleToNextAvailableTool := tools.NewRescheduleToNextAvailableTool(l, googleSvc)
+	toolBox.AddTool(rescheduleToNextAvailableTool)
+	l.Info("registered tool: reschedule_to_next_available (Move an event to the next free slot of the same duration within working hours)")
+
+	// Register list_upcoming_birthdays tool
+	listUpcomingBirthdaysTool := tools.NewListUpcomingBirthdaysTool(l, googleSvc)
+	toolBox.AddTool(listUpcomingBirthdaysTool)
+	l.Info("registered tool: list_upcoming_birthdays (List birthdays, anniversaries and other yearly all-day events coming up in the next N days)")
+
+	// Register find_duplicate_events tool
+	findDuplicateEventsTool := tools.NewFindDuplicateEventsTool(l, googleSvc)
+	toolBox.AddTool(findDuplicateEventsTool)
+	l.Info("registered tool: find_duplicate_events (Find events with the same summary, start and end in a time range, optionally deleting all but the earliest-created copy)")
+
+	// Register get_calendar_settings tool
+	getCalendarSettingsTool := tools.NewGetCalendarSettingsTool(l, googleSvc)
+	toolBox.AddTool(getCalendarSettingsTool)
+	l.Info("registered tool: get_calendar_settings (Get the user's Google Calendar settings: their timezone and the first day of the week)")
+
+	// Register batch_create_calendar_events tool
+	batchCreateCalendarEventsTool := tools.NewBatchCreateCalendarEventsTool(l, googleSvc)
+	toolBox.AddTool(batchCreateCalendarEventsTool)
+	l.Info("registered tool: batch_create_calendar_events (Create several events in Google Calendar from a list, reporting the result of each)")
+
+	// Register check_person_availability tool
+	checkPersonAvailabilityTool := tools.NewCheckPersonAvailabilityTool(l, googleSvc)
+	toolBox.AddTool(checkPersonAvailabilityTool)
+	l.Info("registered tool: check_person_availability (Check whether a person is free in a time range using their free/busy information, returning their busy blocks without event details)")
+
+	// Register get_weekly_stats tool
+	getWeeklyStatsTool := tools.NewGetWeeklyStatsTool(l, googleSvc)
+	toolBox.AddTool(getWeeklyStatsTool)
+	l.Info("registered tool: get_weekly_stats (Summarize a week's meeting load: total meeting hours, number of meetings, the longest meeting-free block within working hours, and the busiest day)")
+
+	// Register copy_event_to_calendar tool
+	copyEventToCalendarTool := tools.NewCopyEventToCalendarTool(l, googleSvc)
+	toolBox.AddTool(copyEventToCalendarTool)
+	l.Info("registered tool: copy_event_to_calendar (Copy an event to another calendar, either with its details or as an opaque \"Busy\" block that hides them)")
+
+	// Register find_lunch_slot tool
+	findLunchSlotTool := tools.NewFindLunchSlotTool(l, googleSvc)
+	toolBox.AddTool(findLunchSlotTool)
+	l.Info("registered tool: find_lunch_slot (Find the earliest free 30-60 minute lunch break between 11:30 and 14:00 on a given day)")
+
+	// Register set_default_calendar tool
+	setDefaultCalendarTool := tools.NewSetDefaultCalendarTool(l, googleSvc)
+	toolBox.AddTool(setDefaultCalendarTool)
+	l.Info("registered tool: set_default_calendar (Switch the calendar the other tools operate on, until the agent restarts)")
+
+	// Register get_default_calendar tool
+	getDefaultCalendarTool := tools.NewGetDefaultCalendarTool(l, googleSvc)
+	toolBox.AddTool(getDefaultCalendarTool)
+	l.Info("registered tool: get_default_calendar (Get the calendar the other tools currently operate on)")
+
+	// Register postpone_event tool
+	postponeEventTool := tools.NewPostponeEventTool(l, googleSvc)
+	toolBox.AddTool(postponeEventTool)
+	l.Info("registered tool: postpone_event (Push an event back by a duration, shifting its start and end by the same amount)")
+
+	// Register check_travel_gaps tool
+	checkTravelGapsTool := tools.NewCheckTravelGapsTool(l, googleSvc)
+	toolBox.AddTool(checkTravelGapsTool)
+	l.Info("registered tool: check_travel_gaps (Flag back-to-back events at different physical locations that leave too little time to travel between them)")
+
+	// Register bulk_reschedule tool
+	bulkRescheduleTool := tools.NewBulkRescheduleTool(l, googleSvc)
+	toolBox.AddTool(bulkRescheduleTool)
+	l.Info("registered tool: bulk_reschedule (Shift every event matching a query within a time range by the same duration, with a dry-run preview)")
+
+	// Register list_calendars tool
+	listCalendarsTool := tools.NewListCalendarsTool(l, googleSvc)
+	toolBox.AddTool(listCalendarsTool)
+	l.Info("registered tool: list_calendars (List the calendars shared with the agent, with the access role the agent has on each)")
+
+	// Register find_overlaps tool
+	findOverlapsTool := tools.NewFindOverlapsTool(l, googleSvc)
+	toolBox.AddTool(findOverlapsTool)
+	l.Info("registered tool: find_overlaps (Find every pair of events that overlap in time within a range, to clean up double-bookings)")
+
+	// Register list_events_table tool
+	listEventsTableTool := tools.NewListEventsTableTool(l, googleSvc)
+	toolBox.AddTool(listEventsTableTool)
+	l.Info("registered tool: list_events_table (List events in a time range as a compact table of time, title, location and attendees, with a plaintext rendering)")
+
+	// Register find_events_by_property tool
+	findEventsByPropertyTool := tools.NewFindEventsByPropertyTool(l, googleSvc)
+	toolBox.AddTool(findEventsByPropertyTool)
+	l.Info("registered tool: find_events_by_property (Find events carrying an extended property, such as a correlation key set through privateProperties or sharedProperties)")
+
+	// Register propose_meeting_times tool
+	proposeMeetingTimesTool := tools.NewProposeMeetingTimesTool(l, googleSvc)
+	toolBox.AddTool(proposeMeetingTimesTool)
+	l.Info("registered tool: propose_meeting_times (Propose the top 3 meeting times within working hours where the most attendees are free, ranked by how many can attend; unlike find_available_time it does not require everyone to be free)")
+
+	// Register export_events_ics tool
+	exportEventsICSTool := tools.NewExportEventsICSTool(l, googleSvc)
+	toolBox.AddTool(exportEventsICSTool)
+	l.Info("registered tool: export_events_ics (Export events in a time range as an iCalendar (.ics) document that other calendar apps can import)")
+
+	// Register respond_to_invites tool
+	respondToInvitesTool := tools.NewRespondToInvitesTool(l, googleSvc)
+	toolBox.AddTool(respondToInvitesTool)
+	l.Info("registered tool: respond_to_invites (Accept, decline, or tentatively accept every invitation the user has not yet answered within a time range)")
+
+	// Register describe_event tool
+	describeEventTool := tools.NewDescribeEventTool(l, googleSvc)
+	toolBox.AddTool(describeEventTool)
+	l.Info("registered tool: describe_event (Describe an event in one readable sentence: when and where it is, who is invited and how many have accepted)")
+
+	// Register list_recurring_series tool
+	listRecurringSeriesTool := tools.NewListRecurringSeriesTool(l, googleSvc)
+	toolBox.AddTool(listRecurringSeriesTool)
+	l.Info("registered tool: list_recurring_series (List recurring meetings as series rather than individual occurrences, with each series' repeat rule in plain words)")
+
+	// Register schedule_across_calendars tool
+	scheduleAcrossCalendarsTool := tools.NewScheduleAcrossCalendarsTool(l, googleSvc)
+	toolBox.AddTool(scheduleAcrossCalendarsTool)
+	l.Info("registered tool: schedule_across_calendars (Find the earliest slot this week, within working hours, that is free on every one of several calendars)")
+
+	// Register list_events_paged tool
+	listEventsPagedTool := tools.NewListEventsPagedTool(l, googleSvc)
+	toolBox.AddTool(listEventsPagedTool)
+	l.Info("registered tool: list_events_paged (List events one page at a time; pass the returned nextPageToken back as pageToken to fetch the following page)")
+
+	// Register get_busyness tool
+	getBusynessTool := tools.NewGetBusynessTool(l, googleSvc)
+	toolBox.AddTool(getBusynessTool)
+	l.Info("registered tool: get_busyness (Report how booked a day is: the percentage of its working hours taken up by events, ignoring events marked as free)")
+
+	// Register check_calendar_hygiene tool
+	checkCalendarHygieneTool := tools.NewCheckCalendarHygieneTool(l, googleSvc)
+	toolBox.AddTool(checkCalendarHygieneTool)
+	l.Info("registered tool: check_calendar_hygiene (Report scheduling anomalies in a range: events longer than the configured threshold, meetings with nobody invited, and overlapping blocks)")
+
+	// Register next_occurrence tool
+	nextOccurrenceTool := tools.NewNextOccurrenceTool(l, googleSvc)
+	toolBox.AddTool(nextOccurrenceTool)
+	l.Info("registered tool: next_occurrence (Work out when a recurring event happens next from its repeat rule, or report that the series has ended)")
+
+	// Register cleanup_calendar tool
+	cleanupCalendarTool := tools.NewCleanupCalendarTool(l, googleSvc)
+	toolBox.AddTool(cleanupCalendarTool)
+	l.Info("registered tool: cleanup_calendar (Tidy up a range by deleting cancelled events and events the user declined, with a dry-run preview)")
+
+	exposedToolBox, err := exposeToolBox(l, &cfg, toolBox)
+	if err != nil {
+		return err
+	}
+
+	llmClient, err := newLLMClient(l, &cfg)
 	if err != nil {
-		return fmt.Errorf("failed to create LLM client: %w", err)
+		return err
 	}
 
-	systemPrompt := `You are a Google Calendar AI agent specialized in calendar management and scheduling operations.
-
-Your primary capabilities:
-1. **Event Management**: Create, update, delete, and retrieve calendar events
-2. **Scheduling Intelligence**: Find available time slots and check for conflicts
-3. **Calendar Operations**: List events with flexible time ranges and search queries
-
-Key features:
-- Support for both mock mode (demo/testing) and production Google Calendar API
-- RFC3339 timestamp handling for accurate scheduling
-- Intelligent conflict detection and availability checking
-- Attendee management and location tracking
-- Comprehensive event search and filtering
-
-When helping users:
-- Always validate time formats and ranges
-- Provide clear feedback on scheduling conflicts
-- Suggest alternative time slots when conflicts are detected
-- Handle both simple and complex scheduling scenarios
-- Maintain data accuracy and consistency with Google Calendar
-
-Time and timezone handling:
-- For any time-relative request ("today", "tomorrow", "next Friday",
-  "in 2 hours"), call the get_current_datetime tool FIRST to anchor
-  the current time and the user's IANA timezone. Do not guess.
-- Emit RFC3339 timestamps with the offset of the user's timezone
-  (e.g. 2026-05-20T14:00:00+02:00 for CEST), not UTC and not a
-  provider-default like Pacific Time.
-- If the user names an explicit timezone, prefer that over the
-  configured default.
-
-Your responses should be accurate, helpful, and focused on calendar management tasks.
-`
-	if skillsPrompt != "" {
-		systemPrompt = systemPrompt + "\n\n" + skillsPrompt
+	systemPrompt, err := buildSystemPrompt(cfg.SystemPrompt, skillsPrompt, tools.CalendarToolNames(exposedToolBox))
+	if err != nil {
+		return fmt.Errorf("failed to build system prompt: %w", err)
 	}
 
 	agent, err := server.NewAgentBuilder(l).
 		WithConfig(&cfg.A2A.AgentConfig).
 		WithLLMClient(llmClient).
-		WithToolBox(toolBox).
+		WithToolBox(exposedToolBox).
 		WithMaxChatCompletion(cfg.A2A.AgentConfig.MaxChatCompletionIterations).
 		WithSystemPrompt(systemPrompt).
+		WithCallbacks(newCallbacks(l, &cfg)).
 		Build()
 	if err != nil {
 		return fmt.Errorf("failed to create agent: %w", err)
 	}
 
+	cardSkills, err := agentCardSkills(agentCardFile, exposedToolBox)
+	if err != nil {
+		return fmt.Errorf("failed to build agent card skills: %w", err)
+	}
+
 	a2aServer, err := server.NewA2AServerBuilder(cfg.A2A, l).
 		WithAgent(agent).
-		WithAgentCardFromFile(".well-known/agent-card.json", map[string]any{
+		WithAgentCardFromFile(agentCardFile, map[string]any{
 			"name":        AgentName,
 			"version":     Version,
 			"description": AgentDescription,
 			"url":         cfg.A2A.AgentURL,
+			"skills":      cardSkills,
 		}).
 		WithDefaultBackgroundTaskHandler().
 		WithDefaultStreamingTaskHandler().
@@ -321,11 +459,14 @@ Your responses should be accurate, helpful, and focused on calendar management t
 
 	go func() {
 		l.Info("starting A2A server", zap.String("port", cfg.A2A.ServerConfig.Port))
-		if err := a2aServer.Start(ctx); err != nil {
+		if err := a2aServer.Start(ctx); err != nil && !errors.Is(err, http.ErrServerClosed) {
 			l.Fatal("server failed to start", zap.Error(err))
 		}
 	}()
 
+	stopReminders := startReminders(ctx, l, &cfg, googleSvc)
+	defer stopReminders()
+
 	l.Info("google-calendar-agent agent running successfully",
 		zap.String("port", cfg.A2A.ServerConfig.Port))
 
@@ -333,8 +474,12 @@ Your responses should be accurate, helpful, and focused on calendar management t
 	signal.Notify(quit, syscall.SIGINT, syscall.SIGTERM)
 	<-quit
 
-	l.Info("shutdown signal received, gracefully stopping server...")
-	a2aServer.Stop(ctx)
+	stopReminders()
+	l.Info("shutdown signal received, gracefully stopping server...",
+		zap.Duration("timeout", cfg.Server.ShutdownTimeout))
+	if err := shutdownServer(a2aServer, cfg.Server.ShutdownTimeout); err != nil {
+		l.Error("server did not stop gracefully", zap.Error(err))
+	}
 	l.Info("google-calendar-agent agent stopped")
 	return nil
 }
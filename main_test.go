@@ -0,0 +1,322 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	server "github.com/inference-gateway/adk/server"
+	envconfig "github.com/sethvargo/go-envconfig"
+	zap "go.uber.org/zap"
+	zapcore "go.uber.org/zap/zapcore"
+
+	config "github.com/inference-gateway/google-calendar-agent/config"
+	tools "github.com/inference-gateway/google-calendar-agent/tools"
+)
+
+// blockingServer is an A2AServer whose Stop waits for in-flight work
+// that takes `drain` to finish, or for the shutdown context to expire.
+type blockingServer struct {
+	server.A2AServer
+	drain time.Duration
+}
+
+func (s *blockingServer) Stop(ctx context.Context) error {
+	select {
+	case <-time.After(s.drain):
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func TestShutdownServer(t *testing.T) {
+	tests := []struct {
+		name    string
+		drain   time.Duration
+		timeout time.Duration
+		wantErr error
+	}{
+		{
+			name:    "in-flight requests finish before the timeout",
+			drain:   10 * time.Millisecond,
+			timeout: time.Second,
+		},
+		{
+			name:    "slow requests are cut off at the timeout",
+			drain:   time.Minute,
+			timeout: 50 * time.Millisecond,
+			wantErr: context.DeadlineExceeded,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			srv := &blockingServer{drain: tc.drain}
+
+			start := time.Now()
+			err := shutdownServer(srv, tc.timeout)
+			elapsed := time.Since(start)
+
+			if !errors.Is(err, tc.wantErr) {
+				t.Errorf("err = %v, want %v", err, tc.wantErr)
+			}
+			if elapsed > tc.timeout+500*time.Millisecond {
+				t.Errorf("shutdown took %s, want at most ~%s", elapsed, tc.timeout)
+			}
+		})
+	}
+}
+
+func TestBuildSystemPrompt(t *testing.T) {
+	dir := t.TempDir()
+	rulesFile := filepath.Join(dir, "rules.md")
+	if err := os.WriteFile(rulesFile, []byte("Never book meetings on Fridays.\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name        string
+		cfg         config.SystemPromptConfig
+		wantContain []string
+		wantMissing []string
+		wantErrSub  string
+	}{
+		{
+			name:        "default prompt without override",
+			cfg:         config.SystemPromptConfig{Mode: "append"},
+			wantContain: []string{defaultSystemPrompt, timeHandlingPrompt, "## Skills", defaultHelpText},
+		},
+		{
+			name:        "append keeps the default and adds the override",
+			cfg:         config.SystemPromptConfig{Mode: "append", Text: "Answer in a formal tone."},
+			wantContain: []string{defaultSystemPrompt, timeHandlingPrompt, "Answer in a formal tone."},
+		},
+		{
+			name:        "replace drops the default but keeps time handling",
+			cfg:         config.SystemPromptConfig{Mode: "replace", File: rulesFile},
+			wantContain: []string{"Never book meetings on Fridays.", timeHandlingPrompt, "## Skills", "list_calendar_events"},
+			wantMissing: []string{defaultSystemPrompt},
+		},
+		{
+			name:        "text and file are combined",
+			cfg:         config.SystemPromptConfig{Mode: "replace", Text: "Be brief.", File: rulesFile},
+			wantContain: []string{"Be brief.\n\nNever book meetings on Fridays."},
+		},
+		{
+			name:        "response language adds a directive in both modes",
+			cfg:         config.SystemPromptConfig{Mode: "replace", Text: "Be brief.", ResponseLanguage: "German"},
+			wantContain: []string{"Always respond in German", "into German instead of quoting them in English"},
+		},
+		{
+			name:        "no directive without a response language",
+			cfg:         config.SystemPromptConfig{Mode: "append"},
+			wantMissing: []string{"Always respond in"},
+		},
+		{
+			name:       "unreadable file fails",
+			cfg:        config.SystemPromptConfig{Mode: "append", File: filepath.Join(dir, "missing.md")},
+			wantErrSub: "failed to read SYSTEM_PROMPT_FILE",
+		},
+		{
+			name:       "replace without text fails",
+			cfg:        config.SystemPromptConfig{Mode: "replace"},
+			wantErrSub: "requires SYSTEM_PROMPT_TEXT or SYSTEM_PROMPT_FILE",
+		},
+		{
+			name:       "unknown mode fails",
+			cfg:        config.SystemPromptConfig{Mode: "prepend"},
+			wantErrSub: "invalid SYSTEM_PROMPT_MODE",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			prompt, err := buildSystemPrompt(tc.cfg, "## Skills", []string{"list_calendar_events"})
+			if tc.wantErrSub != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErrSub) {
+					t.Fatalf("error = %v, want substring %q", err, tc.wantErrSub)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			for _, want := range tc.wantContain {
+				if !strings.Contains(prompt, want) {
+					t.Errorf("prompt is missing %q", want)
+				}
+			}
+			for _, unwanted := range tc.wantMissing {
+				if strings.Contains(prompt, unwanted) {
+					t.Errorf("prompt unexpectedly contains %q", unwanted)
+				}
+			}
+		})
+	}
+}
+
+func TestTimeHandlingPromptClockVariants(t *testing.T) {
+	tests := []struct {
+		phrase string
+		want   string
+	}{
+		{phrase: `"3 PM"`, want: "15:00"},
+		{phrase: `"3:00 p.m."`, want: "15:00"},
+		{phrase: `"noon" is`, want: "12:00"},
+		{phrase: `"midnight" is`, want: "start of the next day"},
+		{phrase: `"quarter past 3" is`, want: "3:15"},
+		{phrase: `"quarter to 4"`, want: "3:45"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.phrase, func(t *testing.T) {
+			idx := strings.Index(timeHandlingPrompt, tc.phrase)
+			if idx < 0 {
+				t.Fatalf("timeHandlingPrompt does not cover %s", tc.phrase)
+			}
+			// The reading must follow the phrase within the same rule.
+			rule := timeHandlingPrompt[idx:]
+			if end := strings.Index(rule, "\n- "); end >= 0 {
+				rule = rule[:end]
+			}
+			if !strings.Contains(rule, tc.want) {
+				t.Errorf("rule for %s does not map it to %s", tc.phrase, tc.want)
+			}
+		})
+	}
+}
+
+func TestHelpPrompt(t *testing.T) {
+	tests := []struct {
+		name        string
+		helpText    string
+		toolNames   []string
+		wantContain []string
+		wantMissing []string
+	}{
+		{
+			name:        "default text lists the enabled tools",
+			toolNames:   []string{"create_calendar_event", "list_calendar_events"},
+			wantContain: []string{defaultHelpText, "create_calendar_event, list_calendar_events."},
+		},
+		{
+			name:        "restricted tool set hides the others",
+			toolNames:   []string{"list_calendar_events"},
+			wantContain: []string{"these tools: list_calendar_events."},
+			wantMissing: []string{"create_calendar_event"},
+		},
+		{
+			name:        "custom text replaces the default",
+			helpText:    "  Ask me about your schedule.  ",
+			toolNames:   []string{"list_calendar_events"},
+			wantContain: []string{"  Ask me about your schedule.\n"},
+			wantMissing: []string{defaultHelpText},
+		},
+		{
+			name:        "no tools omits the list",
+			wantMissing: []string{"based only on these tools"},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			prompt := helpPrompt(tc.helpText, tc.toolNames)
+			for _, want := range tc.wantContain {
+				if !strings.Contains(prompt, want) {
+					t.Errorf("prompt %q is missing %q", prompt, want)
+				}
+			}
+			for _, unwanted := range tc.wantMissing {
+				if strings.Contains(prompt, unwanted) {
+					t.Errorf("prompt %q unexpectedly contains %q", prompt, unwanted)
+				}
+			}
+		})
+	}
+}
+
+func TestRedactedConfig(t *testing.T) {
+	t.Setenv("A2A_AGENT_CLIENT_API_KEY", "sk-gateway-secret")
+	t.Setenv("A2A_AUTH_CLIENT_SECRET", "oidc-secret")
+	t.Setenv("GOOGLE_SERVICE_ACCOUNT_JSON", `{"private_key":"pk-secret"}`)
+	t.Setenv("NOTIFIER_WEBHOOK_URL", "https://hooks.example.com/hook-secret")
+
+	var cfg config.Config
+	if err := envconfig.Process(context.Background(), &cfg); err != nil {
+		t.Fatalf("failed to load config: %v", err)
+	}
+	if cfg.A2A.AgentConfig.APIKey != "sk-gateway-secret" {
+		t.Fatalf("AgentConfig.APIKey = %q, want the key passed to the LLM client", cfg.A2A.AgentConfig.APIKey)
+	}
+
+	var buf bytes.Buffer
+	core := zapcore.NewCore(zapcore.NewJSONEncoder(zap.NewDevelopmentEncoderConfig()), zapcore.AddSync(&buf), zapcore.DebugLevel)
+	zap.New(core).Debug("loaded configuration", zap.Any("config", redactedConfig(cfg)))
+
+	logged := buf.String()
+	for _, secret := range []string{"sk-gateway-secret", "oidc-secret", "pk-secret", "hook-secret"} {
+		if strings.Contains(logged, secret) {
+			t.Errorf("logged configuration contains %q", secret)
+		}
+	}
+	if !strings.Contains(logged, redactedSecret) {
+		t.Errorf("logged configuration = %s, want %s placeholders", logged, redactedSecret)
+	}
+	if cfg.A2A.AgentConfig.APIKey != "sk-gateway-secret" {
+		t.Error("redactedConfig modified the original config")
+	}
+}
+
+func TestAgentCardSkills(t *testing.T) {
+	cardPath := filepath.Join(t.TempDir(), "agent-card.json")
+	card := `{"name":"google-calendar-agent","skills":[{"id":"schedule-meeting","name":"schedule-meeting","description":"Book a meeting","tags":["calendar"]}]}`
+	if err := os.WriteFile(cardPath, []byte(card), 0o600); err != nil {
+		t.Fatalf("failed to write agent card: %v", err)
+	}
+
+	toolBox := server.NewDefaultToolBox(nil)
+	for _, name := range []string{"create_calendar_event", "delete_calendar_event", "list_calendar_events"} {
+		toolBox.AddTool(server.NewBasicTool(name, "does "+name, map[string]any{"type": "object"}, func(ctx context.Context, args map[string]any) (string, error) {
+			return "ok", nil
+		}))
+	}
+
+	tests := []struct {
+		name string
+		cfg  config.SkillConfig
+		want []string
+	}{
+		{
+			name: "every skill enabled",
+			cfg:  config.SkillConfig{CreateEnabled: true, DeleteEnabled: true, UpdateEnabled: true},
+			want: []string{"schedule-meeting", "create_calendar_event", "delete_calendar_event", "list_calendar_events"},
+		},
+		{
+			name: "delete disabled",
+			cfg:  config.SkillConfig{CreateEnabled: true, UpdateEnabled: true},
+			want: []string{"schedule-meeting", "create_calendar_event", "list_calendar_events"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			skills, err := agentCardSkills(cardPath, tools.NewSkillToolBox(toolBox, tt.cfg))
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			var got []string
+			for _, skill := range skills {
+				got = append(got, skill.ID)
+			}
+			if strings.Join(got, ",") != strings.Join(tt.want, ",") {
+				t.Errorf("skills = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
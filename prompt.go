@@ -0,0 +1,127 @@
+package main
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	config "github.com/inference-gateway/google-calendar-agent/config"
+)
+
+// defaultSystemPrompt describes the agent. SYSTEM_PROMPT_TEXT or
+// SYSTEM_PROMPT_FILE can replace it or be appended to it.
+const defaultSystemPrompt = `You are a Google Calendar AI agent specialized in calendar management and scheduling operations.
+
+Your primary capabilities:
+1. **Event Management**: Create, update, delete, and retrieve calendar events
+2. **Scheduling Intelligence**: Find available time slots and check for conflicts
+3. **Calendar Operations**: List events with flexible time ranges and search queries
+
+Key features:
+- Support for both mock mode (demo/testing) and production Google Calendar API
+- RFC3339 timestamp handling for accurate scheduling
+- Intelligent conflict detection and availability checking
+- Attendee management and location tracking
+- Comprehensive event search and filtering
+
+When helping users:
+- Always validate time formats and ranges
+- Provide clear feedback on scheduling conflicts
+- Suggest alternative time slots when conflicts are detected
+- Handle both simple and complex scheduling scenarios
+- Maintain data accuracy and consistency with Google Calendar
+
+Your responses should be accurate, helpful, and focused on calendar management tasks.`
+
+// timeHandlingPrompt is always part of the system prompt, even when the
+// default is replaced, so the model keeps anchoring relative dates with
+// get_current_datetime instead of guessing today's date.
+const timeHandlingPrompt = `Time and timezone handling:
+- For any time-relative request ("today", "tomorrow", "next Friday",
+  "in 2 hours"), call the get_current_datetime tool FIRST to anchor
+  the current time and the user's IANA timezone. Do not guess.
+- For relative offsets ("in 30 minutes", "in 1 hour 30 minutes"),
+  pass offsetHours/offsetMinutes to get_current_datetime and use its
+  offset_time as the start.
+- Emit RFC3339 timestamps with the offset of the user's timezone
+  (e.g. 2026-05-20T14:00:00+02:00 for CEST), not UTC and not a
+  provider-default like Pacific Time.
+- If the user names an explicit timezone, prefer that over the
+  configured default.
+- Read clock times in any spelling as the same wall-clock time: "3 PM",
+  "3pm", "3 p.m.", "3:00 p.m." and "15:00" are all 15:00. "noon" is
+  12:00. "midnight" is 00:00; as the end of a range ("until midnight") it
+  means the start of the next day. "quarter past 3" is 3:15, "half past 3"
+  3:30 and "quarter to 4" 3:45, taken as afternoon times when the user
+  does not say morning and the hour falls outside working hours otherwise.`
+
+// defaultHelpText opens the reply to requests the agent cannot act on
+// unless SYSTEM_PROMPT_HELP_TEXT replaces it.
+const defaultHelpText = "I can help you manage your Google Calendar."
+
+// helpPrompt tells the model how to answer "help" and requests it cannot
+// classify. The tool list comes from the toolbox the agent is built with,
+// so it follows LLM_ENABLED_TOOLS.
+func helpPrompt(helpText string, toolNames []string) string {
+	helpText = strings.TrimSpace(helpText)
+	if helpText == "" {
+		helpText = defaultHelpText
+	}
+	prompt := "Help and unclear requests:\n" +
+		"- When the user asks for help, or a request is unclear or not about\n" +
+		"  their calendar, do not guess and do not call tools. Reply with:\n" +
+		"  " + helpText
+	if len(toolNames) > 0 {
+		prompt += "\n- Then list what you can do, based only on these tools: " +
+			strings.Join(toolNames, ", ") + "."
+	}
+	return prompt
+}
+
+// languagePrompt pins the reply language. Tool results and the built-in
+// messages they carry are English, so the model is told to translate them
+// rather than echo them.
+func languagePrompt(language string) string {
+	return "Response language:\n" +
+		"- Always respond in " + language + ", whatever language the request\n" +
+		"  is in. Translate tool results, error messages and the help reply\n" +
+		"  into " + language + " instead of quoting them in English."
+}
+
+// buildSystemPrompt assembles the system prompt from the default, the
+// operator's override, the time handling rules, the help instructions for
+// the enabled tools, the response language and the skills manifest.
+func buildSystemPrompt(cfg config.SystemPromptConfig, skillsPrompt string, toolNames []string) (string, error) {
+	custom := strings.TrimSpace(cfg.Text)
+	if cfg.File != "" {
+		content, err := os.ReadFile(cfg.File)
+		if err != nil {
+			return "", fmt.Errorf("failed to read SYSTEM_PROMPT_FILE: %w", err)
+		}
+		custom = strings.TrimSpace(strings.Join([]string{custom, string(content)}, "\n\n"))
+	}
+
+	var parts []string
+	switch cfg.Mode {
+	case "append":
+		parts = append(parts, defaultSystemPrompt, timeHandlingPrompt)
+		if custom != "" {
+			parts = append(parts, custom)
+		}
+	case "replace":
+		if custom == "" {
+			return "", fmt.Errorf("SYSTEM_PROMPT_MODE=replace requires SYSTEM_PROMPT_TEXT or SYSTEM_PROMPT_FILE")
+		}
+		parts = append(parts, custom, timeHandlingPrompt)
+	default:
+		return "", fmt.Errorf("invalid SYSTEM_PROMPT_MODE %q (expected append or replace)", cfg.Mode)
+	}
+	parts = append(parts, helpPrompt(cfg.HelpText, toolNames))
+	if language := strings.TrimSpace(cfg.ResponseLanguage); language != "" {
+		parts = append(parts, languagePrompt(language))
+	}
+	if skillsPrompt != "" {
+		parts = append(parts, skillsPrompt)
+	}
+	return strings.Join(parts, "\n\n"), nil
+}
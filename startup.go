@@ -0,0 +1,182 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	otel "go.opentelemetry.io/otel"
+	zap "go.uber.org/zap"
+
+	server "github.com/inference-gateway/adk/server"
+	serverConfig "github.com/inference-gateway/adk/server/config"
+
+	config "github.com/inference-gateway/google-calendar-agent/config"
+	tools "github.com/inference-gateway/google-calendar-agent/tools"
+
+	google "github.com/inference-gateway/google-calendar-agent/internal/google"
+	llm "github.com/inference-gateway/google-calendar-agent/internal/llm"
+	logger "github.com/inference-gateway/google-calendar-agent/internal/logger"
+	notifier "github.com/inference-gateway/google-calendar-agent/internal/notifier"
+	ratelimit "github.com/inference-gateway/google-calendar-agent/internal/ratelimit"
+)
+
+// The functions below hold the agent's hand-written startup steps. runStart
+// in main.go calls them so that it stays close to what ADL generates.
+
+// watchLogEncoding flips the log encoding between json and console on every
+// SIGUSR1, e.g. to read a production agent's logs in a terminal without
+// restarting it. The returned func stops watching.
+func watchLogEncoding(l *zap.Logger, encoding *logger.EncodingSwitch) func() {
+	toggle := make(chan os.Signal, 1)
+	signal.Notify(toggle, syscall.SIGUSR1)
+	go func() {
+		for range toggle {
+			l.Info("switched log encoding", zap.String("encoding", encoding.Toggle()))
+		}
+	}()
+	return func() {
+		signal.Stop(toggle)
+		close(toggle)
+	}
+}
+
+// preferCalendarTimezone replaces a UTC GOOGLE_CALENDAR_TIMEZONE, the spec
+// default, with the timezone the user picked in Google Calendar.
+func preferCalendarTimezone(ctx context.Context, l *zap.Logger, cfg *config.Config, svc google.CalendarService) {
+	if cfg.GoogleCalendar.Timezone != "UTC" {
+		return
+	}
+	timezone, err := tools.UseCalendarSettingsTimezone(ctx, svc)
+	if err != nil {
+		l.Warn("failed to load timezone from Google Calendar settings, keeping UTC", zap.Error(err))
+		return
+	}
+	l.Info("loaded timezone from Google Calendar settings", zap.String("timezone", timezone))
+}
+
+// exposeToolBox wraps the registered tools in what the LLM actually sees:
+// the tools of disabled skills and those left out of LLM_ENABLED_TOOLS are
+// hidden, and every call is audited, bounded by GOOGLE_OPERATION_TIMEOUT
+// and has its Google errors explained.
+func exposeToolBox(l *zap.Logger, cfg *config.Config, toolBox server.ToolBox) (server.ToolBox, error) {
+	if disabled := tools.DisabledSkills(cfg.Skill); len(disabled) > 0 {
+		l.Info("skipping the tools of disabled skills", zap.Strings("skills", disabled))
+	}
+	exposed, err := tools.NewFilteredToolBox(tools.NewSkillToolBox(toolBox, cfg.Skill), cfg.LLM.EnabledTools)
+	if err != nil {
+		return nil, fmt.Errorf("invalid LLM_ENABLED_TOOLS: %w", err)
+	}
+	if len(cfg.LLM.EnabledTools) > 0 {
+		l.Info("restricting tools exposed to the LLM", zap.Strings("tools", exposed.GetToolNames()))
+	}
+	exposed = tools.NewAuditToolBox(exposed, l)
+	exposed = tools.NewTimeoutToolBox(exposed, cfg.Google.OperationTimeout)
+	return tools.NewServiceErrorToolBox(exposed, l), nil
+}
+
+// newLLMClient returns the LLM client the agent talks to, instrumented
+// with OpenTelemetry metrics. With LLM_FALLBACK_MODEL set, failed requests
+// are retried on that model.
+func newLLMClient(l *zap.Logger, cfg *config.Config) (server.LLMClient, error) {
+	primary, err := newInstrumentedLLMClient(l, cfg.A2A.AgentConfig)
+	if err != nil {
+		return nil, err
+	}
+	if cfg.LLM.FallbackModel == "" {
+		return primary, nil
+	}
+
+	fallbackCfg := cfg.A2A.AgentConfig
+	fallbackCfg.Model = cfg.LLM.FallbackModel
+	fallback, err := newInstrumentedLLMClient(l, fallbackCfg)
+	if err != nil {
+		return nil, fmt.Errorf("fallback: %w", err)
+	}
+	l.Info("LLM fallback model enabled", zap.String("fallbackModel", cfg.LLM.FallbackModel))
+	return llm.NewFallbackClient(primary, fallback, cfg.LLM.FallbackModel, l), nil
+}
+
+// newInstrumentedLLMClient creates an OpenAI compatible client for
+// agentCfg's provider and model and records metrics for its calls.
+func newInstrumentedLLMClient(l *zap.Logger, agentCfg serverConfig.AgentConfig) (server.LLMClient, error) {
+	client, err := server.NewOpenAICompatibleLLMClient(&agentCfg, l)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create LLM client: %w", err)
+	}
+	instrumented, err := llm.NewInstrumentedClient(
+		client,
+		otel.Meter("github.com/inference-gateway/google-calendar-agent/internal/llm"),
+		agentCfg.Provider,
+		agentCfg.Model,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("failed to instrument LLM client: %w", err)
+	}
+	return instrumented, nil
+}
+
+// newCallbacks returns the agent callbacks: with RATE_LIMIT_RPS set, tool
+// calls beyond the configured rate are refused.
+func newCallbacks(l *zap.Logger, cfg *config.Config) *server.CallbackConfig {
+	callbacks := &server.CallbackConfig{}
+	if cfg.RateLimit.RPS > 0 {
+		limiter := ratelimit.NewMemoryLimiter(cfg.RateLimit.RPS, cfg.RateLimit.Burst)
+		callbacks.BeforeTool = append(callbacks.BeforeTool, ratelimit.BeforeToolCallback(limiter, l))
+		l.Info("tool call rate limiting enabled",
+			zap.Float64("rps", cfg.RateLimit.RPS),
+			zap.Int("burst", cfg.RateLimit.Burst))
+	}
+	return callbacks
+}
+
+// startReminders posts event reminders to NOTIFIER_WEBHOOK_URL until the
+// returned func is called. Without a webhook it does nothing.
+func startReminders(ctx context.Context, l *zap.Logger, cfg *config.Config, svc google.CalendarService) func() {
+	ctx, stop := context.WithCancel(ctx)
+	if reminder := notifier.NewReminder(l, svc, cfg.Notifier); reminder != nil {
+		go reminder.Run(ctx)
+		l.Info("event reminder webhook enabled",
+			zap.Duration("leadTime", cfg.Notifier.ReminderLeadTime),
+			zap.Duration("pollInterval", cfg.Notifier.PollInterval))
+	}
+	return stop
+}
+
+// redactedSecret replaces secret values in logged configuration.
+const redactedSecret = "[redacted]"
+
+// redactedConfig returns a copy of cfg that is safe to log: the LLM API
+// key, the auth client secret, queue credentials and inline Google
+// credentials are replaced by redactedSecret when set.
+func redactedConfig(cfg config.Config) config.Config {
+	mask := func(s *string) {
+		if *s != "" {
+			*s = redactedSecret
+		}
+	}
+	mask(&cfg.A2A.AgentConfig.APIKey)
+	mask(&cfg.A2A.AuthConfig.ClientSecret)
+	mask(&cfg.Google.ServiceAccountJSON)
+	mask(&cfg.Notifier.WebhookURL)
+	if len(cfg.A2A.QueueConfig.Credentials) > 0 {
+		credentials := make(map[string]string, len(cfg.A2A.QueueConfig.Credentials))
+		for k := range cfg.A2A.QueueConfig.Credentials {
+			credentials[k] = redactedSecret
+		}
+		cfg.A2A.QueueConfig.Credentials = credentials
+	}
+	return cfg
+}
+
+// shutdownServer stops the A2A server, giving in-flight requests up to
+// timeout, SERVER_SHUTDOWN_TIMEOUT, to complete before their connections
+// are dropped.
+func shutdownServer(srv server.A2AServer, timeout time.Duration) error {
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+	return srv.Stop(ctx)
+}
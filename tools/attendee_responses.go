@@ -0,0 +1,73 @@
+package tools
+
+import (
+	"fmt"
+	"strings"
+
+	calendar "google.golang.org/api/calendar/v3"
+)
+
+// attendeeResponses counts an event's guests by response status. Resources
+// such as meeting rooms are not guests and are left out.
+type attendeeResponses struct {
+	Total     int `json:"total"`
+	Accepted  int `json:"accepted"`
+	Tentative int `json:"tentative"`
+	Declined  int `json:"declined"`
+	Pending   int `json:"pending"`
+}
+
+// countAttendeeResponses tallies the responses of an event's guests.
+func countAttendeeResponses(event *calendar.Event) attendeeResponses {
+	var r attendeeResponses
+	for _, attendee := range event.Attendees {
+		if attendee == nil || attendee.Resource {
+			continue
+		}
+		r.Total++
+		switch attendee.ResponseStatus {
+		case "accepted":
+			r.Accepted++
+		case "tentative":
+			r.Tentative++
+		case "declined":
+			r.Declined++
+		default:
+			r.Pending++
+		}
+	}
+	return r
+}
+
+// summary phrases the counts for a sentence, e.g. "2 have accepted, 1
+// declined". It is empty when there are no guests.
+func (r attendeeResponses) summary() string {
+	if r.Total == 0 {
+		return ""
+	}
+	if r.Pending == r.Total {
+		return "nobody has replied yet"
+	}
+	var parts []string
+	if r.Accepted > 0 {
+		parts = append(parts, fmt.Sprintf("%d %s accepted", r.Accepted, plural(r.Accepted, "has", "have")))
+	}
+	if r.Tentative > 0 {
+		parts = append(parts, fmt.Sprintf("%d %s tentative", r.Tentative, plural(r.Tentative, "is", "are")))
+	}
+	if r.Declined > 0 {
+		parts = append(parts, fmt.Sprintf("%d declined", r.Declined))
+	}
+	if r.Pending > 0 {
+		parts = append(parts, fmt.Sprintf("%d %s not replied", r.Pending, plural(r.Pending, "has", "have")))
+	}
+	return strings.Join(parts, ", ")
+}
+
+// plural picks the singular or plural form for n.
+func plural(n int, one, many string) string {
+	if n == 1 {
+		return one
+	}
+	return many
+}
@@ -0,0 +1,101 @@
+package tools
+
+import (
+	"fmt"
+	"net/mail"
+	"strings"
+)
+
+// invalidAttendeeModes are the GOOGLE_CALENDAR_INVALID_ATTENDEES values.
+var invalidAttendeeModes = []string{"reject", "skip"}
+
+// normalizeAttendees validates the attendees argument with net/mail,
+// lowercases the addresses and drops duplicates, keeping the first
+// occurrence. Display names ("Ada <ada@example.com>") are reduced to the
+// address. Malformed addresses fail the whole request in reject mode; in skip
+// mode they are returned separately so the caller can warn about them.
+// Non-string entries are ignored.
+func normalizeAttendees(list []any, mode string) (emails, skipped []string, err error) {
+	if mode != "reject" && mode != "skip" {
+		return nil, nil, fmt.Errorf("invalid attendees mode %q (expected one of %s)", mode, strings.Join(invalidAttendeeModes, ", "))
+	}
+
+	seen := make(map[string]bool)
+	for _, item := range list {
+		raw, ok := item.(string)
+		if !ok {
+			continue
+		}
+		addr, parseErr := mail.ParseAddress(strings.TrimSpace(raw))
+		if parseErr != nil {
+			if mode == "reject" {
+				return nil, nil, fmt.Errorf("invalid attendee email %q: %w", raw, parseErr)
+			}
+			skipped = append(skipped, raw)
+			continue
+		}
+		email := strings.ToLower(addr.Address)
+		if seen[email] {
+			continue
+		}
+		seen[email] = true
+		emails = append(emails, email)
+	}
+	return emails, skipped, nil
+}
+
+// checkAttendeeLimit refuses to invite more than limit attendees unless the
+// confirmLargeInvite argument is true. A limit of zero or less disables the
+// guard.
+func checkAttendeeLimit(count, limit int, args map[string]any) error {
+	if limit <= 0 || count <= limit {
+		return nil
+	}
+	confirmed := false
+	if v, exists := args["confirmLargeInvite"]; exists && v != nil {
+		b, ok := v.(bool)
+		if !ok {
+			return fmt.Errorf("confirmLargeInvite must be a boolean, got %T", v)
+		}
+		confirmed = b
+	}
+	if !confirmed {
+		return fmt.Errorf("event has %d attendees, more than the limit of %d; confirm with the user and retry with confirmLargeInvite: true", count, limit)
+	}
+	return nil
+}
+
+// mergeDefaultAttendees appends GOOGLE_CALENDAR_DEFAULT_ATTENDEES to the
+// requested attendees, skipping addresses already invited, unless the
+// skipDefaultAttendees argument is true.
+func mergeDefaultAttendees(emails []string, args map[string]any) ([]string, error) {
+	if v, exists := args["skipDefaultAttendees"]; exists && v != nil {
+		skip, ok := v.(bool)
+		if !ok {
+			return nil, fmt.Errorf("skipDefaultAttendees must be a boolean, got %T", v)
+		}
+		if skip {
+			return emails, nil
+		}
+	}
+
+	cfg, err := loadCalendarSettings()
+	if err != nil {
+		return nil, err
+	}
+	if len(cfg.DefaultAttendees) == 0 {
+		return emails, nil
+	}
+	list := make([]any, 0, len(emails)+len(cfg.DefaultAttendees))
+	for _, email := range emails {
+		list = append(list, email)
+	}
+	for _, email := range cfg.DefaultAttendees {
+		list = append(list, email)
+	}
+	merged, _, err := normalizeAttendees(list, "reject")
+	if err != nil {
+		return nil, fmt.Errorf("invalid GOOGLE_CALENDAR_DEFAULT_ATTENDEES: %w", err)
+	}
+	return merged, nil
+}
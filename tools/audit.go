@@ -0,0 +1,40 @@
+package tools
+
+import (
+	"context"
+
+	zap "go.uber.org/zap"
+
+	server "github.com/inference-gateway/adk/server"
+	types "github.com/inference-gateway/adk/types"
+
+	redact "github.com/inference-gateway/google-calendar-agent/internal/redact"
+)
+
+// AuditToolBox writes one info-level line for every tool call the LLM
+// makes, recording the tool, its arguments with email addresses masked, and
+// the A2A task and context it belongs to. The LLM does not report a
+// confidence for its choice, so none is logged.
+type AuditToolBox struct {
+	server.ToolBox
+	logger *zap.Logger
+}
+
+// NewAuditToolBox wraps inner so that each tool call is logged to logger.
+func NewAuditToolBox(inner server.ToolBox, logger *zap.Logger) server.ToolBox {
+	return &AuditToolBox{ToolBox: inner, logger: logger}
+}
+
+// ExecuteTool logs the tool call decision and executes the tool
+func (a *AuditToolBox) ExecuteTool(ctx context.Context, toolName string, arguments map[string]any) (string, error) {
+	fields := []zap.Field{
+		zap.String("tool", toolName),
+		zap.Any("arguments", redact.Value(arguments)),
+	}
+	if task, ok := ctx.Value(server.TaskContextKey).(*types.Task); ok && task != nil {
+		fields = append(fields, zap.String("contextId", task.ContextID), zap.String("taskId", task.ID))
+	}
+	a.logger.Info("tool call", fields...)
+
+	return a.ToolBox.ExecuteTool(ctx, toolName, arguments)
+}
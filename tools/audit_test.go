@@ -0,0 +1,60 @@
+package tools
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	zap "go.uber.org/zap"
+	zapcore "go.uber.org/zap/zapcore"
+	observer "go.uber.org/zap/zaptest/observer"
+
+	server "github.com/inference-gateway/adk/server"
+	types "github.com/inference-gateway/adk/types"
+)
+
+func TestAuditToolBoxLogsMaskedCall(t *testing.T) {
+	core, logs := observer.New(zapcore.InfoLevel)
+
+	inner := server.NewDefaultToolBox(nil)
+	inner.AddTool(server.NewBasicTool("echo", "Echo", map[string]any{"type": "object"},
+		func(ctx context.Context, args map[string]any) (string, error) { return "ok", nil }))
+	tb := NewAuditToolBox(inner, zap.New(core))
+
+	ctx := context.WithValue(context.Background(), server.TaskContextKey, &types.Task{ID: "task-1", ContextID: "ctx-1"})
+	result, err := tb.ExecuteTool(ctx, "echo", map[string]any{
+		"summary":   "1:1 with bob@example.com",
+		"attendees": []any{"alice@example.com"},
+	})
+	if err != nil || result != "ok" {
+		t.Fatalf("ExecuteTool() = %q, %v; want ok", result, err)
+	}
+
+	entries := logs.FilterMessage("tool call").All()
+	if len(entries) != 1 {
+		t.Fatalf("got %d tool call log lines, want 1", len(entries))
+	}
+	fields := entries[0].ContextMap()
+	if fields["tool"] != "echo" || fields["contextId"] != "ctx-1" || fields["taskId"] != "task-1" {
+		t.Errorf("fields = %v, want tool echo in ctx-1/task-1", fields)
+	}
+
+	args, ok := fields["arguments"].(map[string]any)
+	if !ok {
+		t.Fatalf("arguments = %T, want a map", fields["arguments"])
+	}
+	if args["summary"] != "1:1 with b***@example.com" {
+		t.Errorf("summary = %v, want the email masked", args["summary"])
+	}
+	attendees, _ := args["attendees"].([]any)
+	if len(attendees) != 1 || attendees[0] != "a***@example.com" {
+		t.Errorf("attendees = %v, want [a***@example.com]", args["attendees"])
+	}
+	for _, entry := range logs.All() {
+		for _, value := range entry.ContextMap() {
+			if s, ok := value.(string); ok && strings.Contains(s, "alice@example.com") {
+				t.Errorf("unmasked email logged in %q", entry.Message)
+			}
+		}
+	}
+}
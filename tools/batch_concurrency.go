@@ -0,0 +1,42 @@
+package tools
+
+import (
+	"fmt"
+	"sync"
+)
+
+// loadBatchConcurrency reads GOOGLE_CALENDAR_BATCH_CONCURRENCY.
+func loadBatchConcurrency() (int, error) {
+	cfg, err := loadCalendarSettings()
+	if err != nil {
+		return 1, err
+	}
+	if cfg.BatchConcurrency < 1 {
+		return 1, fmt.Errorf("invalid batch concurrency %d (expected at least 1)", cfg.BatchConcurrency)
+	}
+	return cfg.BatchConcurrency, nil
+}
+
+// runBatch calls fn for each index in [0, n) using at most concurrency
+// goroutines and returns the results in index order, whatever order the
+// calls finish in.
+func runBatch(n, concurrency int, fn func(i int) map[string]any) []map[string]any {
+	results := make([]map[string]any, n)
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for w := 0; w < min(concurrency, n); w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				results[i] = fn(i)
+			}
+		}()
+	}
+	for i := 0; i < n; i++ {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+	return results
+}
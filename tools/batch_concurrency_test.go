@@ -0,0 +1,149 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	zap "go.uber.org/zap"
+	calendar "google.golang.org/api/calendar/v3"
+)
+
+func TestRunBatch(t *testing.T) {
+	tests := []struct {
+		name        string
+		n           int
+		concurrency int
+	}{
+		{name: "sequential", n: 5, concurrency: 1},
+		{name: "bounded pool", n: 20, concurrency: 3},
+		{name: "more workers than items", n: 2, concurrency: 8},
+		{name: "empty batch", n: 0, concurrency: 4},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var inFlight, peak atomic.Int32
+			results := runBatch(tc.n, tc.concurrency, func(i int) map[string]any {
+				current := inFlight.Add(1)
+				for {
+					p := peak.Load()
+					if current <= p || peak.CompareAndSwap(p, current) {
+						break
+					}
+				}
+				// Later items finish first, so order comes from the index alone.
+				time.Sleep(time.Duration(tc.n-i) * time.Millisecond)
+				inFlight.Add(-1)
+				return map[string]any{"index": i}
+			})
+
+			if len(results) != tc.n {
+				t.Fatalf("got %d results, want %d", len(results), tc.n)
+			}
+			for i, result := range results {
+				if result["index"] != i {
+					t.Errorf("results[%d] = %v, want index %d", i, result, i)
+				}
+			}
+			if got := int(peak.Load()); got > tc.concurrency {
+				t.Errorf("peak concurrency = %d, want at most %d", got, tc.concurrency)
+			}
+		})
+	}
+}
+
+func TestLoadBatchConcurrency(t *testing.T) {
+	tests := []struct {
+		value      string
+		want       int
+		wantErrSub string
+	}{
+		{value: "8", want: 8},
+		{value: "0", wantErrSub: "invalid batch concurrency 0"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.value, func(t *testing.T) {
+			t.Setenv("GOOGLE_CALENDAR_BATCH_CONCURRENCY", tc.value)
+			got, err := loadBatchConcurrency()
+			if tc.wantErrSub != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErrSub) {
+					t.Fatalf("error = %v, want substring %q", err, tc.wantErrSub)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tc.want {
+				t.Errorf("concurrency = %d, want %d", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestBatchCreateConcurrentResultsKeepOrder(t *testing.T) {
+	t.Setenv("GOOGLE_CALENDAR_BATCH_CONCURRENCY", "3")
+	t.Setenv("GOOGLE_CALENDAR_TIMEZONE", "UTC")
+	t.Setenv("TZ", "")
+
+	var items []any
+	for i := 0; i < 9; i++ {
+		items = append(items, map[string]any{
+			"summary":   fmt.Sprintf("Event %d", i),
+			"startTime": fmt.Sprintf("2026-05-25T%02d:00:00Z", 8+i),
+			"endTime":   fmt.Sprintf("2026-05-25T%02d:30:00Z", 8+i),
+		})
+	}
+
+	var inFlight, peak atomic.Int32
+	stub := &stubCalendarService{
+		createEventFn: func(calendarID string, event *calendar.Event) (*calendar.Event, error) {
+			current := inFlight.Add(1)
+			defer inFlight.Add(-1)
+			for {
+				p := peak.Load()
+				if current <= p || peak.CompareAndSwap(p, current) {
+					break
+				}
+			}
+			time.Sleep(5 * time.Millisecond)
+			out := *event
+			out.Id = "id-" + strings.TrimPrefix(event.Summary, "Event ")
+			return &out, nil
+		},
+	}
+	tool := &BatchCreateCalendarEventsTool{logger: zap.NewNop(), google: stub}
+	result, err := tool.BatchCreateCalendarEventsHandler(context.Background(), map[string]any{"events": items})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var parsed struct {
+		Created int `json:"created"`
+		Results []struct {
+			Index   int    `json:"index"`
+			EventID string `json:"eventId"`
+			Summary string `json:"summary"`
+		} `json:"results"`
+	}
+	if err := json.Unmarshal([]byte(result), &parsed); err != nil {
+		t.Fatalf("failed to unmarshal result: %v", err)
+	}
+	if parsed.Created != len(items) || len(parsed.Results) != len(items) {
+		t.Fatalf("created %d with %d results, want %d", parsed.Created, len(parsed.Results), len(items))
+	}
+	for i, r := range parsed.Results {
+		if r.Index != i || r.EventID != fmt.Sprintf("id-%d", i) || r.Summary != fmt.Sprintf("Event %d", i) {
+			t.Errorf("results[%d] = %+v, want event %d", i, r, i)
+		}
+	}
+	if got := peak.Load(); got > 3 {
+		t.Errorf("peak concurrency = %d, want at most 3", got)
+	}
+}
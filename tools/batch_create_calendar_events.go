@@ -0,0 +1,191 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	zap "go.uber.org/zap"
+
+	server "github.com/inference-gateway/adk/server"
+
+	google "github.com/inference-gateway/google-calendar-agent/internal/google"
+)
+
+// maxBatchEvents caps a single batch so one call cannot burn through the
+// Calendar API quota.
+const maxBatchEvents = 50
+
+// BatchCreateCalendarEventsTool struct holds the tool with dependencies
+type BatchCreateCalendarEventsTool struct {
+	logger *zap.Logger
+	google google.CalendarService
+}
+
+// NewBatchCreateCalendarEventsTool creates a new batch_create_calendar_events tool
+func NewBatchCreateCalendarEventsTool(logger *zap.Logger, google google.CalendarService) server.Tool {
+	tool := &BatchCreateCalendarEventsTool{
+		logger: logger,
+		google: google,
+	}
+	return server.NewBasicTool(
+		"batch_create_calendar_events",
+		"Create several events in Google Calendar from a list, reporting the result of each",
+		map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"events": map[string]any{
+					"description": fmt.Sprintf("Events to create, in order (max %d). Each takes the same fields as create_calendar_event.", maxBatchEvents),
+					"items": map[string]any{
+						"type": "object",
+						"properties": map[string]any{
+							"attendees":            map[string]any{"items": map[string]any{"type": "string"}, "type": "array"},
+							"description":          map[string]any{"type": "string"},
+							"endTime":              map[string]any{"description": "RFC3339", "type": "string"},
+							"location":             map[string]any{"type": "string"},
+							"reminders":            map[string]any{"items": map[string]any{"type": "integer"}, "type": "array"},
+							"skipDefaultAttendees": map[string]any{"type": "boolean"},
+							"startTime":            map[string]any{"description": "RFC3339", "type": "string"},
+							"summary":              map[string]any{"type": "string"},
+						},
+						"required": []string{"summary", "startTime", "endTime"},
+					},
+					"maxItems": maxBatchEvents,
+					"minItems": 1,
+					"type":     "array",
+				},
+				"skipConflicts": map[string]any{
+					"description": "Check each event for conflicts and skip the ones that overlap existing events (default: false)",
+					"type":        "boolean",
+				},
+			},
+			"required": []string{"events"},
+		},
+		tool.BatchCreateCalendarEventsHandler,
+	)
+}
+
+// BatchCreateCalendarEventsHandler handles the batch_create_calendar_events tool execution
+func (s *BatchCreateCalendarEventsTool) BatchCreateCalendarEventsHandler(ctx context.Context, args map[string]any) (string, error) {
+	span := startToolSpan(ctx, "batch_create_calendar_events")
+	defer span.End()
+	s.logger.Debug("batch creating calendar events", zap.Any("args", args))
+
+	items, ok := args["events"].([]any)
+	if !ok || len(items) == 0 {
+		return "", fmt.Errorf("events is required")
+	}
+	if len(items) > maxBatchEvents {
+		return "", fmt.Errorf("events must contain at most %d items, got %d", maxBatchEvents, len(items))
+	}
+
+	skipConflicts := false
+	if v, exists := args["skipConflicts"]; exists && v != nil {
+		b, ok := v.(bool)
+		if !ok {
+			return "", fmt.Errorf("skipConflicts must be a boolean, got %T", v)
+		}
+		skipConflicts = b
+	}
+
+	concurrency, err := loadBatchConcurrency()
+	if err != nil {
+		return "", err
+	}
+
+	calendarID := s.google.GetCalendarID()
+	results := runBatch(len(items), concurrency, func(i int) map[string]any {
+		result := s.createOne(ctx, calendarID, items[i], skipConflicts)
+		result["index"] = i
+		return result
+	})
+	created, skipped, failed := 0, 0, 0
+	for _, result := range results {
+		switch result["status"] {
+		case "created":
+			created++
+		case "skipped":
+			skipped++
+		default:
+			failed++
+		}
+	}
+
+	s.logger.Info("batch create finished",
+		zap.Int("created", created),
+		zap.Int("skipped", skipped),
+		zap.Int("failed", failed))
+
+	response := map[string]any{
+		"success": failed == 0,
+		"results": results,
+		"created": created,
+		"skipped": skipped,
+		"failed":  failed,
+	}
+
+	resultJSON, err := json.Marshal(response)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal result: %w", err)
+	}
+
+	return string(resultJSON), nil
+}
+
+// createOne creates a single batch item. Failures are reported in the
+// returned result rather than aborting the batch.
+func (s *BatchCreateCalendarEventsTool) createOne(ctx context.Context, calendarID string, item any, skipConflicts bool) map[string]any {
+	itemArgs, ok := item.(map[string]any)
+	if !ok {
+		return map[string]any{"status": "failed", "error": fmt.Sprintf("event must be an object, got %T", item)}
+	}
+	summary, _ := itemArgs["summary"].(string)
+
+	loc, tzName := calendarTimezone(ctx, s.google, calendarID)
+	event, skippedAttendees, err := eventFromArgs(itemArgs, loc, tzName)
+	if err != nil {
+		return map[string]any{"status": "failed", "summary": summary, "error": err.Error()}
+	}
+	if len(skippedAttendees) > 0 {
+		s.logger.Warn("skipping invalid attendee emails", zap.Strings("attendees", skippedAttendees), zap.String("summary", summary))
+	}
+
+	if skipConflicts {
+		start, _ := time.Parse(time.RFC3339, event.Start.DateTime)
+		end, _ := time.Parse(time.RFC3339, event.End.DateTime)
+		conflicts, err := s.google.CheckConflicts(ctx, calendarID, start, end)
+		if err != nil {
+			s.logger.Warn("failed to check conflicts for batch item", zap.Error(err), zap.String("summary", summary))
+			return map[string]any{"status": "failed", "summary": summary, "error": fmt.Sprintf("failed to check conflicts: %v", err)}
+		}
+		if len(conflicts) > 0 {
+			var ids []string
+			for _, c := range conflicts {
+				ids = append(ids, c.Id)
+			}
+			return map[string]any{"status": "skipped", "summary": summary, "conflicts": ids}
+		}
+	}
+
+	createdEvent, err := s.google.CreateEvent(ctx, calendarID, event)
+	if err != nil {
+		s.logger.Warn("failed to create batch item", zap.Error(err), zap.String("summary", summary))
+		return map[string]any{"status": "failed", "summary": summary, "error": err.Error()}
+	}
+
+	result := map[string]any{
+		"status":    "created",
+		"eventId":   createdEvent.Id,
+		"summary":   createdEvent.Summary,
+		"startTime": event.Start.DateTime,
+		"endTime":   event.End.DateTime,
+	}
+	if viewLink := viewLinkFor(calendarID, createdEvent); viewLink != "" {
+		result["viewLink"] = viewLink
+	}
+	if len(skippedAttendees) > 0 {
+		result["skippedAttendees"] = skippedAttendees
+	}
+	return result
+}
@@ -0,0 +1,138 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	zap "go.uber.org/zap"
+	calendar "google.golang.org/api/calendar/v3"
+)
+
+func TestBatchCreateCalendarEventsHandler(t *testing.T) {
+	// One worker keeps the stub calls in batch order.
+	t.Setenv("GOOGLE_CALENDAR_BATCH_CONCURRENCY", "1")
+
+	batch := []any{
+		map[string]any{"summary": "Kickoff", "startTime": "2026-05-25T09:00:00Z", "endTime": "2026-05-25T10:00:00Z"},
+		map[string]any{"summary": "Clashes", "startTime": "2026-05-25T12:00:00Z", "endTime": "2026-05-25T13:00:00Z"},
+		map[string]any{"summary": "No end", "startTime": "2026-05-25T14:00:00Z"},
+		map[string]any{"summary": "Rejected", "startTime": "2026-05-25T15:00:00Z", "endTime": "2026-05-25T16:00:00Z"},
+		"not an object",
+		map[string]any{"summary": "Wrap-up", "startTime": "2026-05-25T17:00:00Z", "endTime": "2026-05-25T17:30:00Z"},
+	}
+
+	tests := []struct {
+		name          string
+		args          map[string]any
+		wantErrSub    string
+		wantStatuses  []string
+		wantCreated   []string
+		wantSuccess   bool
+		wantConflicts []string
+	}{
+		{
+			name:         "continues past failures without conflict checks",
+			args:         map[string]any{"events": batch},
+			wantStatuses: []string{"created", "created", "failed", "failed", "failed", "created"},
+			wantCreated:  []string{"Kickoff", "Clashes", "Wrap-up"},
+		},
+		{
+			name:          "skipConflicts skips the overlapping event",
+			args:          map[string]any{"events": batch, "skipConflicts": true},
+			wantStatuses:  []string{"created", "skipped", "failed", "failed", "failed", "created"},
+			wantCreated:   []string{"Kickoff", "Wrap-up"},
+			wantConflicts: []string{"lunch"},
+		},
+		{
+			name:         "all created reports success",
+			args:         map[string]any{"events": batch[:1], "skipConflicts": true},
+			wantStatuses: []string{"created"},
+			wantCreated:  []string{"Kickoff"},
+			wantSuccess:  true,
+		},
+		{
+			name:       "missing events returns error",
+			args:       map[string]any{},
+			wantErrSub: "events is required",
+		},
+		{
+			name:       "wrong-typed skipConflicts returns error",
+			args:       map[string]any{"events": batch, "skipConflicts": "yes"},
+			wantErrSub: "skipConflicts must be a boolean",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var created []string
+			stub := &stubCalendarService{
+				createEventFn: func(calendarID string, event *calendar.Event) (*calendar.Event, error) {
+					if event.Summary == "Rejected" {
+						return nil, errors.New("forbidden")
+					}
+					created = append(created, event.Summary)
+					return &calendar.Event{Id: strings.ToLower(event.Summary), Summary: event.Summary}, nil
+				},
+				checkConflictsFn: func(calendarID string, startTime, endTime time.Time) ([]*calendar.Event, error) {
+					lunchStart := time.Date(2026, 5, 25, 12, 30, 0, 0, time.UTC)
+					if startTime.Before(lunchStart.Add(time.Hour)) && endTime.After(lunchStart) {
+						return []*calendar.Event{{Id: "lunch"}}, nil
+					}
+					return nil, nil
+				},
+			}
+			tool := &BatchCreateCalendarEventsTool{logger: zap.NewNop(), google: stub}
+			result, err := tool.BatchCreateCalendarEventsHandler(context.Background(), tc.args)
+
+			if tc.wantErrSub != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErrSub) {
+					t.Fatalf("error = %v, want substring %q", err, tc.wantErrSub)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			var parsed struct {
+				Success bool `json:"success"`
+				Results []struct {
+					Index     int      `json:"index"`
+					Status    string   `json:"status"`
+					Error     string   `json:"error"`
+					Conflicts []string `json:"conflicts"`
+				} `json:"results"`
+			}
+			if err := json.Unmarshal([]byte(result), &parsed); err != nil {
+				t.Fatalf("failed to unmarshal result: %v", err)
+			}
+			if parsed.Success != tc.wantSuccess {
+				t.Errorf("success = %v, want %v", parsed.Success, tc.wantSuccess)
+			}
+			var statuses, conflicts []string
+			for i, r := range parsed.Results {
+				if r.Index != i {
+					t.Errorf("results[%d].index = %d", i, r.Index)
+				}
+				if r.Status == "failed" && r.Error == "" {
+					t.Errorf("results[%d] failed without an error", i)
+				}
+				statuses = append(statuses, r.Status)
+				conflicts = append(conflicts, r.Conflicts...)
+			}
+			if got, want := strings.Join(statuses, ","), strings.Join(tc.wantStatuses, ","); got != want {
+				t.Errorf("statuses = %s, want %s", got, want)
+			}
+			if got, want := strings.Join(created, ","), strings.Join(tc.wantCreated, ","); got != want {
+				t.Errorf("created = %s, want %s", got, want)
+			}
+			if got, want := strings.Join(conflicts, ","), strings.Join(tc.wantConflicts, ","); got != want {
+				t.Errorf("conflicts = %s, want %s", got, want)
+			}
+		})
+	}
+}
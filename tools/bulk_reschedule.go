@@ -0,0 +1,276 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	zap "go.uber.org/zap"
+	calendar "google.golang.org/api/calendar/v3"
+
+	server "github.com/inference-gateway/adk/server"
+
+	google "github.com/inference-gateway/google-calendar-agent/internal/google"
+)
+
+// BulkRescheduleTool struct holds the tool with dependencies
+type BulkRescheduleTool struct {
+	logger *zap.Logger
+	google google.CalendarService
+}
+
+// NewBulkRescheduleTool creates a new bulk_reschedule tool
+func NewBulkRescheduleTool(logger *zap.Logger, google google.CalendarService) server.Tool {
+	tool := &BulkRescheduleTool{
+		logger: logger,
+		google: google,
+	}
+	return server.NewBasicTool(
+		"bulk_reschedule",
+		"Shift every event matching a query within a time range by the same duration, with a dry-run preview",
+		map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"dryRun": map[string]any{
+					"description": "Only report what would move, without changing any event. Defaults to false.",
+					"type":        "boolean",
+				},
+				"query": map[string]any{
+					"description": "Text matched case-insensitively against event titles and descriptions (required)",
+					"type":        "string",
+				},
+				"shiftBy": map[string]any{
+					"description": "How far to move each event, as a Go duration such as \"1h\" or \"168h\" for a week; negative values move events earlier (required)",
+					"type":        "string",
+				},
+				"timeMax": map[string]any{
+					"description": "End of the range to search (RFC3339 format) (required)",
+					"type":        "string",
+				},
+				"timeMin": map[string]any{
+					"description": "Start of the range to search (RFC3339 format) (required)",
+					"type":        "string",
+				},
+			},
+			"required": []string{"query", "shiftBy", "timeMin", "timeMax"},
+		},
+		tool.BulkRescheduleHandler,
+	)
+}
+
+// BulkRescheduleHandler handles the bulk_reschedule tool execution
+func (s *BulkRescheduleTool) BulkRescheduleHandler(ctx context.Context, args map[string]any) (string, error) {
+	span := startToolSpan(ctx, "bulk_reschedule")
+	defer span.End()
+	s.logger.Debug("bulk rescheduling calendar events", zap.Any("args", args))
+
+	query, ok := args["query"].(string)
+	if !ok || strings.TrimSpace(query) == "" {
+		return "", fmt.Errorf("query is required")
+	}
+
+	shiftStr, ok := args["shiftBy"].(string)
+	if !ok || shiftStr == "" {
+		return "", fmt.Errorf("shiftBy is required")
+	}
+	shift, err := time.ParseDuration(shiftStr)
+	if err != nil {
+		return "", fmt.Errorf("invalid shiftBy %q (expected a duration such as 1h or 168h): %w", shiftStr, err)
+	}
+	if shift == 0 {
+		return "", fmt.Errorf("shiftBy must not be zero")
+	}
+
+	timeMinStr, ok := args["timeMin"].(string)
+	if !ok || timeMinStr == "" {
+		return "", fmt.Errorf("timeMin is required")
+	}
+	timeMin, err := time.Parse(time.RFC3339, timeMinStr)
+	if err != nil {
+		return "", fmt.Errorf("invalid timeMin format (expected RFC3339): %w", err)
+	}
+	timeMaxStr, ok := args["timeMax"].(string)
+	if !ok || timeMaxStr == "" {
+		return "", fmt.Errorf("timeMax is required")
+	}
+	timeMax, err := time.Parse(time.RFC3339, timeMaxStr)
+	if err != nil {
+		return "", fmt.Errorf("invalid timeMax format (expected RFC3339): %w", err)
+	}
+	if !timeMax.After(timeMin) {
+		return "", fmt.Errorf("timeMax must be after timeMin")
+	}
+
+	dryRun := false
+	if v, exists := args["dryRun"]; exists && v != nil {
+		b, ok := v.(bool)
+		if !ok {
+			return "", fmt.Errorf("dryRun must be a boolean, got %T", v)
+		}
+		dryRun = b
+	}
+
+	calendarID := s.google.GetCalendarID()
+	loc, _ := calendarTimezone(ctx, s.google, calendarID)
+	events, err := s.google.ListEvents(ctx, calendarID, timeMin, timeMax)
+	if err != nil {
+		s.logger.Error("failed to list calendar events", zap.Error(err))
+		return "", fmt.Errorf("failed to list calendar events: %w", err)
+	}
+
+	lowerQuery := strings.ToLower(query)
+	var matched []*calendar.Event
+	for _, event := range events {
+		if strings.Contains(strings.ToLower(event.Summary), lowerQuery) ||
+			strings.Contains(strings.ToLower(event.Description), lowerQuery) {
+			matched = append(matched, event)
+		}
+	}
+	if len(matched) > maxBatchEvents {
+		return "", fmt.Errorf("query matches %d events, more than the limit of %d; narrow the query or time range", len(matched), maxBatchEvents)
+	}
+
+	concurrency, err := loadBatchConcurrency()
+	if err != nil {
+		return "", err
+	}
+
+	results := runBatch(len(matched), concurrency, func(i int) map[string]any {
+		return s.rescheduleOne(ctx, calendarID, matched[i], shift, loc, dryRun)
+	})
+	moved, skipped, failed := 0, 0, 0
+	for _, result := range results {
+		switch result["status"] {
+		case "moved", "preview":
+			moved++
+		case "skipped":
+			skipped++
+		default:
+			failed++
+		}
+	}
+
+	s.logger.Info("bulk reschedule finished",
+		zap.Bool("dryRun", dryRun),
+		zap.Int("moved", moved),
+		zap.Int("skipped", skipped),
+		zap.Int("failed", failed))
+
+	response := map[string]any{
+		"success": failed == 0,
+		"dryRun":  dryRun,
+		"shiftBy": shift.String(),
+		"matched": len(matched),
+		"results": results,
+		"moved":   moved,
+		"skipped": skipped,
+		"failed":  failed,
+	}
+
+	resultJSON, err := json.Marshal(response)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal result: %w", err)
+	}
+
+	return string(resultJSON), nil
+}
+
+// rescheduleOne shifts a single event, or only computes its new times when
+// dryRun is set. Failures are reported in the returned result rather than
+// aborting the remaining events. loc is the calendar's timezone.
+func (s *BulkRescheduleTool) rescheduleOne(ctx context.Context, calendarID string, event *calendar.Event, shift time.Duration, loc *time.Location, dryRun bool) map[string]any {
+	result := map[string]any{
+		"eventId":      event.Id,
+		"summary":      event.Summary,
+		"oldStartTime": eventDateTimeString(event.Start),
+		"oldEndTime":   eventDateTimeString(event.End),
+	}
+
+	start, end, err := shiftEventTimes(event, shift, loc)
+	if err != nil {
+		result["status"] = "skipped"
+		result["reason"] = err.Error()
+		return result
+	}
+	result["startTime"] = eventDateTimeString(start)
+	result["endTime"] = eventDateTimeString(end)
+
+	if dryRun {
+		result["status"] = "preview"
+		return result
+	}
+
+	event.Start, event.End = start, end
+	updatedEvent, err := s.google.UpdateEvent(ctx, calendarID, event.Id, event)
+	if err != nil {
+		s.logger.Warn("failed to reschedule event", zap.Error(err), zap.String("eventId", event.Id))
+		result["status"] = "failed"
+		result["error"] = err.Error()
+		return result
+	}
+	result["status"] = "moved"
+	if viewLink := viewLinkFor(calendarID, updatedEvent); viewLink != "" {
+		result["viewLink"] = viewLink
+	}
+	return result
+}
+
+// shiftEventTimes returns event's start and end moved by shift, keeping
+// their timezones. All-day events can only move by whole days. Timed events
+// without a timezone of their own are shifted in loc.
+func shiftEventTimes(event *calendar.Event, shift time.Duration, loc *time.Location) (start, end *calendar.EventDateTime, err error) {
+	if event.Start == nil || event.End == nil {
+		return nil, nil, fmt.Errorf("event has no start or end time")
+	}
+
+	if event.Start.DateTime == "" || event.End.DateTime == "" {
+		if shift%(24*time.Hour) != 0 {
+			return nil, nil, fmt.Errorf("all-day events can only move by whole days, got %s", shift)
+		}
+		days := int(shift / (24 * time.Hour))
+		startDate, err := time.Parse("2006-01-02", event.Start.Date)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid event start date: %w", err)
+		}
+		endDate, err := time.Parse("2006-01-02", event.End.Date)
+		if err != nil {
+			return nil, nil, fmt.Errorf("invalid event end date: %w", err)
+		}
+		return &calendar.EventDateTime{Date: startDate.AddDate(0, 0, days).Format("2006-01-02")},
+			&calendar.EventDateTime{Date: endDate.AddDate(0, 0, days).Format("2006-01-02")},
+			nil
+	}
+
+	oldStart, err := time.Parse(time.RFC3339, event.Start.DateTime)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid event start time: %w", err)
+	}
+	oldEnd, err := time.Parse(time.RFC3339, event.End.DateTime)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid event end time: %w", err)
+	}
+	newStart := shiftDateTime(oldStart, shift, event.Start.TimeZone, loc)
+	newEnd := shiftDateTime(oldEnd, shift, event.End.TimeZone, loc)
+	return &calendar.EventDateTime{DateTime: newStart.Format(time.RFC3339), TimeZone: event.Start.TimeZone},
+		&calendar.EventDateTime{DateTime: newEnd.Format(time.RFC3339), TimeZone: event.End.TimeZone},
+		nil
+}
+
+// shiftDateTime moves t by shift. A whole number of days is added to the
+// date in zone, or in loc when zone is empty or unknown, so the event keeps
+// its wall-clock time across a daylight saving change: a week later is
+// 09:00 again, not 08:00. Other shifts are exact durations. The result
+// carries the offset in effect in that timezone.
+func shiftDateTime(t time.Time, shift time.Duration, zone string, loc *time.Location) time.Time {
+	if zone != "" {
+		if zoneLoc, err := time.LoadLocation(zone); err == nil {
+			loc = zoneLoc
+		}
+	}
+	if shift%(24*time.Hour) != 0 {
+		return t.Add(shift).In(loc)
+	}
+	return t.In(loc).AddDate(0, 0, int(shift/(24*time.Hour)))
+}
@@ -0,0 +1,253 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	zap "go.uber.org/zap"
+	calendar "google.golang.org/api/calendar/v3"
+)
+
+func TestBulkRescheduleHandler(t *testing.T) {
+	// One worker keeps the stub calls in batch order.
+	t.Setenv("GOOGLE_CALENDAR_BATCH_CONCURRENCY", "1")
+
+	newEvents := func() []*calendar.Event {
+		return []*calendar.Event{
+			{
+				Id:      "focus-1",
+				Summary: "Focus block",
+				Start:   &calendar.EventDateTime{DateTime: "2026-05-18T09:00:00Z", TimeZone: "Europe/Berlin"},
+				End:     &calendar.EventDateTime{DateTime: "2026-05-18T11:00:00Z", TimeZone: "Europe/Berlin"},
+			},
+			{
+				Id:      "focus-2",
+				Summary: "Deep FOCUS",
+				Start:   &calendar.EventDateTime{Date: "2026-05-20"},
+				End:     &calendar.EventDateTime{Date: "2026-05-21"},
+			},
+			{
+				Id:      "standup",
+				Summary: "Standup",
+				Start:   &calendar.EventDateTime{DateTime: "2026-05-18T10:00:00Z"},
+				End:     &calendar.EventDateTime{DateTime: "2026-05-18T10:15:00Z"},
+			},
+		}
+	}
+	baseArgs := func(extra map[string]any) map[string]any {
+		args := map[string]any{
+			"query":   "focus",
+			"shiftBy": "168h",
+			"timeMin": "2026-05-18T00:00:00Z",
+			"timeMax": "2026-05-25T00:00:00Z",
+		}
+		for k, v := range extra {
+			args[k] = v
+		}
+		return args
+	}
+
+	type outcome struct {
+		EventID   string `json:"eventId"`
+		Status    string `json:"status"`
+		StartTime string `json:"startTime"`
+		EndTime   string `json:"endTime"`
+		Reason    string `json:"reason"`
+		Error     string `json:"error"`
+	}
+
+	tests := []struct {
+		name         string
+		args         map[string]any
+		updateErr    map[string]error
+		wantErrSub   string
+		wantUpdated  []string
+		wantOutcomes map[string]outcome
+		wantSuccess  bool
+	}{
+		{
+			name:        "dry run previews without updating",
+			args:        baseArgs(map[string]any{"dryRun": true}),
+			wantSuccess: true,
+			wantOutcomes: map[string]outcome{
+				"focus-1": {Status: "preview", StartTime: "2026-05-25T11:00:00+02:00", EndTime: "2026-05-25T13:00:00+02:00"},
+				"focus-2": {Status: "preview", StartTime: "2026-05-27", EndTime: "2026-05-28"},
+			},
+		},
+		{
+			name:        "moves every matching event",
+			args:        baseArgs(nil),
+			wantSuccess: true,
+			wantUpdated: []string{"focus-1", "focus-2"},
+			wantOutcomes: map[string]outcome{
+				"focus-1": {Status: "moved", StartTime: "2026-05-25T11:00:00+02:00", EndTime: "2026-05-25T13:00:00+02:00"},
+				"focus-2": {Status: "moved", StartTime: "2026-05-27", EndTime: "2026-05-28"},
+			},
+		},
+		{
+			name:        "all-day events skip partial-day shifts",
+			args:        baseArgs(map[string]any{"shiftBy": "-30m"}),
+			wantSuccess: true,
+			wantUpdated: []string{"focus-1"},
+			wantOutcomes: map[string]outcome{
+				"focus-1": {Status: "moved", StartTime: "2026-05-18T10:30:00+02:00", EndTime: "2026-05-18T12:30:00+02:00"},
+				"focus-2": {Status: "skipped", Reason: "all-day events can only move by whole days"},
+			},
+		},
+		{
+			name:        "update failures are reported per event",
+			args:        baseArgs(nil),
+			updateErr:   map[string]error{"focus-2": errors.New("quota exceeded")},
+			wantUpdated: []string{"focus-1", "focus-2"},
+			wantOutcomes: map[string]outcome{
+				"focus-1": {Status: "moved", StartTime: "2026-05-25T11:00:00+02:00", EndTime: "2026-05-25T13:00:00+02:00"},
+				"focus-2": {Status: "failed", StartTime: "2026-05-27", EndTime: "2026-05-28", Error: "quota exceeded"},
+			},
+		},
+		{
+			name:       "zero shift returns error",
+			args:       baseArgs(map[string]any{"shiftBy": "0s"}),
+			wantErrSub: "shiftBy must not be zero",
+		},
+		{
+			name:       "invalid shift returns error",
+			args:       baseArgs(map[string]any{"shiftBy": "next week"}),
+			wantErrSub: "invalid shiftBy",
+		},
+		{
+			name:       "missing query returns error",
+			args:       baseArgs(map[string]any{"query": ""}),
+			wantErrSub: "query is required",
+		},
+		{
+			name:       "inverted range returns error",
+			args:       baseArgs(map[string]any{"timeMax": "2026-05-17T00:00:00Z"}),
+			wantErrSub: "timeMax must be after timeMin",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var updated []string
+			stub := &stubCalendarService{
+				listEventsFn: func(calendarID string, timeMin, timeMax time.Time) ([]*calendar.Event, error) {
+					return newEvents(), nil
+				},
+				updateEventFn: func(calendarID, eventID string, event *calendar.Event) (*calendar.Event, error) {
+					updated = append(updated, eventID)
+					if err := tc.updateErr[eventID]; err != nil {
+						return nil, err
+					}
+					if event.Start.DateTime != "" && event.Start.TimeZone != "Europe/Berlin" {
+						t.Errorf("TimeZone = %q, want the original Europe/Berlin", event.Start.TimeZone)
+					}
+					return event, nil
+				},
+			}
+			tool := &BulkRescheduleTool{logger: zap.NewNop(), google: stub}
+			result, err := tool.BulkRescheduleHandler(context.Background(), tc.args)
+
+			if tc.wantErrSub != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErrSub) {
+					t.Fatalf("error = %v, want substring %q", err, tc.wantErrSub)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if strings.Join(updated, ",") != strings.Join(tc.wantUpdated, ",") {
+				t.Errorf("updated = %v, want %v", updated, tc.wantUpdated)
+			}
+
+			var parsed struct {
+				Success bool      `json:"success"`
+				Matched int       `json:"matched"`
+				Results []outcome `json:"results"`
+			}
+			if err := json.Unmarshal([]byte(result), &parsed); err != nil {
+				t.Fatalf("failed to unmarshal result: %v", err)
+			}
+			if parsed.Success != tc.wantSuccess {
+				t.Errorf("success = %v, want %v", parsed.Success, tc.wantSuccess)
+			}
+			if parsed.Matched != 2 || len(parsed.Results) != 2 {
+				t.Fatalf("matched %d with %d results, want 2: %s", parsed.Matched, len(parsed.Results), result)
+			}
+			for _, got := range parsed.Results {
+				want, ok := tc.wantOutcomes[got.EventID]
+				if !ok {
+					t.Errorf("unexpected outcome for %s", got.EventID)
+					continue
+				}
+				if got.Status != want.Status || got.StartTime != want.StartTime || got.EndTime != want.EndTime {
+					t.Errorf("%s = %+v, want %+v", got.EventID, got, want)
+				}
+				if !strings.Contains(got.Reason, want.Reason) || !strings.Contains(got.Error, want.Error) {
+					t.Errorf("%s reason/error = %q/%q, want %q/%q", got.EventID, got.Reason, got.Error, want.Reason, want.Error)
+				}
+			}
+		})
+	}
+}
+
+func TestShiftEventTimesKeepsWallClockAcrossDST(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Fatalf("LoadLocation: %v", err)
+	}
+	tests := []struct {
+		name      string
+		event     *calendar.Event
+		shift     time.Duration
+		wantStart string
+		wantEnd   string
+	}{
+		{
+			name: "a week later in the event's timezone",
+			event: &calendar.Event{
+				Start: &calendar.EventDateTime{DateTime: "2026-10-20T09:00:00+02:00", TimeZone: "Europe/Berlin"},
+				End:   &calendar.EventDateTime{DateTime: "2026-10-20T10:00:00+02:00", TimeZone: "Europe/Berlin"},
+			},
+			shift:     168 * time.Hour,
+			wantStart: "2026-10-27T09:00:00+01:00",
+			wantEnd:   "2026-10-27T10:00:00+01:00",
+		},
+		{
+			name: "a week later in the calendar's timezone",
+			event: &calendar.Event{
+				Start: &calendar.EventDateTime{DateTime: "2026-10-20T09:00:00+02:00"},
+				End:   &calendar.EventDateTime{DateTime: "2026-10-20T10:00:00+02:00"},
+			},
+			shift:     168 * time.Hour,
+			wantStart: "2026-10-27T09:00:00+01:00",
+			wantEnd:   "2026-10-27T10:00:00+01:00",
+		},
+		{
+			name: "hours are an exact duration",
+			event: &calendar.Event{
+				Start: &calendar.EventDateTime{DateTime: "2026-10-24T23:00:00+02:00", TimeZone: "Europe/Berlin"},
+				End:   &calendar.EventDateTime{DateTime: "2026-10-25T00:00:00+02:00", TimeZone: "Europe/Berlin"},
+			},
+			shift:     6 * time.Hour,
+			wantStart: "2026-10-25T04:00:00+01:00",
+			wantEnd:   "2026-10-25T05:00:00+01:00",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			start, end, err := shiftEventTimes(tc.event, tc.shift, berlin)
+			if err != nil {
+				t.Fatalf("shiftEventTimes: %v", err)
+			}
+			if start.DateTime != tc.wantStart || end.DateTime != tc.wantEnd {
+				t.Errorf("shifted to %s - %s, want %s - %s", start.DateTime, end.DateTime, tc.wantStart, tc.wantEnd)
+			}
+		})
+	}
+}
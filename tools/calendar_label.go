@@ -0,0 +1,22 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+
+	google "github.com/inference-gateway/google-calendar-agent/internal/google"
+)
+
+// calendarLabel names a calendar for user-facing messages, e.g.
+// "Work (work@company.com)" instead of the alias "primary". It falls back
+// to the raw ID when the calendar cannot be resolved.
+func calendarLabel(ctx context.Context, svc google.CalendarService, calendarID string) string {
+	cal, err := svc.GetCalendar(ctx, calendarID)
+	if err != nil || cal == nil || cal.Summary == "" {
+		return calendarID
+	}
+	if cal.Id == "" || cal.Id == cal.Summary {
+		return cal.Summary
+	}
+	return fmt.Sprintf("%s (%s)", cal.Summary, cal.Id)
+}
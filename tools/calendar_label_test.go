@@ -0,0 +1,75 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	zap "go.uber.org/zap"
+	calendar "google.golang.org/api/calendar/v3"
+)
+
+func TestCalendarLabel(t *testing.T) {
+	tests := []struct {
+		name          string
+		getCalendarFn func(calendarID string) (*calendar.Calendar, error)
+		want          string
+	}{
+		{
+			name: "primary resolves to summary and address",
+			getCalendarFn: func(calendarID string) (*calendar.Calendar, error) {
+				return &calendar.Calendar{Id: "work@company.com", Summary: "Work"}, nil
+			},
+			want: "Work (work@company.com)",
+		},
+		{
+			name: "summary equal to the ID is not repeated",
+			getCalendarFn: func(calendarID string) (*calendar.Calendar, error) {
+				return &calendar.Calendar{Id: "work@company.com", Summary: "work@company.com"}, nil
+			},
+			want: "work@company.com",
+		},
+		{
+			name: "resolution failure falls back to the raw ID",
+			getCalendarFn: func(calendarID string) (*calendar.Calendar, error) {
+				return nil, errors.New("forbidden")
+			},
+			want: "primary",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stub := &stubCalendarService{getCalendarFn: tt.getCalendarFn}
+			if got := calendarLabel(context.Background(), stub, "primary"); got != tt.want {
+				t.Errorf("calendarLabel(ctx) = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCreateCalendarEventErrorNamesCalendar(t *testing.T) {
+	disableConflictCheck(t)
+	stub := &stubCalendarService{
+		createEventFn: func(calendarID string, event *calendar.Event) (*calendar.Event, error) {
+			return nil, errors.New("quota exceeded")
+		},
+		getCalendarFn: func(calendarID string) (*calendar.Calendar, error) {
+			return &calendar.Calendar{Id: "work@company.com", Summary: "Work"}, nil
+		},
+	}
+	tool := &CreateCalendarEventTool{logger: zap.NewNop(), google: stub}
+
+	_, err := tool.CreateCalendarEventHandler(context.Background(), map[string]any{
+		"summary":   "Standup",
+		"startTime": "2026-05-23T10:00:00Z",
+		"endTime":   "2026-05-23T10:30:00Z",
+	})
+	if err == nil {
+		t.Fatal("expected error")
+	}
+	if want := "failed to create calendar event on 'Work (work@company.com)'"; !strings.Contains(err.Error(), want) {
+		t.Errorf("error = %q, want containing %q", err.Error(), want)
+	}
+}
@@ -0,0 +1,26 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+
+	envconfig "github.com/sethvargo/go-envconfig"
+
+	config "github.com/inference-gateway/google-calendar-agent/config"
+)
+
+// calendarSettings mirrors the googleCalendar section of config.Config so
+// tools can read it without the generated constructors threading it through.
+type calendarSettings struct {
+	GoogleCalendar config.GoogleCalendarConfig `env:",prefix=GOOGLE_CALENDAR_"`
+}
+
+// loadCalendarSettings resolves the GOOGLE_CALENDAR_* settings from the
+// environment, applying the spec.config.googleCalendar defaults.
+func loadCalendarSettings() (config.GoogleCalendarConfig, error) {
+	var s calendarSettings
+	if err := envconfig.Process(context.Background(), &s); err != nil {
+		return config.GoogleCalendarConfig{}, fmt.Errorf("load googleCalendar config: %w", err)
+	}
+	return s.GoogleCalendar, nil
+}
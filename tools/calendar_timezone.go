@@ -0,0 +1,44 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	calendar "google.golang.org/api/calendar/v3"
+
+	google "github.com/inference-gateway/google-calendar-agent/internal/google"
+)
+
+// naiveLayouts are the wall-clock layouts accepted in place of RFC3339 when
+// a time is given without an offset.
+var naiveLayouts = []string{"2006-01-02T15:04:05", "2006-01-02T15:04"}
+
+// calendarTimezone returns the timezone of calendarID from its Google
+// metadata, which CalendarService caches, so calendars in different zones
+// are each read in their own. It falls back to resolveTimezone when the
+// calendar has no timezone or cannot be read.
+func calendarTimezone(ctx context.Context, svc google.CalendarService, calendarID string) (*time.Location, string) {
+	if cal, err := svc.GetCalendar(ctx, calendarID); err == nil && cal != nil && cal.TimeZone != "" {
+		if loc, err := time.LoadLocation(cal.TimeZone); err == nil {
+			return loc, cal.TimeZone
+		}
+	}
+	loc, name, _ := resolveTimezone()
+	return loc, name
+}
+
+// eventDateTime builds the start or end of an event from an argument value.
+// RFC3339 values are kept as given; naive wall-clock times such as
+// 2024-01-01T10:00:00 are read in loc and tagged with its name.
+func eventDateTime(value string, loc *time.Location, tzName string) (*calendar.EventDateTime, error) {
+	if _, err := time.Parse(time.RFC3339, value); err == nil {
+		return &calendar.EventDateTime{DateTime: value}, nil
+	}
+	for _, layout := range naiveLayouts {
+		if t, err := time.ParseInLocation(layout, value, loc); err == nil {
+			return &calendar.EventDateTime{DateTime: t.Format(time.RFC3339), TimeZone: tzName}, nil
+		}
+	}
+	return nil, fmt.Errorf("%q is neither RFC3339 nor YYYY-MM-DDTHH:MM:SS", value)
+}
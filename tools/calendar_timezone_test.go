@@ -0,0 +1,112 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	zap "go.uber.org/zap"
+	calendar "google.golang.org/api/calendar/v3"
+)
+
+func TestCalendarTimezone(t *testing.T) {
+	t.Setenv("GOOGLE_CALENDAR_TIMEZONE", "UTC")
+	t.Setenv("TZ", "")
+
+	zones := map[string]string{
+		"berlin@example.com":   "Europe/Berlin",
+		"new-york@example.com": "America/New_York",
+		"bogus@example.com":    "Not/AZone",
+		"no-zone@example.com":  "",
+	}
+	stub := &stubCalendarService{
+		getCalendarFn: func(calendarID string) (*calendar.Calendar, error) {
+			zone, ok := zones[calendarID]
+			if !ok {
+				return nil, errors.New("not found")
+			}
+			return &calendar.Calendar{Id: calendarID, TimeZone: zone}, nil
+		},
+	}
+
+	tests := []struct {
+		calendarID string
+		want       string
+	}{
+		{"berlin@example.com", "Europe/Berlin"},
+		{"new-york@example.com", "America/New_York"},
+		{"bogus@example.com", "UTC"},
+		{"no-zone@example.com", "UTC"},
+		{"unknown@example.com", "UTC"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.calendarID, func(t *testing.T) {
+			loc, name := calendarTimezone(context.Background(), stub, tc.calendarID)
+			if name != tc.want || loc.String() != tc.want {
+				t.Errorf("calendarTimezone = %s (%s), want %s", name, loc, tc.want)
+			}
+		})
+	}
+}
+
+func TestCreateCalendarEventNaiveTimePerCalendar(t *testing.T) {
+	disableConflictCheck(t)
+	t.Setenv("GOOGLE_CALENDAR_TIMEZONE", "UTC")
+	t.Setenv("TZ", "")
+
+	zones := map[string]string{
+		"berlin@example.com":   "Europe/Berlin",
+		"new-york@example.com": "America/New_York",
+	}
+
+	tests := []struct {
+		calendarID    string
+		startTime     string
+		wantStart     string
+		wantTimeZone  string
+		wantStartTime string
+	}{
+		{calendarID: "berlin@example.com", startTime: "2026-05-20T10:00:00", wantStart: "2026-05-20T10:00:00+02:00", wantTimeZone: "Europe/Berlin"},
+		{calendarID: "new-york@example.com", startTime: "2026-05-20T10:00:00", wantStart: "2026-05-20T10:00:00-04:00", wantTimeZone: "America/New_York"},
+		{calendarID: "new-york@example.com", startTime: "2026-05-20T10:00:00Z", wantStart: "2026-05-20T10:00:00Z"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.calendarID+" "+tc.startTime, func(t *testing.T) {
+			var created *calendar.Event
+			stub := &stubCalendarService{
+				calendarID: tc.calendarID,
+				getCalendarFn: func(calendarID string) (*calendar.Calendar, error) {
+					return &calendar.Calendar{Id: calendarID, TimeZone: zones[calendarID]}, nil
+				},
+				createEventFn: func(calendarID string, event *calendar.Event) (*calendar.Event, error) {
+					created = event
+					out := *event
+					out.Id = "evt-1"
+					return &out, nil
+				},
+			}
+			tool := &CreateCalendarEventTool{logger: zap.NewNop(), google: stub}
+			result, err := tool.CreateCalendarEventHandler(context.Background(), map[string]any{
+				"summary":   "Sync",
+				"startTime": tc.startTime,
+				"endTime":   "2026-05-20T23:00:00",
+			})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if created.Start.DateTime != tc.wantStart || created.Start.TimeZone != tc.wantTimeZone {
+				t.Errorf("start = %s (%q), want %s (%q)", created.Start.DateTime, created.Start.TimeZone, tc.wantStart, tc.wantTimeZone)
+			}
+			var parsed struct {
+				StartTime string `json:"startTime"`
+			}
+			if err := json.Unmarshal([]byte(result), &parsed); err != nil {
+				t.Fatalf("failed to unmarshal result: %v", err)
+			}
+			if parsed.StartTime != tc.wantStart {
+				t.Errorf("result startTime = %s, want %s", parsed.StartTime, tc.wantStart)
+			}
+		})
+	}
+}
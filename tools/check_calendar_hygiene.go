@@ -0,0 +1,174 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	zap "go.uber.org/zap"
+	calendar "google.golang.org/api/calendar/v3"
+
+	server "github.com/inference-gateway/adk/server"
+
+	google "github.com/inference-gateway/google-calendar-agent/internal/google"
+)
+
+// CheckCalendarHygieneTool struct holds the tool with dependencies
+type CheckCalendarHygieneTool struct {
+	logger *zap.Logger
+	google google.CalendarService
+}
+
+// NewCheckCalendarHygieneTool creates a new check_calendar_hygiene tool
+func NewCheckCalendarHygieneTool(logger *zap.Logger, google google.CalendarService) server.Tool {
+	tool := &CheckCalendarHygieneTool{
+		logger: logger,
+		google: google,
+	}
+	return server.NewBasicTool(
+		"check_calendar_hygiene",
+		"Report scheduling anomalies in a range: events longer than the configured threshold, meetings with nobody invited, and overlapping blocks",
+		map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"timeMax": map[string]any{
+					"description": "End of the range to check (RFC3339 format). Defaults to 7 days after timeMin.",
+					"type":        "string",
+				},
+				"timeMin": map[string]any{
+					"description": "Start of the range to check (RFC3339 format). Defaults to now.",
+					"type":        "string",
+				},
+			},
+		},
+		tool.CheckCalendarHygieneHandler,
+	)
+}
+
+// CheckCalendarHygieneHandler handles the check_calendar_hygiene tool execution
+func (s *CheckCalendarHygieneTool) CheckCalendarHygieneHandler(ctx context.Context, args map[string]any) (string, error) {
+	span := startToolSpan(ctx, "check_calendar_hygiene")
+	defer span.End()
+	s.logger.Debug("checking calendar hygiene", zap.Any("args", args))
+
+	cfg, err := loadCalendarSettings()
+	if err != nil {
+		return "", err
+	}
+	longEvent := time.Duration(cfg.LongEventHours) * time.Hour
+
+	timeMin := time.Now()
+	if tm, exists := args["timeMin"]; exists && tm != nil {
+		tmStr, ok := tm.(string)
+		if !ok {
+			return "", fmt.Errorf("timeMin must be a string, got %T", tm)
+		}
+		parsedTime, err := time.Parse(time.RFC3339, tmStr)
+		if err != nil {
+			return "", fmt.Errorf("invalid timeMin format (expected RFC3339): %w", err)
+		}
+		timeMin = parsedTime
+	}
+
+	timeMax := timeMin.AddDate(0, 0, 7)
+	if tm, exists := args["timeMax"]; exists && tm != nil {
+		tmStr, ok := tm.(string)
+		if !ok {
+			return "", fmt.Errorf("timeMax must be a string, got %T", tm)
+		}
+		parsedTime, err := time.Parse(time.RFC3339, tmStr)
+		if err != nil {
+			return "", fmt.Errorf("invalid timeMax format (expected RFC3339): %w", err)
+		}
+		timeMax = parsedTime
+	}
+	if !timeMax.After(timeMin) {
+		return "", fmt.Errorf("timeMax must be after timeMin")
+	}
+
+	calendarID := s.google.GetCalendarID()
+	events, err := s.google.ListEvents(ctx, calendarID, timeMin, timeMax)
+	if err != nil {
+		s.logger.Error("failed to list events for hygiene check", zap.Error(err))
+		return "", fmt.Errorf("failed to list calendar events: %w", err)
+	}
+
+	loc, _ := calendarTimezone(ctx, s.google, calendarID)
+	longEvents := []map[string]any{}
+	meetingsWithoutAttendees := []map[string]any{}
+	var timed []timedEvent
+	for _, event := range events {
+		// All-day events are days off, holidays and the like, never
+		// anomalies.
+		if event.Status == "cancelled" || !google.BlocksTime(event) || event.Start == nil || event.Start.DateTime == "" {
+			continue
+		}
+		periods := eventBusyPeriods([]*calendar.Event{event}, loc)
+		if len(periods) == 0 {
+			continue
+		}
+		te := timedEvent{event: event, slot: periods[0]}
+
+		if longEvent > 0 && te.slot.duration > longEvent {
+			entry := overlapEventSummary(te)
+			entry["durationHours"] = math.Round(te.slot.duration.Hours()*10) / 10
+			longEvents = append(longEvents, entry)
+		}
+		if isMeeting(event) && invitedGuests(event) == 0 {
+			meetingsWithoutAttendees = append(meetingsWithoutAttendees, overlapEventSummary(te))
+		}
+		if event.Transparency != "transparent" {
+			timed = append(timed, te)
+		}
+	}
+	overlaps := overlappingPairs(timed)
+
+	issueCount := len(longEvents) + len(meetingsWithoutAttendees) + len(overlaps)
+	s.logger.Info("calendar hygiene checked",
+		zap.Int("longEvents", len(longEvents)),
+		zap.Int("meetingsWithoutAttendees", len(meetingsWithoutAttendees)),
+		zap.Int("overlaps", len(overlaps)))
+
+	result := map[string]any{
+		"success":                  true,
+		"longEventHours":           cfg.LongEventHours,
+		"longEvents":               longEvents,
+		"meetingsWithoutAttendees": meetingsWithoutAttendees,
+		"overlaps":                 overlaps,
+		"issueCount":               issueCount,
+	}
+
+	resultJSON, err := json.Marshal(result)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal result: %w", err)
+	}
+
+	return string(resultJSON), nil
+}
+
+// isMeeting reports whether an event presents itself as a meeting: it has a
+// video call attached or "meeting" in its title.
+func isMeeting(event *calendar.Event) bool {
+	if event.EventType != "" && event.EventType != "default" {
+		return false
+	}
+	if event.HangoutLink != "" || event.ConferenceData != nil {
+		return true
+	}
+	return strings.Contains(strings.ToLower(event.Summary), "meeting")
+}
+
+// invitedGuests counts the people invited to an event besides the calendar
+// owner. Resources such as meeting rooms do not count.
+func invitedGuests(event *calendar.Event) int {
+	guests := 0
+	for _, attendee := range event.Attendees {
+		if attendee != nil && !attendee.Resource && !attendee.Self {
+			guests++
+		}
+	}
+	return guests
+}
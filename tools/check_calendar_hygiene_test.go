@@ -0,0 +1,145 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	zap "go.uber.org/zap"
+	calendar "google.golang.org/api/calendar/v3"
+)
+
+func TestCheckCalendarHygieneHandler(t *testing.T) {
+	t.Setenv("GOOGLE_CALENDAR_TIMEZONE", "UTC")
+	t.Setenv("TZ", "")
+	t.Setenv("GOOGLE_CALENDAR_LONG_EVENT_HOURS", "4")
+
+	timed := func(id, summary, start, end string) *calendar.Event {
+		return &calendar.Event{
+			Id:      id,
+			Summary: summary,
+			Start:   &calendar.EventDateTime{DateTime: start},
+			End:     &calendar.EventDateTime{DateTime: end},
+		}
+	}
+	withGuest := func(event *calendar.Event) *calendar.Event {
+		event.Attendees = []*calendar.EventAttendee{
+			{Email: "me@example.com", Self: true},
+			{Email: "alice@example.com"},
+		}
+		return event
+	}
+	videoCall := timed("evt-call", "Catch-up", "2026-05-19T15:00:00Z", "2026-05-19T15:30:00Z")
+	videoCall.HangoutLink = "https://meet.google.com/abc-defg-hij"
+	videoCall.Attendees = []*calendar.EventAttendee{
+		{Email: "me@example.com", Self: true},
+		{Email: "room-1@resource.calendar.google.com", Resource: true},
+	}
+	freeBlock := timed("evt-free", "Offsite prep", "2026-05-20T09:00:00Z", "2026-05-20T10:00:00Z")
+	freeBlock.Transparency = "transparent"
+
+	tests := []struct {
+		name         string
+		events       []*calendar.Event
+		wantLong     []string
+		wantNoGuests []string
+		wantOverlaps int
+	}{
+		{
+			name:   "clean calendar",
+			events: []*calendar.Event{withGuest(timed("evt-1", "Planning meeting", "2026-05-18T10:00:00Z", "2026-05-18T11:00:00Z"))},
+		},
+		{
+			name:     "event longer than the threshold",
+			events:   []*calendar.Event{timed("evt-long", "Workshop", "2026-05-18T09:00:00Z", "2026-05-18T14:30:00Z")},
+			wantLong: []string{"evt-long"},
+		},
+		{
+			name:   "event at the threshold is fine",
+			events: []*calendar.Event{timed("evt-4h", "Deep work", "2026-05-18T09:00:00Z", "2026-05-18T13:00:00Z")},
+		},
+		{
+			name: "meetings with nobody invited",
+			events: []*calendar.Event{
+				timed("evt-alone", "Team meeting", "2026-05-19T10:00:00Z", "2026-05-19T11:00:00Z"),
+				videoCall,
+				timed("evt-focus", "Write report", "2026-05-19T13:00:00Z", "2026-05-19T14:00:00Z"),
+			},
+			wantNoGuests: []string{"evt-alone", "evt-call"},
+		},
+		{
+			name: "overlapping blocks ignore free events",
+			events: []*calendar.Event{
+				withGuest(timed("evt-a", "Design review", "2026-05-20T09:00:00Z", "2026-05-20T10:00:00Z")),
+				withGuest(timed("evt-b", "Vendor call", "2026-05-20T09:30:00Z", "2026-05-20T10:30:00Z")),
+				freeBlock,
+			},
+			wantOverlaps: 1,
+		},
+		{
+			name: "all-day events are skipped",
+			events: []*calendar.Event{{
+				Id:      "evt-holiday",
+				Summary: "Company offsite meeting",
+				Start:   &calendar.EventDateTime{Date: "2026-05-21"},
+				End:     &calendar.EventDateTime{Date: "2026-05-22"},
+			}},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			stub := &stubCalendarService{
+				listEventsFn: func(calendarID string, timeMin, timeMax time.Time) ([]*calendar.Event, error) {
+					return tc.events, nil
+				},
+			}
+			tool := &CheckCalendarHygieneTool{logger: zap.NewNop(), google: stub}
+			result, err := tool.CheckCalendarHygieneHandler(context.Background(), map[string]any{
+				"timeMin": "2026-05-18T00:00:00Z",
+				"timeMax": "2026-05-25T00:00:00Z",
+			})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			var parsed struct {
+				LongEvents []struct {
+					EventID       string  `json:"eventId"`
+					DurationHours float64 `json:"durationHours"`
+				} `json:"longEvents"`
+				MeetingsWithoutAttendees []struct {
+					EventID string `json:"eventId"`
+				} `json:"meetingsWithoutAttendees"`
+				Overlaps   []map[string]any `json:"overlaps"`
+				IssueCount int              `json:"issueCount"`
+			}
+			if err := json.Unmarshal([]byte(result), &parsed); err != nil {
+				t.Fatalf("failed to unmarshal result: %v", err)
+			}
+
+			var long []string
+			for _, e := range parsed.LongEvents {
+				long = append(long, e.EventID)
+			}
+			if got, want := strings.Join(long, ","), strings.Join(tc.wantLong, ","); got != want {
+				t.Errorf("longEvents = %q, want %q", got, want)
+			}
+			var noGuests []string
+			for _, e := range parsed.MeetingsWithoutAttendees {
+				noGuests = append(noGuests, e.EventID)
+			}
+			if got, want := strings.Join(noGuests, ","), strings.Join(tc.wantNoGuests, ","); got != want {
+				t.Errorf("meetingsWithoutAttendees = %q, want %q", got, want)
+			}
+			if len(parsed.Overlaps) != tc.wantOverlaps {
+				t.Errorf("overlaps = %d, want %d", len(parsed.Overlaps), tc.wantOverlaps)
+			}
+			if want := len(tc.wantLong) + len(tc.wantNoGuests) + tc.wantOverlaps; parsed.IssueCount != want {
+				t.Errorf("issueCount = %d, want %d", parsed.IssueCount, want)
+			}
+		})
+	}
+}
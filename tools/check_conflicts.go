@@ -73,7 +73,7 @@ func (s *CheckConflictsTool) CheckConflictsHandler(ctx context.Context, args map
 	}
 
 	calendarID := s.google.GetCalendarID()
-	conflicts, err := s.google.CheckConflicts(calendarID, startTime, endTime)
+	conflicts, err := s.google.CheckConflicts(ctx, calendarID, startTime, endTime)
 	if err != nil {
 		s.logger.Error("failed to check conflicts", zap.Error(err))
 		return "", fmt.Errorf("failed to check conflicts: %w", err)
@@ -84,22 +84,7 @@ func (s *CheckConflictsTool) CheckConflictsHandler(ctx context.Context, args map
 	hasConflicts := len(conflicts) > 0
 	var conflictList []map[string]any
 	for _, conflict := range conflicts {
-		conflictData := map[string]any{
-			"eventId": conflict.Id,
-			"summary": conflict.Summary,
-		}
-
-		if conflict.Start != nil {
-			conflictData["startTime"] = conflict.Start.DateTime
-		}
-		if conflict.End != nil {
-			conflictData["endTime"] = conflict.End.DateTime
-		}
-		if conflict.Location != "" {
-			conflictData["location"] = conflict.Location
-		}
-
-		conflictList = append(conflictList, conflictData)
+		conflictList = append(conflictList, conflictSummary(conflict))
 	}
 
 	result := map[string]any{
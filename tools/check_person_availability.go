@@ -0,0 +1,169 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	zap "go.uber.org/zap"
+	calendar "google.golang.org/api/calendar/v3"
+
+	server "github.com/inference-gateway/adk/server"
+
+	google "github.com/inference-gateway/google-calendar-agent/internal/google"
+)
+
+// CheckPersonAvailabilityTool struct holds the tool with dependencies
+type CheckPersonAvailabilityTool struct {
+	logger *zap.Logger
+	google google.CalendarService
+}
+
+// NewCheckPersonAvailabilityTool creates a new check_person_availability tool
+func NewCheckPersonAvailabilityTool(logger *zap.Logger, google google.CalendarService) server.Tool {
+	tool := &CheckPersonAvailabilityTool{
+		logger: logger,
+		google: google,
+	}
+	return server.NewBasicTool(
+		"check_person_availability",
+		"Check whether a person is free in a time range using their free/busy information, returning their busy blocks without event details",
+		map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"email": map[string]any{
+					"description": "Email address of the person to check (required)",
+					"type":        "string",
+				},
+				"endTime": map[string]any{
+					"description": "End of the range to check (RFC3339 format, required)",
+					"type":        "string",
+				},
+				"startTime": map[string]any{
+					"description": "Start of the range to check (RFC3339 format, required)",
+					"type":        "string",
+				},
+			},
+			"required": []string{"email", "startTime", "endTime"},
+		},
+		tool.CheckPersonAvailabilityHandler,
+	)
+}
+
+// CheckPersonAvailabilityHandler handles the check_person_availability tool execution
+func (s *CheckPersonAvailabilityTool) CheckPersonAvailabilityHandler(ctx context.Context, args map[string]any) (string, error) {
+	span := startToolSpan(ctx, "check_person_availability")
+	defer span.End()
+	s.logger.Debug("checking person availability", zap.Any("args", args))
+
+	email, ok := args["email"].(string)
+	email = strings.TrimSpace(email)
+	if !ok || email == "" {
+		return "", fmt.Errorf("email is required")
+	}
+
+	startTimeStr, ok := args["startTime"].(string)
+	if !ok || startTimeStr == "" {
+		return "", fmt.Errorf("startTime is required")
+	}
+	endTimeStr, ok := args["endTime"].(string)
+	if !ok || endTimeStr == "" {
+		return "", fmt.Errorf("endTime is required")
+	}
+	startTime, err := time.Parse(time.RFC3339, startTimeStr)
+	if err != nil {
+		return "", fmt.Errorf("invalid startTime format: %w", err)
+	}
+	endTime, err := time.Parse(time.RFC3339, endTimeStr)
+	if err != nil {
+		return "", fmt.Errorf("invalid endTime format: %w", err)
+	}
+	if !endTime.After(startTime) {
+		return "", fmt.Errorf("endTime must be after startTime")
+	}
+
+	calendars, err := s.google.QueryFreeBusy(ctx, []string{email}, startTime, endTime)
+	if err != nil {
+		s.logger.Error("failed to query free/busy", zap.Error(err), zap.String("email", email))
+		return "", fmt.Errorf("failed to query free/busy: %w", err)
+	}
+
+	result := map[string]any{
+		"success":   true,
+		"email":     email,
+		"startTime": startTimeStr,
+		"endTime":   endTimeStr,
+	}
+
+	busy, err := freeBusyPeriods(calendars, email)
+	if err != nil {
+		s.logger.Info("calendar not accessible for availability check",
+			zap.String("email", email),
+			zap.Error(err))
+		result["accessible"] = false
+		result["message"] = fmt.Sprintf("Cannot see %s's calendar (%v). They need to share their free/busy information with this account.", email, err)
+	} else {
+		loc, _ := calendarTimezone(ctx, s.google, s.google.GetCalendarID())
+		var blocks []map[string]any
+		for _, period := range busy {
+			blocks = append(blocks, map[string]any{
+				"startTime": period.startTime.In(loc).Format(time.RFC3339),
+				"endTime":   period.endTime.In(loc).Format(time.RFC3339),
+			})
+		}
+		result["accessible"] = true
+		result["free"] = len(busy) == 0
+		result["busy"] = blocks
+		if len(busy) == 0 {
+			result["message"] = fmt.Sprintf("%s is free for the whole range", email)
+		} else {
+			result["message"] = fmt.Sprintf("%s has %d busy block(s) in the range", email, len(busy))
+		}
+	}
+
+	s.logger.Info("person availability checked",
+		zap.String("email", email),
+		zap.Any("accessible", result["accessible"]),
+		zap.Int("busyBlocks", len(busy)))
+
+	resultJSON, err := json.Marshal(result)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal result: %w", err)
+	}
+
+	return string(resultJSON), nil
+}
+
+// freeBusyPeriods returns the merged busy periods for id from a free/busy
+// response, or an error naming the reason when Google could not compute
+// them (typically a calendar that is not shared with the credentials).
+func freeBusyPeriods(calendars map[string]calendar.FreeBusyCalendar, id string) ([]timeSlot, error) {
+	fb, ok := calendars[id]
+	if !ok {
+		return nil, fmt.Errorf("no free/busy information returned")
+	}
+	if len(fb.Errors) > 0 {
+		var reasons []string
+		for _, e := range fb.Errors {
+			reasons = append(reasons, e.Reason)
+		}
+		return nil, fmt.Errorf("%s", strings.Join(reasons, ", "))
+	}
+
+	var periods []timeSlot
+	for _, period := range fb.Busy {
+		start, err1 := time.Parse(time.RFC3339, period.Start)
+		end, err2 := time.Parse(time.RFC3339, period.End)
+		if err1 != nil || err2 != nil {
+			continue
+		}
+		periods = append(periods, timeSlot{startTime: start, endTime: end, duration: end.Sub(start)})
+	}
+	sort.Slice(periods, func(i, j int) bool {
+		return periods[i].startTime.Before(periods[j].startTime)
+	})
+	return mergeBusyPeriods(periods), nil
+}
@@ -0,0 +1,142 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	zap "go.uber.org/zap"
+	calendar "google.golang.org/api/calendar/v3"
+)
+
+func TestCheckPersonAvailabilityHandler(t *testing.T) {
+	t.Setenv("GOOGLE_CALENDAR_TIMEZONE", "UTC")
+	t.Setenv("TZ", "")
+
+	window := map[string]any{
+		"email":     "bob@example.com",
+		"startTime": "2026-05-23T15:00:00Z",
+		"endTime":   "2026-05-23T16:00:00Z",
+	}
+
+	tests := []struct {
+		name           string
+		args           map[string]any
+		freeBusy       map[string]calendar.FreeBusyCalendar
+		queryErr       error
+		wantErrSub     string
+		wantAccessible bool
+		wantFree       bool
+		wantBusy       []string
+	}{
+		{
+			name:           "free person",
+			args:           window,
+			freeBusy:       map[string]calendar.FreeBusyCalendar{"bob@example.com": {}},
+			wantAccessible: true,
+			wantFree:       true,
+		},
+		{
+			name: "busy person gets merged busy blocks",
+			args: window,
+			freeBusy: map[string]calendar.FreeBusyCalendar{"bob@example.com": {Busy: []*calendar.TimePeriod{
+				{Start: "2026-05-23T15:30:00Z", End: "2026-05-23T16:00:00Z"},
+				{Start: "2026-05-23T14:30:00Z", End: "2026-05-23T15:15:00Z"},
+				{Start: "2026-05-23T15:15:00Z", End: "2026-05-23T15:20:00Z"},
+			}}},
+			wantAccessible: true,
+			wantBusy:       []string{"2026-05-23T14:30:00Z-2026-05-23T15:20:00Z", "2026-05-23T15:30:00Z-2026-05-23T16:00:00Z"},
+		},
+		{
+			name: "inaccessible calendar is reported explicitly",
+			args: window,
+			freeBusy: map[string]calendar.FreeBusyCalendar{"bob@example.com": {
+				Errors: []*calendar.Error{{Domain: "global", Reason: "notFound"}},
+			}},
+			wantAccessible: false,
+		},
+		{
+			name:       "query failure returns error",
+			args:       window,
+			queryErr:   errors.New("backend unavailable"),
+			wantErrSub: "failed to query free/busy",
+		},
+		{
+			name:       "missing email returns error",
+			args:       map[string]any{"startTime": "2026-05-23T15:00:00Z", "endTime": "2026-05-23T16:00:00Z"},
+			wantErrSub: "email is required",
+		},
+		{
+			name:       "reversed range returns error",
+			args:       map[string]any{"email": "bob@example.com", "startTime": "2026-05-23T16:00:00Z", "endTime": "2026-05-23T15:00:00Z"},
+			wantErrSub: "endTime must be after startTime",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			stub := &stubCalendarService{
+				freeBusyFn: func(calendarIDs []string, timeMin, timeMax time.Time) (map[string]calendar.FreeBusyCalendar, error) {
+					if len(calendarIDs) != 1 || calendarIDs[0] != "bob@example.com" {
+						t.Errorf("calendarIDs = %v, want [bob@example.com]", calendarIDs)
+					}
+					return tc.freeBusy, tc.queryErr
+				},
+			}
+			tool := &CheckPersonAvailabilityTool{logger: zap.NewNop(), google: stub}
+			result, err := tool.CheckPersonAvailabilityHandler(context.Background(), tc.args)
+
+			if tc.wantErrSub != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErrSub) {
+					t.Fatalf("error = %v, want substring %q", err, tc.wantErrSub)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			var parsed struct {
+				Success    bool   `json:"success"`
+				Accessible bool   `json:"accessible"`
+				Free       *bool  `json:"free"`
+				Message    string `json:"message"`
+				Busy       []struct {
+					StartTime string `json:"startTime"`
+					EndTime   string `json:"endTime"`
+				} `json:"busy"`
+			}
+			if err := json.Unmarshal([]byte(result), &parsed); err != nil {
+				t.Fatalf("failed to unmarshal result: %v", err)
+			}
+			if !parsed.Success {
+				t.Error("success = false, want true")
+			}
+			if parsed.Accessible != tc.wantAccessible {
+				t.Errorf("accessible = %v, want %v", parsed.Accessible, tc.wantAccessible)
+			}
+			if !tc.wantAccessible {
+				if parsed.Free != nil {
+					t.Errorf("free = %v, want omitted for an inaccessible calendar", *parsed.Free)
+				}
+				if !strings.Contains(parsed.Message, "notFound") {
+					t.Errorf("message = %q, want the reason", parsed.Message)
+				}
+				return
+			}
+			if parsed.Free == nil || *parsed.Free != tc.wantFree {
+				t.Errorf("free = %v, want %v", parsed.Free, tc.wantFree)
+			}
+			var gotBusy []string
+			for _, b := range parsed.Busy {
+				gotBusy = append(gotBusy, b.StartTime+"-"+b.EndTime)
+			}
+			if got, want := strings.Join(gotBusy, ","), strings.Join(tc.wantBusy, ","); got != want {
+				t.Errorf("busy = %s, want %s", got, want)
+			}
+		})
+	}
+}
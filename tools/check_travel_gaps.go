@@ -0,0 +1,161 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	zap "go.uber.org/zap"
+	calendar "google.golang.org/api/calendar/v3"
+
+	server "github.com/inference-gateway/adk/server"
+
+	google "github.com/inference-gateway/google-calendar-agent/internal/google"
+)
+
+// CheckTravelGapsTool struct holds the tool with dependencies
+type CheckTravelGapsTool struct {
+	logger *zap.Logger
+	google google.CalendarService
+}
+
+// NewCheckTravelGapsTool creates a new check_travel_gaps tool
+func NewCheckTravelGapsTool(logger *zap.Logger, google google.CalendarService) server.Tool {
+	tool := &CheckTravelGapsTool{
+		logger: logger,
+		google: google,
+	}
+	return server.NewBasicTool(
+		"check_travel_gaps",
+		"Flag back-to-back events at different physical locations that leave too little time to travel between them",
+		map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"date": map[string]any{
+					"description": "Day to check (YYYY-MM-DD) in the user's timezone. Defaults to today.",
+					"type":        "string",
+				},
+			},
+		},
+		tool.CheckTravelGapsHandler,
+	)
+}
+
+// locatedEvent is a timed event held at a physical place.
+type locatedEvent struct {
+	event     *calendar.Event
+	startTime time.Time
+	endTime   time.Time
+}
+
+// CheckTravelGapsHandler handles the check_travel_gaps tool execution
+func (s *CheckTravelGapsTool) CheckTravelGapsHandler(ctx context.Context, args map[string]any) (string, error) {
+	span := startToolSpan(ctx, "check_travel_gaps")
+	defer span.End()
+	s.logger.Debug("checking travel gaps", zap.Any("args", args))
+
+	cfg, err := loadCalendarSettings()
+	if err != nil {
+		return "", err
+	}
+	minTravel := time.Duration(cfg.MinTravelMinutes) * time.Minute
+
+	calendarID := s.google.GetCalendarID()
+	loc, _ := calendarTimezone(ctx, s.google, calendarID)
+	day := time.Now().In(loc)
+	if d, exists := args["date"]; exists && d != nil {
+		dStr, ok := d.(string)
+		if !ok {
+			return "", fmt.Errorf("date must be a string, got %T", d)
+		}
+		parsed, err := time.ParseInLocation("2006-01-02", dStr, loc)
+		if err != nil {
+			return "", fmt.Errorf("invalid date format (expected YYYY-MM-DD): %w", err)
+		}
+		day = parsed
+	}
+	dayStart := time.Date(day.Year(), day.Month(), day.Day(), 0, 0, 0, 0, loc)
+	dayEnd := dayStart.AddDate(0, 0, 1)
+
+	events, err := s.google.ListEvents(ctx, calendarID, dayStart, dayEnd)
+	if err != nil {
+		s.logger.Error("failed to list events for travel gaps", zap.Error(err))
+		return "", fmt.Errorf("failed to list calendar events: %w", err)
+	}
+
+	located := locatedEvents(events, loc)
+	gaps := []map[string]any{}
+	for i := 1; i < len(located); i++ {
+		prev, next := located[i-1], located[i]
+		if sameLocation(prev.event.Location, next.event.Location) {
+			continue
+		}
+		gap := next.startTime.Sub(prev.endTime)
+		if gap >= minTravel {
+			continue
+		}
+		gaps = append(gaps, map[string]any{
+			"fromEventId":  prev.event.Id,
+			"fromSummary":  prev.event.Summary,
+			"fromLocation": prev.event.Location,
+			"endTime":      prev.endTime.Format(time.RFC3339),
+			"toEventId":    next.event.Id,
+			"toSummary":    next.event.Summary,
+			"toLocation":   next.event.Location,
+			"startTime":    next.startTime.Format(time.RFC3339),
+			"gapMinutes":   int(gap.Minutes()),
+		})
+	}
+
+	s.logger.Info("travel gaps checked", zap.Int("count", len(gaps)))
+
+	result := map[string]any{
+		"success":          true,
+		"date":             dayStart.Format("2006-01-02"),
+		"minTravelMinutes": cfg.MinTravelMinutes,
+		"gaps":             gaps,
+		"count":            len(gaps),
+	}
+
+	resultJSON, err := json.Marshal(result)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal result: %w", err)
+	}
+
+	return string(resultJSON), nil
+}
+
+// locatedEvents keeps the timed events with a physical location, sorted by
+// start time. All-day events and virtual locations cannot need travel.
+func locatedEvents(events []*calendar.Event, loc *time.Location) []locatedEvent {
+	var located []locatedEvent
+	for _, event := range events {
+		if event.Start == nil || event.End == nil || event.Start.DateTime == "" || event.End.DateTime == "" {
+			continue
+		}
+		if strings.TrimSpace(event.Location) == "" || isVirtualLocation(event.Location) {
+			continue
+		}
+		start, err := time.Parse(time.RFC3339, event.Start.DateTime)
+		if err != nil {
+			continue
+		}
+		end, err := time.Parse(time.RFC3339, event.End.DateTime)
+		if err != nil {
+			continue
+		}
+		located = append(located, locatedEvent{event: event, startTime: start.In(loc), endTime: end.In(loc)})
+	}
+	sort.SliceStable(located, func(i, j int) bool {
+		return located[i].startTime.Before(located[j].startTime)
+	})
+	return located
+}
+
+// sameLocation compares two locations ignoring case and surrounding space.
+func sameLocation(a, b string) bool {
+	return strings.EqualFold(strings.TrimSpace(a), strings.TrimSpace(b))
+}
@@ -0,0 +1,107 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	zap "go.uber.org/zap"
+	calendar "google.golang.org/api/calendar/v3"
+)
+
+func TestCheckTravelGapsHandler(t *testing.T) {
+	t.Setenv("GOOGLE_CALENDAR_TIMEZONE", "UTC")
+	t.Setenv("TZ", "")
+
+	at := func(id, location, start, end string) *calendar.Event {
+		return &calendar.Event{
+			Id:       id,
+			Summary:  id,
+			Location: location,
+			Start:    &calendar.EventDateTime{DateTime: "2026-05-20T" + start + ":00Z"},
+			End:      &calendar.EventDateTime{DateTime: "2026-05-20T" + end + ":00Z"},
+		}
+	}
+
+	tests := []struct {
+		name      string
+		minutes   string
+		events    []*calendar.Event
+		wantPairs []string
+		wantGaps  []float64
+	}{
+		{
+			name:      "back-to-back at different places is flagged",
+			events:    []*calendar.Event{at("office", "HQ", "09:00", "10:00"), at("client", "Client site", "10:05", "11:00")},
+			wantPairs: []string{"office>client"},
+			wantGaps:  []float64{5},
+		},
+		{
+			name:   "same place needs no travel",
+			events: []*calendar.Event{at("standup", "HQ", "09:00", "10:00"), at("review", " hq ", "10:00", "11:00")},
+		},
+		{
+			name:   "enough buffer is not flagged",
+			events: []*calendar.Event{at("office", "HQ", "09:00", "10:00"), at("client", "Client site", "10:15", "11:00")},
+		},
+		{
+			name: "virtual events are ignored",
+			events: []*calendar.Event{
+				at("office", "HQ", "09:00", "10:00"),
+				at("call", "https://meet.google.com/abc-defg-hij", "10:00", "10:10"),
+				at("client", "Client site", "10:10", "11:00"),
+			},
+			wantPairs: []string{"office>client"},
+			wantGaps:  []float64{10},
+		},
+		{
+			name:      "threshold comes from config",
+			minutes:   "45",
+			events:    []*calendar.Event{at("client", "Client site", "10:30", "11:00"), at("office", "HQ", "09:00", "10:00")},
+			wantPairs: []string{"office>client"},
+			wantGaps:  []float64{30},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.minutes != "" {
+				t.Setenv("GOOGLE_CALENDAR_MIN_TRAVEL_MINUTES", tc.minutes)
+			}
+			stub := &stubCalendarService{
+				listEventsFn: func(calendarID string, timeMin, timeMax time.Time) ([]*calendar.Event, error) {
+					return tc.events, nil
+				},
+			}
+			tool := &CheckTravelGapsTool{logger: zap.NewNop(), google: stub}
+			result, err := tool.CheckTravelGapsHandler(context.Background(), map[string]any{"date": "2026-05-20"})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			var parsed struct {
+				Gaps []struct {
+					FromEventID string  `json:"fromEventId"`
+					ToEventID   string  `json:"toEventId"`
+					GapMinutes  float64 `json:"gapMinutes"`
+				} `json:"gaps"`
+				Count int `json:"count"`
+			}
+			if err := json.Unmarshal([]byte(result), &parsed); err != nil {
+				t.Fatalf("failed to unmarshal result: %v", err)
+			}
+			if parsed.Count != len(tc.wantPairs) || len(parsed.Gaps) != len(tc.wantPairs) {
+				t.Fatalf("got %d gaps (count %d), want %d: %s", len(parsed.Gaps), parsed.Count, len(tc.wantPairs), result)
+			}
+			for i, gap := range parsed.Gaps {
+				if pair := gap.FromEventID + ">" + gap.ToEventID; pair != tc.wantPairs[i] {
+					t.Errorf("gaps[%d] = %s, want %s", i, pair, tc.wantPairs[i])
+				}
+				if gap.GapMinutes != tc.wantGaps[i] {
+					t.Errorf("gaps[%d].gapMinutes = %v, want %v", i, gap.GapMinutes, tc.wantGaps[i])
+				}
+			}
+		})
+	}
+}
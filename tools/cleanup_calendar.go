@@ -0,0 +1,219 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	zap "go.uber.org/zap"
+	calendar "google.golang.org/api/calendar/v3"
+
+	server "github.com/inference-gateway/adk/server"
+
+	google "github.com/inference-gateway/google-calendar-agent/internal/google"
+)
+
+// Reasons cleanup_calendar removes an event for.
+const (
+	cleanupReasonCancelled = "cancelled"
+	cleanupReasonDeclined  = "declined"
+)
+
+// cancelledTitlePrefixes mark invitations from other calendar systems, such
+// as Outlook, whose cancellation arrives as a renamed event that stays on
+// the calendar.
+var cancelledTitlePrefixes = []string{"canceled:", "cancelled:"}
+
+// CleanupCalendarTool struct holds the tool with dependencies
+type CleanupCalendarTool struct {
+	logger *zap.Logger
+	google google.CalendarService
+}
+
+// NewCleanupCalendarTool creates a new cleanup_calendar tool
+func NewCleanupCalendarTool(logger *zap.Logger, google google.CalendarService) server.Tool {
+	tool := &CleanupCalendarTool{
+		logger: logger,
+		google: google,
+	}
+	return server.NewBasicTool(
+		"cleanup_calendar",
+		"Tidy up a range by deleting cancelled events and events the user declined, with a dry-run preview",
+		map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"confirm": map[string]any{
+					"description": "Set to true to delete the events. Only pass this after the user has seen the dry-run preview and confirmed.",
+					"type":        "boolean",
+				},
+				"dryRun": map[string]any{
+					"description": "Only list the events that would be deleted, without deleting any. Defaults to false.",
+					"type":        "boolean",
+				},
+				"timeMax": map[string]any{
+					"description": "End of the range to tidy (RFC3339 format) (required)",
+					"type":        "string",
+				},
+				"timeMin": map[string]any{
+					"description": "Start of the range to tidy (RFC3339 format) (required)",
+					"type":        "string",
+				},
+			},
+			"required": []string{"timeMin", "timeMax"},
+		},
+		tool.CleanupCalendarHandler,
+	)
+}
+
+// CleanupCalendarHandler handles the cleanup_calendar tool execution
+func (s *CleanupCalendarTool) CleanupCalendarHandler(ctx context.Context, args map[string]any) (string, error) {
+	span := startToolSpan(ctx, "cleanup_calendar")
+	defer span.End()
+	s.logger.Debug("cleaning up calendar", zap.Any("args", args))
+
+	timeMinStr, ok := args["timeMin"].(string)
+	if !ok || timeMinStr == "" {
+		return "", fmt.Errorf("timeMin is required")
+	}
+	timeMin, err := time.Parse(time.RFC3339, timeMinStr)
+	if err != nil {
+		return "", fmt.Errorf("invalid timeMin format (expected RFC3339): %w", err)
+	}
+	timeMaxStr, ok := args["timeMax"].(string)
+	if !ok || timeMaxStr == "" {
+		return "", fmt.Errorf("timeMax is required")
+	}
+	timeMax, err := time.Parse(time.RFC3339, timeMaxStr)
+	if err != nil {
+		return "", fmt.Errorf("invalid timeMax format (expected RFC3339): %w", err)
+	}
+	if !timeMax.After(timeMin) {
+		return "", fmt.Errorf("timeMax must be after timeMin")
+	}
+
+	dryRun := false
+	if v, exists := args["dryRun"]; exists && v != nil {
+		b, ok := v.(bool)
+		if !ok {
+			return "", fmt.Errorf("dryRun must be a boolean, got %T", v)
+		}
+		dryRun = b
+	}
+
+	confirmed := false
+	if v, exists := args["confirm"]; exists && v != nil {
+		b, ok := v.(bool)
+		if !ok {
+			return "", fmt.Errorf("confirm must be a boolean, got %T", v)
+		}
+		confirmed = b
+	}
+
+	calendarID := s.google.GetCalendarID()
+	events, err := s.google.ListEvents(ctx, calendarID, timeMin, timeMax)
+	if err != nil {
+		s.logger.Error("failed to list calendar events", zap.Error(err))
+		return "", fmt.Errorf("failed to list calendar events: %w", err)
+	}
+
+	var matched []*calendar.Event
+	var reasons []string
+	for _, event := range events {
+		if reason := cleanupReason(event); reason != "" {
+			matched = append(matched, event)
+			reasons = append(reasons, reason)
+		}
+	}
+	if len(matched) > maxBatchEvents {
+		return "", fmt.Errorf("range has %d events to clean up, more than the limit of %d; narrow the time range", len(matched), maxBatchEvents)
+	}
+	if !dryRun && !confirmed && len(matched) > 0 {
+		return "", fmt.Errorf("cleanup would delete %d %s; preview them with dryRun: true, confirm with the user and retry with confirm: true", len(matched), plural(len(matched), "event", "events"))
+	}
+
+	concurrency, err := loadBatchConcurrency()
+	if err != nil {
+		return "", err
+	}
+
+	results := runBatch(len(matched), concurrency, func(i int) map[string]any {
+		return s.cleanupOne(ctx, calendarID, matched[i], reasons[i], dryRun)
+	})
+	deleted, failed := 0, 0
+	for _, result := range results {
+		switch result["status"] {
+		case "deleted", "preview":
+			deleted++
+		default:
+			failed++
+		}
+	}
+
+	s.logger.Info("calendar cleanup finished",
+		zap.Bool("dryRun", dryRun),
+		zap.Int("deleted", deleted),
+		zap.Int("failed", failed))
+
+	response := map[string]any{
+		"success": failed == 0,
+		"dryRun":  dryRun,
+		"matched": len(matched),
+		"results": results,
+		"deleted": deleted,
+		"failed":  failed,
+	}
+
+	resultJSON, err := json.Marshal(response)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal result: %w", err)
+	}
+
+	return string(resultJSON), nil
+}
+
+// cleanupOne deletes a single event, or only describes it when dryRun is
+// set. Failures are reported in the returned result rather than aborting
+// the remaining events.
+func (s *CleanupCalendarTool) cleanupOne(ctx context.Context, calendarID string, event *calendar.Event, reason string, dryRun bool) map[string]any {
+	result := map[string]any{
+		"eventId":   event.Id,
+		"summary":   event.Summary,
+		"startTime": eventDateTimeString(event.Start),
+		"endTime":   eventDateTimeString(event.End),
+		"reason":    reason,
+	}
+	if dryRun {
+		result["status"] = "preview"
+		return result
+	}
+
+	if err := s.google.DeleteEvent(ctx, calendarID, event.Id); err != nil {
+		s.logger.Warn("failed to delete event during cleanup", zap.Error(err), zap.String("eventId", event.Id))
+		result["status"] = "failed"
+		result["error"] = err.Error()
+		return result
+	}
+	result["status"] = "deleted"
+	return result
+}
+
+// cleanupReason returns why an event is clutter, or "" to keep it: another
+// calendar system renamed it to mark it cancelled, or the calendar owner
+// declined it. Events cancelled in Google Calendar itself are already gone:
+// listings leave them out.
+func cleanupReason(event *calendar.Event) string {
+	summary := strings.ToLower(strings.TrimSpace(event.Summary))
+	for _, prefix := range cancelledTitlePrefixes {
+		if strings.HasPrefix(summary, prefix) {
+			return cleanupReasonCancelled
+		}
+	}
+	for _, attendee := range event.Attendees {
+		if attendee != nil && attendee.Self && attendee.ResponseStatus == "declined" {
+			return cleanupReasonDeclined
+		}
+	}
+	return ""
+}
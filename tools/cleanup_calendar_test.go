@@ -0,0 +1,160 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	zap "go.uber.org/zap"
+	calendar "google.golang.org/api/calendar/v3"
+
+	config "github.com/inference-gateway/google-calendar-agent/config"
+	google "github.com/inference-gateway/google-calendar-agent/internal/google"
+)
+
+func TestCleanupCalendarHandler(t *testing.T) {
+	seed := func(t *testing.T) *google.InMemoryCalendarService {
+		t.Helper()
+		svc := google.NewInMemoryCalendarService(zap.NewNop(), &config.Config{})
+		at := func(summary, start string) *calendar.Event {
+			startTime, _ := time.Parse(time.RFC3339, start)
+			return &calendar.Event{
+				Summary: summary,
+				Start:   &calendar.EventDateTime{DateTime: start},
+				End:     &calendar.EventDateTime{DateTime: startTime.Add(time.Hour).Format(time.RFC3339)},
+			}
+		}
+		declined := at("Vendor pitch", "2026-05-18T13:00:00Z")
+		declined.Attendees = []*calendar.EventAttendee{
+			{Email: "vendor@example.com", ResponseStatus: "accepted"},
+			{Email: "me@example.com", Self: true, ResponseStatus: "declined"},
+		}
+		accepted := at("Team sync", "2026-05-18T15:00:00Z")
+		accepted.Attendees = []*calendar.EventAttendee{
+			{Email: "lead@example.com", ResponseStatus: "declined"},
+			{Email: "me@example.com", Self: true, ResponseStatus: "accepted"},
+		}
+		// Google leaves events cancelled in Google Calendar out of listings,
+		// so the only cancellations cleanup sees are invitations other
+		// calendar systems renamed.
+		cancelled, err := svc.CreateEvent(context.Background(), "primary", at("Standup", "2026-05-18T08:00:00Z"))
+		if err != nil {
+			t.Fatalf("CreateEvent: %v", err)
+		}
+		cancelled.Status = "cancelled"
+		if _, err := svc.UpdateEvent(context.Background(), "primary", cancelled.Id, cancelled); err != nil {
+			t.Fatalf("UpdateEvent: %v", err)
+		}
+		for _, event := range []*calendar.Event{
+			at("Cancelled: Design review", "2026-05-18T09:00:00Z"),
+			at("Canceled: Quarterly planning", "2026-05-18T11:00:00Z"),
+			declined,
+			accepted,
+			at("Focus", "2026-05-18T16:00:00Z"),
+		} {
+			if _, err := svc.CreateEvent(context.Background(), "primary", event); err != nil {
+				t.Fatalf("CreateEvent: %v", err)
+			}
+		}
+		return svc
+	}
+
+	tests := []struct {
+		name          string
+		args          map[string]any
+		wantErrSub    string
+		wantReasons   []string
+		wantStatus    string
+		wantRemaining []string
+	}{
+		{
+			name:          "dry run previews without deleting",
+			args:          map[string]any{"dryRun": true},
+			wantReasons:   []string{"cancelled", "cancelled", "declined"},
+			wantStatus:    "preview",
+			wantRemaining: []string{"Cancelled: Design review", "Canceled: Quarterly planning", "Vendor pitch", "Team sync", "Focus"},
+		},
+		{
+			name:          "deleting requires confirmation",
+			wantErrSub:    "cleanup would delete 3 events",
+			wantRemaining: []string{"Cancelled: Design review", "Canceled: Quarterly planning", "Vendor pitch", "Team sync", "Focus"},
+		},
+		{
+			name:          "confirmed cleanup deletes the clutter",
+			args:          map[string]any{"confirm": true},
+			wantReasons:   []string{"cancelled", "cancelled", "declined"},
+			wantStatus:    "deleted",
+			wantRemaining: []string{"Team sync", "Focus"},
+		},
+		{
+			name:       "non-boolean confirm",
+			args:       map[string]any{"confirm": "yes"},
+			wantErrSub: "confirm must be a boolean",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			svc := seed(t)
+			tool := &CleanupCalendarTool{logger: zap.NewNop(), google: svc}
+			args := map[string]any{
+				"timeMin": "2026-05-18T00:00:00Z",
+				"timeMax": "2026-05-19T00:00:00Z",
+			}
+			for k, v := range tc.args {
+				args[k] = v
+			}
+			result, err := tool.CleanupCalendarHandler(context.Background(), args)
+
+			if tc.wantErrSub != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErrSub) {
+					t.Fatalf("error = %v, want substring %q", err, tc.wantErrSub)
+				}
+			} else {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+				var parsed struct {
+					Success bool `json:"success"`
+					Results []struct {
+						Reason string `json:"reason"`
+						Status string `json:"status"`
+					} `json:"results"`
+				}
+				if err := json.Unmarshal([]byte(result), &parsed); err != nil {
+					t.Fatalf("failed to unmarshal result: %v", err)
+				}
+				if !parsed.Success {
+					t.Errorf("success = false, result %s", result)
+				}
+				var reasons []string
+				for _, r := range parsed.Results {
+					reasons = append(reasons, r.Reason)
+					if r.Status != tc.wantStatus {
+						t.Errorf("status = %q, want %q", r.Status, tc.wantStatus)
+					}
+				}
+				if got, want := strings.Join(reasons, ","), strings.Join(tc.wantReasons, ","); got != want {
+					t.Errorf("reasons = %q, want %q", got, want)
+				}
+			}
+
+			if tc.wantRemaining == nil {
+				return
+			}
+			remaining, err := svc.ListEvents(context.Background(), "primary", time.Date(2026, 5, 18, 0, 0, 0, 0, time.UTC), time.Date(2026, 5, 19, 0, 0, 0, 0, time.UTC))
+			if err != nil {
+				t.Fatalf("ListEvents: %v", err)
+			}
+			var summaries []string
+			for _, event := range remaining {
+				summaries = append(summaries, event.Summary)
+			}
+			if got, want := strings.Join(summaries, ","), strings.Join(tc.wantRemaining, ","); got != want {
+				t.Errorf("remaining events = %q, want %q", got, want)
+			}
+		})
+	}
+}
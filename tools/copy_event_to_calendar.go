@@ -0,0 +1,150 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	zap "go.uber.org/zap"
+	calendar "google.golang.org/api/calendar/v3"
+
+	server "github.com/inference-gateway/adk/server"
+
+	google "github.com/inference-gateway/google-calendar-agent/internal/google"
+)
+
+// copyPrivacyModes are the privacy values copy_event_to_calendar accepts.
+var copyPrivacyModes = []string{"full", "busyOnly"}
+
+// CopyEventToCalendarTool struct holds the tool with dependencies
+type CopyEventToCalendarTool struct {
+	logger *zap.Logger
+	google google.CalendarService
+}
+
+// NewCopyEventToCalendarTool creates a new copy_event_to_calendar tool
+func NewCopyEventToCalendarTool(logger *zap.Logger, google google.CalendarService) server.Tool {
+	tool := &CopyEventToCalendarTool{
+		logger: logger,
+		google: google,
+	}
+	return server.NewBasicTool(
+		"copy_event_to_calendar",
+		"Copy an event to another calendar, either with its details or as an opaque \"Busy\" block that hides them",
+		map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"eventId": map[string]any{
+					"description": "ID of the event to copy (required)",
+					"type":        "string",
+				},
+				"privacy": map[string]any{
+					"description": "full copies the title, description, location and recurrence; busyOnly creates a \"Busy\" event with no details (default: busyOnly). Attendees are never copied, so no invitations are sent.",
+					"enum":        copyPrivacyModes,
+					"type":        "string",
+				},
+				"targetCalendarId": map[string]any{
+					"description": "ID of the calendar to copy the event to (required)",
+					"type":        "string",
+				},
+			},
+			"required": []string{"eventId", "targetCalendarId"},
+		},
+		tool.CopyEventToCalendarHandler,
+	)
+}
+
+// CopyEventToCalendarHandler handles the copy_event_to_calendar tool execution
+func (s *CopyEventToCalendarTool) CopyEventToCalendarHandler(ctx context.Context, args map[string]any) (string, error) {
+	span := startToolSpan(ctx, "copy_event_to_calendar")
+	defer span.End()
+	s.logger.Debug("copying calendar event", zap.Any("args", args))
+
+	eventID, ok := args["eventId"].(string)
+	if !ok || eventID == "" {
+		return "", fmt.Errorf("eventId is required")
+	}
+
+	targetCalendarID, ok := args["targetCalendarId"].(string)
+	if !ok || targetCalendarID == "" {
+		return "", fmt.Errorf("targetCalendarId is required")
+	}
+
+	privacy := "busyOnly"
+	if v, exists := args["privacy"]; exists && v != nil {
+		str, ok := v.(string)
+		if !ok {
+			return "", fmt.Errorf("privacy must be a string, got %T", v)
+		}
+		privacy = str
+	}
+	if privacy != "full" && privacy != "busyOnly" {
+		return "", fmt.Errorf("privacy must be one of %s, got %q", strings.Join(copyPrivacyModes, ", "), privacy)
+	}
+
+	calendarID := s.google.GetCalendarID()
+	if targetCalendarID == calendarID {
+		return "", fmt.Errorf("targetCalendarId must differ from the source calendar %q", calendarID)
+	}
+
+	event, err := s.google.GetEvent(ctx, calendarID, eventID)
+	if err != nil {
+		s.logger.Error("failed to get calendar event", zap.Error(err), zap.String("eventId", eventID))
+		return "", fmt.Errorf("failed to get calendar event on '%s': %w", calendarLabel(ctx, s.google, calendarID), err)
+	}
+
+	created, err := s.google.CreateEvent(ctx, targetCalendarID, eventCopy(event, privacy))
+	if err != nil {
+		s.logger.Error("failed to copy calendar event", zap.Error(err),
+			zap.String("eventId", eventID),
+			zap.String("targetCalendarId", targetCalendarID))
+		return "", fmt.Errorf("failed to copy event to '%s': %w", calendarLabel(ctx, s.google, targetCalendarID), err)
+	}
+
+	s.logger.Info("calendar event copied successfully",
+		zap.String("eventId", eventID),
+		zap.String("copyEventId", created.Id),
+		zap.String("privacy", privacy))
+
+	result := map[string]any{
+		"success":          true,
+		"eventId":          eventID,
+		"copyEventId":      created.Id,
+		"targetCalendarId": targetCalendarID,
+		"privacy":          privacy,
+		"summary":          created.Summary,
+		"htmlLink":         created.HtmlLink,
+	}
+	if viewLink := viewLinkFor(targetCalendarID, created); viewLink != "" {
+		result["viewLink"] = viewLink
+	}
+
+	resultJSON, err := json.Marshal(result)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal result: %w", err)
+	}
+
+	return string(resultJSON), nil
+}
+
+// eventCopy builds the event to create on the target calendar. Attendees are
+// left out in both modes so the copy never re-invites anyone.
+func eventCopy(event *calendar.Event, privacy string) *calendar.Event {
+	copied := &calendar.Event{
+		Start:        event.Start,
+		End:          event.End,
+		Recurrence:   event.Recurrence,
+		Transparency: "opaque",
+	}
+	if privacy == "busyOnly" {
+		copied.Summary = "Busy"
+		copied.Visibility = "private"
+		return copied
+	}
+	copied.Summary = event.Summary
+	copied.Description = event.Description
+	copied.Location = event.Location
+	copied.Transparency = event.Transparency
+	return copied
+}
@@ -0,0 +1,130 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	zap "go.uber.org/zap"
+	calendar "google.golang.org/api/calendar/v3"
+)
+
+func TestCopyEventToCalendarHandler(t *testing.T) {
+	source := &calendar.Event{
+		Id:          "evt-1",
+		Summary:     "Doctor appointment",
+		Description: "Bring test results",
+		Location:    "Clinic",
+		Attendees:   []*calendar.EventAttendee{{Email: "partner@example.com"}},
+		Recurrence:  []string{"RRULE:FREQ=MONTHLY;COUNT=3"},
+		Start:       &calendar.EventDateTime{DateTime: "2026-05-23T10:00:00Z"},
+		End:         &calendar.EventDateTime{DateTime: "2026-05-23T11:00:00Z"},
+	}
+
+	tests := []struct {
+		name       string
+		args       map[string]any
+		wantErrSub string
+		check      func(t *testing.T, copied *calendar.Event)
+	}{
+		{
+			name: "busyOnly hides the details",
+			args: map[string]any{"eventId": "evt-1", "targetCalendarId": "team@example.com", "privacy": "busyOnly"},
+			check: func(t *testing.T, copied *calendar.Event) {
+				if copied.Summary != "Busy" || copied.Description != "" || copied.Location != "" {
+					t.Errorf("copy = %q/%q/%q, want a bare Busy event", copied.Summary, copied.Description, copied.Location)
+				}
+				if copied.Transparency != "opaque" {
+					t.Errorf("Transparency = %q, want opaque", copied.Transparency)
+				}
+			},
+		},
+		{
+			name: "privacy defaults to busyOnly",
+			args: map[string]any{"eventId": "evt-1", "targetCalendarId": "team@example.com"},
+			check: func(t *testing.T, copied *calendar.Event) {
+				if copied.Summary != "Busy" {
+					t.Errorf("Summary = %q, want Busy", copied.Summary)
+				}
+			},
+		},
+		{
+			name: "full copies the details",
+			args: map[string]any{"eventId": "evt-1", "targetCalendarId": "team@example.com", "privacy": "full"},
+			check: func(t *testing.T, copied *calendar.Event) {
+				if copied.Summary != "Doctor appointment" || copied.Description != "Bring test results" || copied.Location != "Clinic" {
+					t.Errorf("copy = %q/%q/%q, want the source details", copied.Summary, copied.Description, copied.Location)
+				}
+				if len(copied.Recurrence) != 1 {
+					t.Errorf("Recurrence = %v, want copied", copied.Recurrence)
+				}
+			},
+		},
+		{
+			name:       "unknown privacy returns error",
+			args:       map[string]any{"eventId": "evt-1", "targetCalendarId": "team@example.com", "privacy": "public"},
+			wantErrSub: "privacy must be one of",
+		},
+		{
+			name:       "copying onto the source calendar returns error",
+			args:       map[string]any{"eventId": "evt-1", "targetCalendarId": "primary"},
+			wantErrSub: "must differ from the source calendar",
+		},
+		{
+			name:       "missing target returns error",
+			args:       map[string]any{"eventId": "evt-1"},
+			wantErrSub: "targetCalendarId is required",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var copied *calendar.Event
+			var target string
+			stub := &stubCalendarService{
+				getEventFn: func(calendarID, eventID string) (*calendar.Event, error) {
+					return source, nil
+				},
+				createEventFn: func(calendarID string, event *calendar.Event) (*calendar.Event, error) {
+					target = calendarID
+					copied = event
+					created := *event
+					created.Id = "copy-1"
+					return &created, nil
+				},
+			}
+			tool := &CopyEventToCalendarTool{logger: zap.NewNop(), google: stub}
+			result, err := tool.CopyEventToCalendarHandler(context.Background(), tc.args)
+
+			if tc.wantErrSub != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErrSub) {
+					t.Fatalf("error = %v, want substring %q", err, tc.wantErrSub)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if target != "team@example.com" {
+				t.Errorf("created on %q, want team@example.com", target)
+			}
+			if len(copied.Attendees) != 0 {
+				t.Errorf("Attendees = %v, want none copied", copied.Attendees)
+			}
+			if copied.Start.DateTime != source.Start.DateTime || copied.End.DateTime != source.End.DateTime {
+				t.Errorf("copy time = %s-%s, want the source time", copied.Start.DateTime, copied.End.DateTime)
+			}
+			tc.check(t, copied)
+
+			var parsed map[string]any
+			if err := json.Unmarshal([]byte(result), &parsed); err != nil {
+				t.Fatalf("failed to unmarshal result: %v", err)
+			}
+			if parsed["copyEventId"] != "copy-1" {
+				t.Errorf("copyEventId = %v, want copy-1", parsed["copyEventId"])
+			}
+		})
+	}
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
+	"time"
 
 	zap "go.uber.org/zap"
 	calendar "google.golang.org/api/calendar/v3"
@@ -32,30 +34,63 @@ func NewCreateCalendarEventTool(logger *zap.Logger, google google.CalendarServic
 			"type": "object",
 			"properties": map[string]any{
 				"attendees": map[string]any{
-					"description": "List of attendee email addresses. Optional.",
+					"description": "List of attendee email addresses. Optional. Addresses are lowercased and deduplicated; malformed ones are rejected or skipped depending on configuration.",
 					"items":       map[string]any{"type": "string"},
 					"type":        "array",
 				},
+				"confirmLargeInvite": map[string]any{
+					"description": "Set to true to invite more attendees than the configured limit. Only pass this after the user has confirmed the large invite. Optional.",
+					"type":        "boolean",
+				},
 				"description": map[string]any{
 					"description": "Event description. Optional.",
 					"type":        "string",
 				},
+				"declineMessage": map[string]any{
+					"description": "Message sent when a focusTime or outOfOffice event auto-declines an invitation. Optional.",
+					"type":        "string",
+				},
 				"endTime": map[string]any{
-					"description": "End time in RFC3339 format (required, e.g., 2024-01-01T11:00:00Z)",
+					"description": "End time in RFC3339 format (required, e.g., 2024-01-01T11:00:00Z). A time without an offset is read in the calendar's timezone.",
 					"type":        "string",
 				},
+				"eventType": map[string]any{
+					"description": "Special event type. focusTime and outOfOffice block the time and decline conflicting invitations; workingLocation records where the user works (location, or home when empty) without blocking time. Defaults to a regular event.",
+					"enum":        eventTypes,
+					"type":        "string",
+				},
+				"force": map[string]any{
+					"description": "Set to true to create the event even though it overlaps existing events. Only pass this after the user has seen the conflicts and confirmed. Optional.",
+					"type":        "boolean",
+				},
 				"location": map[string]any{
 					"description": "Event location. Optional.",
 					"type":        "string",
 				},
+				"maxAttendees":      guestLimitSchema,
+				"privateProperties": privatePropertiesSchema,
+				"reminders": map[string]any{
+					"description": "Popup reminders in minutes before the start. Optional; an empty list disables reminders. Defaults to the configured reminder.",
+					"items":       map[string]any{"type": "integer"},
+					"type":        "array",
+				},
+				"sharedProperties": sharedPropertiesSchema,
+				"skipDefaultAttendees": map[string]any{
+					"description": "Set to true to leave out the attendees configured to join every event. Optional.",
+					"type":        "boolean",
+				},
 				"startTime": map[string]any{
-					"description": "Start time in RFC3339 format (required, e.g., 2024-01-01T10:00:00Z)",
+					"description": "Start time in RFC3339 format (required, e.g., 2024-01-01T10:00:00Z). A time without an offset is read in the calendar's timezone.",
 					"type":        "string",
 				},
 				"summary": map[string]any{
 					"description": "Event title/summary (required)",
 					"type":        "string",
 				},
+				"template": map[string]any{
+					"description": "Name of a configured description template to fill the description from, e.g. \"standup\". Cannot be combined with description. Optional.",
+					"type":        "string",
+				},
 			},
 			"required": []string{"summary", "startTime", "endTime"},
 		},
@@ -69,26 +104,209 @@ func (s *CreateCalendarEventTool) CreateCalendarEventHandler(ctx context.Context
 	defer span.End()
 	s.logger.Debug("creating calendar event", zap.Any("args", args))
 
+	calendarID := s.google.GetCalendarID()
+	loc, tzName := calendarTimezone(ctx, s.google, calendarID)
+	event, skippedAttendees, err := eventFromArgs(args, loc, tzName)
+	if err != nil {
+		return "", err
+	}
+	if err := applyDescriptionTemplate(event, args, loc); err != nil {
+		return "", err
+	}
+	if len(skippedAttendees) > 0 {
+		s.logger.Warn("skipping invalid attendee emails", zap.Strings("attendees", skippedAttendees))
+	}
+
+	force, err := parseForce(args)
+	if err != nil {
+		return "", err
+	}
+	cfg, err := loadCalendarSettings()
+	if err != nil {
+		return "", err
+	}
+	if cfg.AutoCheckConflicts && !force && checksConflicts(event) {
+		blocked, err := s.conflictsBlockingCreate(ctx, calendarID, event, loc)
+		if err != nil || blocked != "" {
+			return blocked, err
+		}
+	}
+
+	createdEvent, err := s.google.CreateEvent(ctx, calendarID, event)
+	if err != nil {
+		label := calendarLabel(ctx, s.google, calendarID)
+		s.logger.Error("failed to create calendar event", zap.Error(err), zap.String("calendar", label))
+		return "", fmt.Errorf("failed to create calendar event on '%s': %w", label, err)
+	}
+
+	s.logger.Info("calendar event created successfully",
+		zap.String("eventId", createdEvent.Id),
+		zap.String("summary", createdEvent.Summary))
+
+	result := map[string]any{
+		"success":   true,
+		"eventId":   createdEvent.Id,
+		"summary":   createdEvent.Summary,
+		"startTime": createdEvent.Start.DateTime,
+		"endTime":   createdEvent.End.DateTime,
+		"htmlLink":  createdEvent.HtmlLink,
+	}
+
+	if viewLink := viewLinkFor(calendarID, createdEvent); viewLink != "" {
+		result["viewLink"] = viewLink
+	}
+	if createdEvent.Description != "" {
+		result["description"] = createdEvent.Description
+	}
+	if createdEvent.Location != "" {
+		result["location"] = createdEvent.Location
+	}
+	if len(createdEvent.Attendees) > 0 {
+		var attendees []string
+		for _, attendee := range createdEvent.Attendees {
+			attendees = append(attendees, attendee.Email)
+		}
+		result["attendees"] = attendees
+	}
+	if len(skippedAttendees) > 0 {
+		result["skippedAttendees"] = skippedAttendees
+	}
+	if warning := guestLimitWarning(createdEvent); warning != "" {
+		s.logger.Warn("event exceeds its guest limit", zap.String("eventId", createdEvent.Id), zap.String("warning", warning))
+		result["warning"] = warning
+	}
+	addExtendedProperties(result, createdEvent)
+	if cfg.VerifyWrites {
+		verified := awaitEventListed(ctx, s.google, calendarID, createdEvent, loc)
+		if !verified {
+			s.logger.Warn("created event does not show up in listings yet", zap.String("eventId", createdEvent.Id))
+		}
+		result["verified"] = verified
+	}
+
+	resultJSON, err := json.Marshal(result)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal result: %w", err)
+	}
+
+	return string(resultJSON), nil
+}
+
+// checksConflicts reports whether GOOGLE_CALENDAR_AUTO_CHECK_CONFLICTS
+// applies to event. All-day events, free (transparent) events and special
+// event types are exempt: out-of-office and focus time blocks are meant to
+// decline the meetings they overlap, not to be refused because of them.
+func checksConflicts(event *calendar.Event) bool {
+	if event.Start == nil || event.Start.DateTime == "" {
+		return false
+	}
+	if event.EventType != "" && event.EventType != "default" {
+		return false
+	}
+	return event.Transparency != "transparent"
+}
+
+// conflictsBlockingCreate checks the event's time range for conflicts under
+// GOOGLE_CALENDAR_AUTO_CHECK_CONFLICTS. When there are any it returns the
+// result reporting them along with free alternatives, and the event must not
+// be created; it returns "" when the time is free.
+func (s *CreateCalendarEventTool) conflictsBlockingCreate(ctx context.Context, calendarID string, event *calendar.Event, loc *time.Location) (string, error) {
+	start, err := time.Parse(time.RFC3339, event.Start.DateTime)
+	if err != nil {
+		return "", fmt.Errorf("invalid startTime format (expected RFC3339): %w", err)
+	}
+	end, err := time.Parse(time.RFC3339, event.End.DateTime)
+	if err != nil {
+		return "", fmt.Errorf("invalid endTime format (expected RFC3339): %w", err)
+	}
+
+	conflicts, err := s.google.CheckConflicts(ctx, calendarID, start, end)
+	if err != nil {
+		s.logger.Error("failed to check conflicts before create", zap.Error(err))
+		return "", fmt.Errorf("failed to check conflicts: %w", err)
+	}
+	if len(conflicts) == 0 {
+		return "", nil
+	}
+
+	alternatives, err := conflictAlternatives(ctx, s.google, calendarID, start, end, loc)
+	if err != nil {
+		s.logger.Error("failed to find alternatives to a conflicting event", zap.Error(err))
+		return "", err
+	}
+
+	s.logger.Info("calendar event not created because of conflicts",
+		zap.String("summary", event.Summary),
+		zap.Int("conflictCount", len(conflicts)))
+
+	conflictList := []map[string]any{}
+	for _, conflict := range conflicts {
+		conflictList = append(conflictList, conflictSummary(conflict))
+	}
+	alternativeList := []map[string]any{}
+	for _, slot := range alternatives {
+		alternativeList = append(alternativeList, map[string]any{
+			"startTime": slot.startTime.Format(time.RFC3339),
+			"endTime":   slot.endTime.Format(time.RFC3339),
+		})
+	}
+
+	result := map[string]any{
+		"success":       false,
+		"created":       false,
+		"summary":       event.Summary,
+		"conflicts":     conflictList,
+		"conflictCount": len(conflicts),
+		"alternatives":  alternativeList,
+		"message": fmt.Sprintf("The event was not created because it overlaps %d existing %s. Offer one of the alternatives, or pass force: true once the user confirms the double booking.",
+			len(conflicts), plural(len(conflicts), "event", "events")),
+	}
+
+	resultJSON, err := json.Marshal(result)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal result: %w", err)
+	}
+	return string(resultJSON), nil
+}
+
+// eventFromArgs builds the event described by create_calendar_event
+// arguments, validating required fields and the time range. Naive start and
+// end times are read in loc, the target calendar's timezone. It also returns
+// the malformed attendee addresses dropped under
+// GOOGLE_CALENDAR_INVALID_ATTENDEES=skip.
+func eventFromArgs(args map[string]any, loc *time.Location, tzName string) (*calendar.Event, []string, error) {
 	summary, ok := args["summary"].(string)
 	if !ok || summary == "" {
-		return "", fmt.Errorf("summary is required")
+		return nil, nil, fmt.Errorf("summary is required")
 	}
 
 	startTime, ok := args["startTime"].(string)
 	if !ok || startTime == "" {
-		return "", fmt.Errorf("startTime is required")
+		return nil, nil, fmt.Errorf("startTime is required")
 	}
 
 	endTime, ok := args["endTime"].(string)
 	if !ok || endTime == "" {
-		return "", fmt.Errorf("endTime is required")
+		return nil, nil, fmt.Errorf("endTime is required")
+	}
+
+	start, err := eventDateTime(startTime, loc, tzName)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid startTime format (expected RFC3339): %w", err)
+	}
+	end, err := eventDateTime(endTime, loc, tzName)
+	if err != nil {
+		return nil, nil, fmt.Errorf("invalid endTime format (expected RFC3339): %w", err)
+	}
+	if err := validateEventRange(start, end); err != nil {
+		return nil, nil, err
 	}
 
 	description := ""
 	if desc, exists := args["description"]; exists && desc != nil {
 		s, ok := desc.(string)
 		if !ok {
-			return "", fmt.Errorf("description must be a string, got %T", desc)
+			return nil, nil, fmt.Errorf("description must be a string, got %T", desc)
 		}
 		description = s
 	}
@@ -97,35 +315,100 @@ func (s *CreateCalendarEventTool) CreateCalendarEventHandler(ctx context.Context
 	if loc, exists := args["location"]; exists && loc != nil {
 		s, ok := loc.(string)
 		if !ok {
-			return "", fmt.Errorf("location must be a string, got %T", loc)
+			return nil, nil, fmt.Errorf("location must be a string, got %T", loc)
 		}
 		location = s
 	}
 
-	var attendeeEmails []string
+	var attendeeEmails, skippedAttendees []string
 	if attendees, exists := args["attendees"]; exists && attendees != nil {
 		if attendeeList, ok := attendees.([]any); ok {
-			for _, attendee := range attendeeList {
-				if email, ok := attendee.(string); ok {
-					attendeeEmails = append(attendeeEmails, email)
-				}
+			cfg, err := loadCalendarSettings()
+			if err != nil {
+				return nil, nil, err
+			}
+			attendeeEmails, skippedAttendees, err = normalizeAttendees(attendeeList, cfg.InvalidAttendees)
+			if err != nil {
+				return nil, nil, err
 			}
 		}
 	}
 
+	var reminders *calendar.EventReminders
+	if r, exists := args["reminders"]; exists && r != nil {
+		list, ok := r.([]any)
+		if !ok {
+			return nil, nil, fmt.Errorf("reminders must be an array, got %T", r)
+		}
+		reminders = &calendar.EventReminders{ForceSendFields: []string{"UseDefault"}}
+		for _, item := range list {
+			minutes, ok := item.(float64)
+			if !ok || minutes < 0 {
+				return nil, nil, fmt.Errorf("reminders must contain non-negative minutes, got %v", item)
+			}
+			reminders.Overrides = append(reminders.Overrides, &calendar.EventReminder{Method: "popup", Minutes: int64(minutes), ForceSendFields: []string{"Minutes"}})
+		}
+	} else {
+		cfg, err := loadCalendarSettings()
+		if err != nil {
+			return nil, nil, err
+		}
+		if cfg.DefaultReminderMinutes > 0 {
+			reminders = &calendar.EventReminders{
+				ForceSendFields: []string{"UseDefault"},
+				Overrides:       []*calendar.EventReminder{{Method: "popup", Minutes: int64(cfg.DefaultReminderMinutes)}},
+			}
+		}
+	}
+
+	summary, err = decorateTitle(summary)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	event := &calendar.Event{
 		Summary:     summary,
 		Description: description,
 		Location:    location,
-		Start: &calendar.EventDateTime{
-			DateTime: startTime,
-		},
-		End: &calendar.EventDateTime{
-			DateTime: endTime,
-		},
+		Start:       start,
+		End:         end,
+		Reminders:   reminders,
+	}
+
+	if err := applyEventType(event, args); err != nil {
+		return nil, nil, err
+	}
+
+	if err := applyExtendedProperties(event, args); err != nil {
+		return nil, nil, err
+	}
+
+	if err := applyGuestLimit(event, args); err != nil {
+		return nil, nil, err
+	}
+
+	// Focus time and the other special types take no attendees, so the
+	// defaults are only added to regular events.
+	if event.EventType == "" || event.EventType == "default" {
+		attendeeEmails, err = mergeDefaultAttendees(attendeeEmails, args)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	if len(attendeeEmails) > 0 {
+		cfg, err := loadCalendarSettings()
+		if err != nil {
+			return nil, nil, err
+		}
+		if err := checkAttendeeLimit(len(attendeeEmails), cfg.MaxAttendees, args); err != nil {
+			return nil, nil, err
+		}
 	}
 
 	if len(attendeeEmails) > 0 {
+		if event.EventType != "" && event.EventType != "default" {
+			return nil, nil, fmt.Errorf("attendees are not supported for %s events", event.EventType)
+		}
 		var attendees []*calendar.EventAttendee
 		for _, email := range attendeeEmails {
 			attendees = append(attendees, &calendar.EventAttendee{
@@ -135,44 +418,89 @@ func (s *CreateCalendarEventTool) CreateCalendarEventHandler(ctx context.Context
 		event.Attendees = attendees
 	}
 
-	calendarID := s.google.GetCalendarID()
-	createdEvent, err := s.google.CreateEvent(calendarID, event)
-	if err != nil {
-		s.logger.Error("failed to create calendar event", zap.Error(err))
-		return "", fmt.Errorf("failed to create calendar event: %w", err)
+	return event, skippedAttendees, nil
+}
+
+// eventTypes are the eventType values create_calendar_event accepts.
+var eventTypes = []string{"default", "focusTime", "outOfOffice", "workingLocation"}
+
+// applyEventType sets eventType and the properties Google requires for it.
+// Focus time and out-of-office events decline conflicting invitations.
+func applyEventType(event *calendar.Event, args map[string]any) error {
+	v, exists := args["eventType"]
+	if !exists || v == nil {
+		return nil
+	}
+	eventType, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("eventType must be a string, got %T", v)
 	}
 
-	s.logger.Info("calendar event created successfully",
-		zap.String("eventId", createdEvent.Id),
-		zap.String("summary", createdEvent.Summary))
+	declineMessage := ""
+	if m, exists := args["declineMessage"]; exists && m != nil {
+		msg, ok := m.(string)
+		if !ok {
+			return fmt.Errorf("declineMessage must be a string, got %T", m)
+		}
+		declineMessage = msg
+	}
 
-	result := map[string]any{
-		"success":   true,
-		"eventId":   createdEvent.Id,
-		"summary":   createdEvent.Summary,
-		"startTime": createdEvent.Start.DateTime,
-		"endTime":   createdEvent.End.DateTime,
-		"htmlLink":  createdEvent.HtmlLink,
+	switch eventType {
+	case "default":
+	case "focusTime":
+		event.FocusTimeProperties = &calendar.EventFocusTimeProperties{
+			AutoDeclineMode: "declineOnlyNewConflictingInvitations",
+			ChatStatus:      "doNotDisturb",
+			DeclineMessage:  declineMessage,
+		}
+	case "outOfOffice":
+		event.OutOfOfficeProperties = &calendar.EventOutOfOfficeProperties{
+			AutoDeclineMode: "declineAllConflictingInvitations",
+			DeclineMessage:  declineMessage,
+		}
+	case "workingLocation":
+		props := &calendar.EventWorkingLocationProperties{Type: "homeOffice", HomeOffice: struct{}{}}
+		if event.Location != "" {
+			props = &calendar.EventWorkingLocationProperties{
+				Type:           "customLocation",
+				CustomLocation: &calendar.EventWorkingLocationPropertiesCustomLocation{Label: event.Location},
+			}
+		}
+		event.WorkingLocationProperties = props
+		event.Transparency = "transparent"
+		event.Visibility = "public"
+	default:
+		return fmt.Errorf("eventType must be one of %s, got %q", strings.Join(eventTypes, ", "), eventType)
 	}
+	event.EventType = eventType
+	return nil
+}
 
-	if createdEvent.Description != "" {
-		result["description"] = createdEvent.Description
+// validateEventRange rejects malformed or reversed event times. Both bounds
+// must use the same form: RFC3339 date-times, or dates for all-day events
+// where the end date is exclusive.
+func validateEventRange(start, end *calendar.EventDateTime) error {
+	if start == nil || end == nil {
+		return nil
 	}
-	if createdEvent.Location != "" {
-		result["location"] = createdEvent.Location
+	layout, startValue, endValue := time.RFC3339, start.DateTime, end.DateTime
+	if startValue == "" && endValue == "" {
+		layout, startValue, endValue = "2006-01-02", start.Date, end.Date
 	}
-	if len(createdEvent.Attendees) > 0 {
-		var attendees []string
-		for _, attendee := range createdEvent.Attendees {
-			attendees = append(attendees, attendee.Email)
-		}
-		result["attendees"] = attendees
+	if startValue == "" || endValue == "" {
+		return nil
 	}
 
-	resultJSON, err := json.Marshal(result)
+	startAt, err := time.Parse(layout, startValue)
 	if err != nil {
-		return "", fmt.Errorf("failed to marshal result: %w", err)
+		return fmt.Errorf("invalid startTime format (expected RFC3339): %w", err)
 	}
-
-	return string(resultJSON), nil
+	endAt, err := time.Parse(layout, endValue)
+	if err != nil {
+		return fmt.Errorf("invalid endTime format (expected RFC3339): %w", err)
+	}
+	if !endAt.After(startAt) {
+		return fmt.Errorf("endTime (%s) must be after startTime (%s)", endValue, startValue)
+	}
+	return nil
 }
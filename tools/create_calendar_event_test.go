@@ -6,12 +6,25 @@ import (
 	"errors"
 	"strings"
 	"testing"
+	"time"
 
 	zap "go.uber.org/zap"
 	calendar "google.golang.org/api/calendar/v3"
+
+	config "github.com/inference-gateway/google-calendar-agent/config"
+	google "github.com/inference-gateway/google-calendar-agent/internal/google"
 )
 
+// disableConflictCheck turns GOOGLE_CALENDAR_AUTO_CHECK_CONFLICTS off for
+// tests of other create behavior; TestCreateCalendarEventConflictCheck
+// covers the check itself.
+func disableConflictCheck(t *testing.T) {
+	t.Helper()
+	t.Setenv("GOOGLE_CALENDAR_AUTO_CHECK_CONFLICTS", "false")
+}
+
 func TestCreateCalendarEventHandler(t *testing.T) {
+	disableConflictCheck(t)
 	echoCreate := func(calendarID string, event *calendar.Event) (*calendar.Event, error) {
 		event.Id = "evt-created"
 		event.HtmlLink = "https://example.com/evt-created"
@@ -85,6 +98,24 @@ func TestCreateCalendarEventHandler(t *testing.T) {
 			wantErr:    true,
 			wantErrSub: "endTime is required",
 		},
+		{
+			name:       "endTime equal to startTime returns error",
+			args:       map[string]any{"summary": "s", "startTime": "2026-05-23T10:00:00Z", "endTime": "2026-05-23T10:00:00Z"},
+			wantErr:    true,
+			wantErrSub: "must be after startTime",
+		},
+		{
+			name:       "endTime before startTime returns error",
+			args:       map[string]any{"summary": "s", "startTime": "2026-05-23T11:00:00Z", "endTime": "2026-05-23T12:00:00+02:00"},
+			wantErr:    true,
+			wantErrSub: "must be after startTime",
+		},
+		{
+			name:       "unparseable startTime returns error",
+			args:       map[string]any{"summary": "s", "startTime": "tomorrow 10am", "endTime": "2026-05-23T11:00:00Z"},
+			wantErr:    true,
+			wantErrSub: "invalid startTime format",
+		},
 		{
 			name: "wrong-typed description returns error and does not panic",
 			args: map[string]any{
@@ -174,3 +205,771 @@ func TestCreateCalendarEventHandler(t *testing.T) {
 		})
 	}
 }
+
+func TestCreateCalendarEventReminders(t *testing.T) {
+	disableConflictCheck(t)
+	base := func() map[string]any {
+		return map[string]any{
+			"summary":   "Standup",
+			"startTime": "2026-05-23T10:00:00Z",
+			"endTime":   "2026-05-23T10:30:00Z",
+		}
+	}
+
+	tests := []struct {
+		name          string
+		defaultMins   string
+		reminders     any
+		wantReminders []int64
+		wantNil       bool
+		wantErrSub    string
+	}{
+		{
+			name:        "no default keeps the calendar default",
+			defaultMins: "0",
+			wantNil:     true,
+		},
+		{
+			name:          "configured default is applied as a popup",
+			defaultMins:   "10",
+			wantReminders: []int64{10},
+		},
+		{
+			name:          "explicit reminders override the default",
+			defaultMins:   "10",
+			reminders:     []any{float64(30), float64(0)},
+			wantReminders: []int64{30, 0},
+		},
+		{
+			name:          "explicit empty list disables reminders",
+			defaultMins:   "10",
+			reminders:     []any{},
+			wantReminders: nil,
+		},
+		{
+			name:        "negative minutes are rejected",
+			defaultMins: "0",
+			reminders:   []any{float64(-5)},
+			wantErrSub:  "non-negative minutes",
+		},
+		{
+			name:        "non-array reminders are rejected",
+			defaultMins: "0",
+			reminders:   "10",
+			wantErrSub:  "reminders must be an array",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("GOOGLE_CALENDAR_DEFAULT_REMINDER_MINUTES", tt.defaultMins)
+
+			var created *calendar.Event
+			stub := &stubCalendarService{
+				createEventFn: func(calendarID string, event *calendar.Event) (*calendar.Event, error) {
+					created = event
+					event.Id = "evt-created"
+					return event, nil
+				},
+			}
+			tool := &CreateCalendarEventTool{logger: zap.NewNop(), google: stub}
+
+			args := base()
+			if tt.reminders != nil {
+				args["reminders"] = tt.reminders
+			}
+
+			_, err := tool.CreateCalendarEventHandler(context.Background(), args)
+			if tt.wantErrSub != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErrSub) {
+					t.Fatalf("error = %v, want containing %q", err, tt.wantErrSub)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if tt.wantNil {
+				if created.Reminders != nil {
+					t.Errorf("Reminders = %+v, want nil", created.Reminders)
+				}
+				return
+			}
+			if created.Reminders == nil {
+				t.Fatal("Reminders = nil, want overrides")
+			}
+			if created.Reminders.UseDefault {
+				t.Error("UseDefault = true, want false")
+			}
+			var got []int64
+			for _, r := range created.Reminders.Overrides {
+				if r.Method != "popup" {
+					t.Errorf("Method = %q, want popup", r.Method)
+				}
+				got = append(got, r.Minutes)
+			}
+			if len(got) != len(tt.wantReminders) {
+				t.Fatalf("overrides = %v, want %v", got, tt.wantReminders)
+			}
+			for i := range got {
+				if got[i] != tt.wantReminders[i] {
+					t.Errorf("overrides = %v, want %v", got, tt.wantReminders)
+				}
+			}
+		})
+	}
+}
+
+func TestCreateCalendarEventEventType(t *testing.T) {
+	disableConflictCheck(t)
+	base := func(eventType string) map[string]any {
+		return map[string]any{
+			"summary":   "Away",
+			"startTime": "2026-05-23T09:00:00Z",
+			"endTime":   "2026-05-23T17:00:00Z",
+			"eventType": eventType,
+		}
+	}
+
+	tests := []struct {
+		name       string
+		args       map[string]any
+		wantErrSub string
+		check      func(t *testing.T, event *calendar.Event)
+	}{
+		{
+			name: "out of office declines conflicting invitations",
+			args: func() map[string]any {
+				args := base("outOfOffice")
+				args["declineMessage"] = "On holiday"
+				return args
+			}(),
+			check: func(t *testing.T, event *calendar.Event) {
+				props := event.OutOfOfficeProperties
+				if props == nil {
+					t.Fatal("OutOfOfficeProperties = nil")
+				}
+				if props.AutoDeclineMode != "declineAllConflictingInvitations" || props.DeclineMessage != "On holiday" {
+					t.Errorf("OutOfOfficeProperties = %+v", props)
+				}
+			},
+		},
+		{
+			name: "focus time sets do not disturb",
+			args: base("focusTime"),
+			check: func(t *testing.T, event *calendar.Event) {
+				if event.FocusTimeProperties == nil || event.FocusTimeProperties.ChatStatus != "doNotDisturb" {
+					t.Errorf("FocusTimeProperties = %+v", event.FocusTimeProperties)
+				}
+			},
+		},
+		{
+			name: "working location with a location is a custom location",
+			args: func() map[string]any {
+				args := base("workingLocation")
+				args["location"] = "Berlin office"
+				return args
+			}(),
+			check: func(t *testing.T, event *calendar.Event) {
+				props := event.WorkingLocationProperties
+				if props == nil || props.CustomLocation == nil || props.CustomLocation.Label != "Berlin office" {
+					t.Errorf("WorkingLocationProperties = %+v", props)
+				}
+				if event.Transparency != "transparent" {
+					t.Errorf("Transparency = %q, want transparent", event.Transparency)
+				}
+			},
+		},
+		{
+			name:       "unknown type is rejected",
+			args:       base("birthday"),
+			wantErrSub: "eventType must be one of",
+		},
+		{
+			name: "attendees are rejected on special types",
+			args: func() map[string]any {
+				args := base("outOfOffice")
+				args["attendees"] = []any{"a@example.com"}
+				return args
+			}(),
+			wantErrSub: "attendees are not supported",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var created *calendar.Event
+			stub := &stubCalendarService{
+				createEventFn: func(calendarID string, event *calendar.Event) (*calendar.Event, error) {
+					created = event
+					event.Id = "evt-created"
+					return event, nil
+				},
+			}
+			tool := &CreateCalendarEventTool{logger: zap.NewNop(), google: stub}
+
+			_, err := tool.CreateCalendarEventHandler(context.Background(), tt.args)
+			if tt.wantErrSub != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErrSub) {
+					t.Fatalf("error = %v, want containing %q", err, tt.wantErrSub)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if created.EventType != tt.args["eventType"] {
+				t.Errorf("EventType = %q, want %q", created.EventType, tt.args["eventType"])
+			}
+			tt.check(t, created)
+		})
+	}
+}
+
+func TestOutOfOfficeEventBlocksScheduling(t *testing.T) {
+	disableConflictCheck(t)
+	t.Setenv("GOOGLE_CALENDAR_TIMEZONE", "UTC")
+	t.Setenv("TZ", "")
+
+	var events []*calendar.Event
+	stub := &stubCalendarService{
+		createEventFn: func(calendarID string, event *calendar.Event) (*calendar.Event, error) {
+			event.Id = "evt-" + event.EventType
+			events = append(events, event)
+			return event, nil
+		},
+		listEventsFn: func(calendarID string, timeMin, timeMax time.Time) ([]*calendar.Event, error) {
+			return events, nil
+		},
+	}
+	create := &CreateCalendarEventTool{logger: zap.NewNop(), google: stub}
+	for _, args := range []map[string]any{
+		{"summary": "Home", "startTime": "2026-05-23T09:00:00Z", "endTime": "2026-05-23T17:00:00Z", "eventType": "workingLocation"},
+		{"summary": "Dentist", "startTime": "2026-05-23T09:00:00Z", "endTime": "2026-05-23T13:00:00Z", "eventType": "outOfOffice"},
+	} {
+		if _, err := create.CreateCalendarEventHandler(context.Background(), args); err != nil {
+			t.Fatalf("create %v: %v", args["eventType"], err)
+		}
+	}
+
+	find := &FindAvailableTimeTool{logger: zap.NewNop(), google: stub}
+	result, err := find.FindAvailableTimeHandler(context.Background(), map[string]any{
+		"startDate": "2026-05-23T09:00:00Z",
+		"endDate":   "2026-05-23T17:00:00Z",
+		"duration":  float64(60),
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var parsed struct {
+		AvailableSlots []map[string]any `json:"availableSlots"`
+	}
+	if err := json.Unmarshal([]byte(result), &parsed); err != nil {
+		t.Fatalf("failed to unmarshal result: %v", err)
+	}
+	if len(parsed.AvailableSlots) == 0 {
+		t.Fatal("no slots found, want the afternoon free")
+	}
+	if got := parsed.AvailableSlots[0]["startTime"]; got != "2026-05-23T13:00:00Z" {
+		t.Errorf("first slot startTime = %v, want 2026-05-23T13:00:00Z", got)
+	}
+}
+
+func TestCreateCalendarEventAttendees(t *testing.T) {
+	disableConflictCheck(t)
+	tests := []struct {
+		name          string
+		mode          string
+		attendees     []any
+		wantErrSub    string
+		wantAttendees []string
+		wantSkipped   []string
+	}{
+		{
+			name:          "valid addresses are lowercased and display names dropped",
+			attendees:     []any{"Ada@Example.com", "Grace Hopper <grace@EXAMPLE.com>"},
+			wantAttendees: []string{"ada@example.com", "grace@example.com"},
+		},
+		{
+			name:          "duplicates are removed after normalization",
+			attendees:     []any{"ada@example.com", " ADA@example.com ", "Ada <ada@example.com>", "bob@example.com"},
+			wantAttendees: []string{"ada@example.com", "bob@example.com"},
+		},
+		{
+			name:       "malformed address is rejected by default",
+			attendees:  []any{"ada@example.com", "bob@@example"},
+			wantErrSub: `invalid attendee email "bob@@example"`,
+		},
+		{
+			name:          "malformed address is skipped in skip mode",
+			mode:          "skip",
+			attendees:     []any{"ada@example.com", "not an email"},
+			wantAttendees: []string{"ada@example.com"},
+			wantSkipped:   []string{"not an email"},
+		},
+		{
+			name:       "unknown mode returns error",
+			mode:       "ignore",
+			attendees:  []any{"ada@example.com"},
+			wantErrSub: `invalid attendees mode "ignore"`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if tc.mode != "" {
+				t.Setenv("GOOGLE_CALENDAR_INVALID_ATTENDEES", tc.mode)
+			}
+			var created *calendar.Event
+			stub := &stubCalendarService{
+				createEventFn: func(calendarID string, event *calendar.Event) (*calendar.Event, error) {
+					created = event
+					event.Id = "evt-created"
+					return event, nil
+				},
+			}
+			tool := &CreateCalendarEventTool{logger: zap.NewNop(), google: stub}
+			result, err := tool.CreateCalendarEventHandler(context.Background(), map[string]any{
+				"summary":   "Planning",
+				"startTime": "2026-05-23T10:00:00Z",
+				"endTime":   "2026-05-23T11:00:00Z",
+				"attendees": tc.attendees,
+			})
+
+			if tc.wantErrSub != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErrSub) {
+					t.Fatalf("error = %v, want substring %q", err, tc.wantErrSub)
+				}
+				if created != nil {
+					t.Error("event was created despite the error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			var got []string
+			for _, a := range created.Attendees {
+				got = append(got, a.Email)
+			}
+			if strings.Join(got, ",") != strings.Join(tc.wantAttendees, ",") {
+				t.Errorf("attendees = %v, want %v", got, tc.wantAttendees)
+			}
+
+			var parsed struct {
+				SkippedAttendees []string `json:"skippedAttendees"`
+			}
+			if err := json.Unmarshal([]byte(result), &parsed); err != nil {
+				t.Fatalf("failed to unmarshal result: %v", err)
+			}
+			if strings.Join(parsed.SkippedAttendees, ",") != strings.Join(tc.wantSkipped, ",") {
+				t.Errorf("skippedAttendees = %v, want %v", parsed.SkippedAttendees, tc.wantSkipped)
+			}
+		})
+	}
+}
+
+func TestCreateCalendarEventDefaultAttendees(t *testing.T) {
+	disableConflictCheck(t)
+	tests := []struct {
+		name          string
+		defaults      string
+		args          map[string]any
+		wantErrSub    string
+		wantAttendees []string
+	}{
+		{
+			name:          "defaults are added to an event without attendees",
+			defaults:      "Manager@Example.com,team@example.com",
+			wantAttendees: []string{"manager@example.com", "team@example.com"},
+		},
+		{
+			name:          "defaults are merged after the requested attendees without duplicates",
+			defaults:      "manager@example.com,team@example.com",
+			args:          map[string]any{"attendees": []any{"ada@example.com", "MANAGER@example.com"}},
+			wantAttendees: []string{"ada@example.com", "manager@example.com", "team@example.com"},
+		},
+		{
+			name:          "skipDefaultAttendees opts out",
+			defaults:      "manager@example.com",
+			args:          map[string]any{"attendees": []any{"ada@example.com"}, "skipDefaultAttendees": true},
+			wantAttendees: []string{"ada@example.com"},
+		},
+		{
+			name:     "focus time gets no defaults",
+			defaults: "manager@example.com",
+			args:     map[string]any{"eventType": "focusTime"},
+		},
+		{
+			name:          "no defaults configured",
+			args:          map[string]any{"attendees": []any{"ada@example.com"}},
+			wantAttendees: []string{"ada@example.com"},
+		},
+		{
+			name:       "malformed default is reported",
+			defaults:   "manager@@example",
+			wantErrSub: "invalid GOOGLE_CALENDAR_DEFAULT_ATTENDEES",
+		},
+		{
+			name:       "non-boolean skipDefaultAttendees",
+			defaults:   "manager@example.com",
+			args:       map[string]any{"skipDefaultAttendees": "yes"},
+			wantErrSub: "skipDefaultAttendees must be a boolean",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Setenv("GOOGLE_CALENDAR_DEFAULT_ATTENDEES", tc.defaults)
+			var created *calendar.Event
+			stub := &stubCalendarService{
+				createEventFn: func(calendarID string, event *calendar.Event) (*calendar.Event, error) {
+					created = event
+					event.Id = "evt-created"
+					return event, nil
+				},
+			}
+			tool := &CreateCalendarEventTool{logger: zap.NewNop(), google: stub}
+			args := map[string]any{
+				"summary":   "Planning",
+				"startTime": "2026-05-23T10:00:00Z",
+				"endTime":   "2026-05-23T11:00:00Z",
+			}
+			for k, v := range tc.args {
+				args[k] = v
+			}
+			_, err := tool.CreateCalendarEventHandler(context.Background(), args)
+
+			if tc.wantErrSub != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErrSub) {
+					t.Fatalf("error = %v, want substring %q", err, tc.wantErrSub)
+				}
+				if created != nil {
+					t.Error("event was created despite the error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			var got []string
+			for _, a := range created.Attendees {
+				got = append(got, a.Email)
+			}
+			if strings.Join(got, ",") != strings.Join(tc.wantAttendees, ",") {
+				t.Errorf("attendees = %v, want %v", got, tc.wantAttendees)
+			}
+		})
+	}
+}
+
+func TestCreateCalendarEventVerifyWrites(t *testing.T) {
+	disableConflictCheck(t)
+	backoff := verifyWriteBackoff
+	verifyWriteBackoff = time.Millisecond
+	t.Cleanup(func() { verifyWriteBackoff = backoff })
+
+	tests := []struct {
+		name         string
+		verify       string
+		visibleAfter int
+		wantReads    int
+		wantVerified any
+	}{
+		{name: "verification disabled", verify: "false", wantReads: 0, wantVerified: nil},
+		{name: "listed on the first read", verify: "true", visibleAfter: 1, wantReads: 1, wantVerified: true},
+		{name: "first read misses and a retry finds it", verify: "true", visibleAfter: 2, wantReads: 2, wantVerified: true},
+		{name: "never listed", verify: "true", visibleAfter: verifyWriteAttempts + 1, wantReads: verifyWriteAttempts, wantVerified: false},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Setenv("GOOGLE_CALENDAR_VERIFY_WRITES", tc.verify)
+			var created *calendar.Event
+			reads := 0
+			stub := &stubCalendarService{
+				createEventFn: func(calendarID string, event *calendar.Event) (*calendar.Event, error) {
+					event.Id = "evt-created"
+					created = event
+					return event, nil
+				},
+				listEventsFn: func(calendarID string, timeMin, timeMax time.Time) ([]*calendar.Event, error) {
+					reads++
+					if reads < tc.visibleAfter {
+						return nil, nil
+					}
+					return []*calendar.Event{created}, nil
+				},
+			}
+			tool := &CreateCalendarEventTool{logger: zap.NewNop(), google: stub}
+			result, err := tool.CreateCalendarEventHandler(context.Background(), map[string]any{
+				"summary":   "Planning",
+				"startTime": "2026-05-23T10:00:00Z",
+				"endTime":   "2026-05-23T11:00:00Z",
+			})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			var parsed map[string]any
+			if err := json.Unmarshal([]byte(result), &parsed); err != nil {
+				t.Fatalf("failed to unmarshal result: %v", err)
+			}
+			if parsed["success"] != true {
+				t.Errorf("success = %v, want true", parsed["success"])
+			}
+			if parsed["verified"] != tc.wantVerified {
+				t.Errorf("verified = %v, want %v", parsed["verified"], tc.wantVerified)
+			}
+			if reads != tc.wantReads {
+				t.Errorf("listed %d times, want %d", reads, tc.wantReads)
+			}
+		})
+	}
+}
+
+func TestCreateCalendarEventMaxAttendees(t *testing.T) {
+	disableConflictCheck(t)
+	t.Setenv("GOOGLE_CALENDAR_MAX_ATTENDEES", "2")
+
+	tests := []struct {
+		name       string
+		attendees  []any
+		confirm    any
+		wantErrSub string
+	}{
+		{
+			name:      "under the limit is created",
+			attendees: []any{"a@example.com", "b@example.com"},
+		},
+		{
+			name:      "duplicates do not count towards the limit",
+			attendees: []any{"a@example.com", "A@example.com", "b@example.com"},
+		},
+		{
+			name:       "over the limit requires confirmation",
+			attendees:  []any{"a@example.com", "b@example.com", "c@example.com"},
+			wantErrSub: "event has 3 attendees, more than the limit of 2",
+		},
+		{
+			name:      "over the limit with confirmation is created",
+			attendees: []any{"a@example.com", "b@example.com", "c@example.com"},
+			confirm:   true,
+		},
+		{
+			name:       "non-boolean confirmation returns error",
+			attendees:  []any{"a@example.com", "b@example.com", "c@example.com"},
+			confirm:    "yes",
+			wantErrSub: "confirmLargeInvite must be a boolean",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			created := false
+			stub := &stubCalendarService{
+				createEventFn: func(calendarID string, event *calendar.Event) (*calendar.Event, error) {
+					created = true
+					event.Id = "evt-created"
+					return event, nil
+				},
+			}
+			args := map[string]any{
+				"summary":   "All hands",
+				"startTime": "2026-05-23T10:00:00Z",
+				"endTime":   "2026-05-23T11:00:00Z",
+				"attendees": tc.attendees,
+			}
+			if tc.confirm != nil {
+				args["confirmLargeInvite"] = tc.confirm
+			}
+			tool := &CreateCalendarEventTool{logger: zap.NewNop(), google: stub}
+			_, err := tool.CreateCalendarEventHandler(context.Background(), args)
+
+			if tc.wantErrSub != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErrSub) {
+					t.Fatalf("error = %v, want substring %q", err, tc.wantErrSub)
+				}
+				if created {
+					t.Error("event was created despite the error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !created {
+				t.Error("event was not created")
+			}
+		})
+	}
+}
+
+func TestCreateCalendarEventConflictCheck(t *testing.T) {
+	t.Setenv("GOOGLE_CALENDAR_TIMEZONE", "UTC")
+	t.Setenv("TZ", "")
+	t.Setenv("GOOGLE_CALENDAR_WORKING_HOURS_START", "09:00")
+	t.Setenv("GOOGLE_CALENDAR_WORKING_HOURS_END", "17:00")
+
+	tests := []struct {
+		name             string
+		autoCheck        string
+		args             map[string]any
+		wantCreated      bool
+		wantAlternatives []string
+		wantErrSub       string
+	}{
+		{
+			name:             "conflict blocks create",
+			autoCheck:        "true",
+			args:             map[string]any{"startTime": "2026-05-18T10:30:00Z", "endTime": "2026-05-18T11:30:00Z"},
+			wantAlternatives: []string{"2026-05-18T11:00:00Z", "2026-05-18T12:00:00Z", "2026-05-18T13:00:00Z"},
+		},
+		{
+			name:        "force overrides the conflict",
+			autoCheck:   "true",
+			args:        map[string]any{"startTime": "2026-05-18T10:30:00Z", "endTime": "2026-05-18T11:30:00Z", "force": true},
+			wantCreated: true,
+		},
+		{
+			name:        "free time is created",
+			autoCheck:   "true",
+			args:        map[string]any{"startTime": "2026-05-18T14:00:00Z", "endTime": "2026-05-18T15:00:00Z"},
+			wantCreated: true,
+		},
+		{
+			name:        "check disabled",
+			autoCheck:   "false",
+			args:        map[string]any{"startTime": "2026-05-18T10:30:00Z", "endTime": "2026-05-18T11:30:00Z"},
+			wantCreated: true,
+		},
+		{
+			name:        "out of office is created over the meeting it declines",
+			autoCheck:   "true",
+			args:        map[string]any{"startTime": "2026-05-18T10:30:00Z", "endTime": "2026-05-18T11:30:00Z", "eventType": "outOfOffice"},
+			wantCreated: true,
+		},
+		{
+			name:        "focus time is created over the meeting it declines",
+			autoCheck:   "true",
+			args:        map[string]any{"startTime": "2026-05-18T10:30:00Z", "endTime": "2026-05-18T11:30:00Z", "eventType": "focusTime"},
+			wantCreated: true,
+		},
+		{
+			name:        "free working location is created",
+			autoCheck:   "true",
+			args:        map[string]any{"startTime": "2026-05-18T10:30:00Z", "endTime": "2026-05-18T11:30:00Z", "eventType": "workingLocation"},
+			wantCreated: true,
+		},
+		{
+			name:       "non-boolean force",
+			autoCheck:  "true",
+			args:       map[string]any{"startTime": "2026-05-18T10:30:00Z", "endTime": "2026-05-18T11:30:00Z", "force": "yes"},
+			wantErrSub: "force must be a boolean",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Setenv("GOOGLE_CALENDAR_AUTO_CHECK_CONFLICTS", tc.autoCheck)
+			svc := google.NewInMemoryCalendarService(zap.NewNop(), &config.Config{})
+			existing, err := svc.CreateEvent(context.Background(), "primary", &calendar.Event{
+				Summary: "Design review",
+				Start:   &calendar.EventDateTime{DateTime: "2026-05-18T10:00:00Z"},
+				End:     &calendar.EventDateTime{DateTime: "2026-05-18T11:00:00Z"},
+			})
+			if err != nil {
+				t.Fatalf("CreateEvent: %v", err)
+			}
+
+			args := map[string]any{"summary": "1:1"}
+			for k, v := range tc.args {
+				args[k] = v
+			}
+			tool := &CreateCalendarEventTool{logger: zap.NewNop(), google: svc}
+			result, err := tool.CreateCalendarEventHandler(context.Background(), args)
+			if tc.wantErrSub != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErrSub) {
+					t.Fatalf("error = %v, want substring %q", err, tc.wantErrSub)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			var parsed struct {
+				Success   bool   `json:"success"`
+				EventID   string `json:"eventId"`
+				Conflicts []struct {
+					EventID string `json:"eventId"`
+				} `json:"conflicts"`
+				Alternatives []struct {
+					StartTime string `json:"startTime"`
+				} `json:"alternatives"`
+			}
+			if err := json.Unmarshal([]byte(result), &parsed); err != nil {
+				t.Fatalf("failed to unmarshal result: %v", err)
+			}
+
+			events, err := svc.ListEvents(context.Background(), "primary", time.Date(2026, 5, 18, 0, 0, 0, 0, time.UTC), time.Date(2026, 5, 19, 0, 0, 0, 0, time.UTC))
+			if err != nil {
+				t.Fatalf("ListEvents: %v", err)
+			}
+			if tc.wantCreated {
+				if !parsed.Success || parsed.EventID == "" || len(events) != 2 {
+					t.Errorf("result = %s with %d stored events, want the event created", result, len(events))
+				}
+				return
+			}
+
+			if parsed.Success || len(events) != 1 {
+				t.Errorf("result = %s with %d stored events, want the create blocked", result, len(events))
+			}
+			if len(parsed.Conflicts) != 1 || parsed.Conflicts[0].EventID != existing.Id {
+				t.Errorf("conflicts = %+v, want the design review", parsed.Conflicts)
+			}
+			var starts []string
+			for _, alt := range parsed.Alternatives {
+				starts = append(starts, alt.StartTime)
+			}
+			if strings.Join(starts, ",") != strings.Join(tc.wantAlternatives, ",") {
+				t.Errorf("alternatives = %v, want %v", starts, tc.wantAlternatives)
+			}
+		})
+	}
+}
+
+func TestChecksConflicts(t *testing.T) {
+	timed := func(eventType, transparency string) *calendar.Event {
+		return &calendar.Event{
+			Start:        &calendar.EventDateTime{DateTime: "2026-05-18T10:00:00Z"},
+			End:          &calendar.EventDateTime{DateTime: "2026-05-18T11:00:00Z"},
+			EventType:    eventType,
+			Transparency: transparency,
+		}
+	}
+	tests := []struct {
+		name  string
+		event *calendar.Event
+		want  bool
+	}{
+		{name: "busy meeting", event: timed("", ""), want: true},
+		{name: "explicit default type", event: timed("default", "opaque"), want: true},
+		{name: "free meeting", event: timed("", "transparent"), want: false},
+		{name: "out of office", event: timed("outOfOffice", ""), want: false},
+		{name: "focus time", event: timed("focusTime", ""), want: false},
+		{name: "all-day", event: &calendar.Event{Start: &calendar.EventDateTime{Date: "2026-05-18"}}, want: false},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := checksConflicts(tc.event); got != tc.want {
+				t.Errorf("checksConflicts() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
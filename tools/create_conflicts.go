@@ -0,0 +1,79 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	calendar "google.golang.org/api/calendar/v3"
+
+	google "github.com/inference-gateway/google-calendar-agent/internal/google"
+)
+
+const (
+	// maxConflictAlternatives is how many free slots a blocked create offers.
+	maxConflictAlternatives = 3
+	// conflictAlternativesWindow is how far past the requested start the
+	// alternatives are searched for.
+	conflictAlternativesWindow = 7 * 24 * time.Hour
+)
+
+// parseForce reads the optional force argument of create_calendar_event.
+func parseForce(args map[string]any) (bool, error) {
+	v, exists := args["force"]
+	if !exists || v == nil {
+		return false, nil
+	}
+	force, ok := v.(bool)
+	if !ok {
+		return false, fmt.Errorf("force must be a boolean, got %T", v)
+	}
+	return force, nil
+}
+
+// conflictSummary is the shape conflicting events are reported in.
+func conflictSummary(event *calendar.Event) map[string]any {
+	data := map[string]any{
+		"eventId": event.Id,
+		"summary": event.Summary,
+	}
+	if event.Start != nil {
+		data["startTime"] = event.Start.DateTime
+	}
+	if event.End != nil {
+		data["endTime"] = event.End.DateTime
+	}
+	if event.Location != "" {
+		data["location"] = event.Location
+	}
+	return data
+}
+
+// conflictAlternatives returns up to maxConflictAlternatives free slots of
+// the event's length within working hours, starting from its requested
+// start, for a create that was blocked by conflicts.
+func conflictAlternatives(ctx context.Context, svc google.CalendarService, calendarID string, start, end time.Time, loc *time.Location) ([]timeSlot, error) {
+	hours, err := loadWorkingHours()
+	if err != nil {
+		return nil, err
+	}
+	until := start.Add(conflictAlternativesWindow)
+	events, err := svc.ListEvents(ctx, calendarID, start, until)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list events for alternatives: %w", err)
+	}
+
+	busy := eventBusyPeriods(events, loc)
+	duration := end.Sub(start)
+	var alternatives []timeSlot
+	for from := start; len(alternatives) < maxConflictAlternatives; {
+		slotStart, found := hours.nextFreeSlot(busy, from, until, duration, loc)
+		if !found {
+			break
+		}
+		slotEnd := slotStart.Add(duration)
+		alternatives = append(alternatives, timeSlot{startTime: slotStart, endTime: slotEnd, duration: duration})
+		from = slotEnd
+	}
+	return alternatives, nil
+}
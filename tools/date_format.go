@@ -0,0 +1,61 @@
+package tools
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// dateFormat holds the layouts used for human-readable dates and times in
+// tool responses. Machine-readable fields stay RFC3339 regardless of locale.
+type dateFormat struct {
+	dateLayout  string
+	clockLayout string
+}
+
+// localeFormats maps GOOGLE_CALENDAR_LOCALE values to their layouts. Month
+// and weekday names are always English since time.Format is not localized.
+var localeFormats = map[string]dateFormat{
+	"en":    {dateLayout: "Monday, January 2 2006", clockLayout: "15:04"},
+	"en-US": {dateLayout: "Monday, January 2 2006", clockLayout: "3:04 PM"},
+	"en-GB": {dateLayout: "Monday 2 January 2006", clockLayout: "15:04"},
+	"eu":    {dateLayout: "Mon 02 Jan 2006", clockLayout: "15:04"},
+	"iso":   {dateLayout: "2006-01-02", clockLayout: "15:04"},
+}
+
+// loadDateFormat resolves the configured locale, letting
+// GOOGLE_CALENDAR_DATE_FORMAT override the date layout.
+func loadDateFormat() (dateFormat, error) {
+	cfg, err := loadCalendarSettings()
+	if err != nil {
+		return dateFormat{}, err
+	}
+	return newDateFormat(cfg.Locale, cfg.DateFormat)
+}
+
+func newDateFormat(locale, dateLayout string) (dateFormat, error) {
+	f, ok := localeFormats[locale]
+	if !ok {
+		known := make([]string, 0, len(localeFormats))
+		for name := range localeFormats {
+			known = append(known, name)
+		}
+		sort.Strings(known)
+		return dateFormat{}, fmt.Errorf("unknown locale %q (expected one of %s)", locale, strings.Join(known, ", "))
+	}
+	if dateLayout != "" {
+		f.dateLayout = dateLayout
+	}
+	return f, nil
+}
+
+// date formats the calendar day of t.
+func (f dateFormat) date(t time.Time) string {
+	return t.Format(f.dateLayout)
+}
+
+// clock formats the time of day of t.
+func (f dateFormat) clock(t time.Time) string {
+	return t.Format(f.clockLayout)
+}
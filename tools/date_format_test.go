@@ -0,0 +1,75 @@
+package tools
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestLoadDateFormat(t *testing.T) {
+	at := time.Date(2026, 1, 2, 15, 4, 0, 0, time.UTC)
+
+	tests := []struct {
+		name       string
+		locale     string
+		dateLayout string
+		wantDate   string
+		wantClock  string
+		wantErrSub string
+	}{
+		{
+			name:      "default locale",
+			locale:    "en",
+			wantDate:  "Friday, January 2 2026",
+			wantClock: "15:04",
+		},
+		{
+			name:      "en-US uses a 12-hour clock",
+			locale:    "en-US",
+			wantDate:  "Friday, January 2 2026",
+			wantClock: "3:04 PM",
+		},
+		{
+			name:      "en-GB puts the day first",
+			locale:    "en-GB",
+			wantDate:  "Friday 2 January 2026",
+			wantClock: "15:04",
+		},
+		{
+			name:       "date format overrides the locale layout",
+			locale:     "eu",
+			dateLayout: "Mon 02 Jan",
+			wantDate:   "Fri 02 Jan",
+			wantClock:  "15:04",
+		},
+		{
+			name:       "unknown locale is rejected",
+			locale:     "fr-FR",
+			wantErrSub: `unknown locale "fr-FR"`,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Setenv("GOOGLE_CALENDAR_LOCALE", tc.locale)
+			t.Setenv("GOOGLE_CALENDAR_DATE_FORMAT", tc.dateLayout)
+
+			format, err := loadDateFormat()
+			if tc.wantErrSub != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErrSub) {
+					t.Fatalf("error = %v, want substring %q", err, tc.wantErrSub)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := format.date(at); got != tc.wantDate {
+				t.Errorf("date = %q, want %q", got, tc.wantDate)
+			}
+			if got := format.clock(at); got != tc.wantClock {
+				t.Errorf("clock = %q, want %q", got, tc.wantClock)
+			}
+		})
+	}
+}
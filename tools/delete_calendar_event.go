@@ -12,6 +12,13 @@ import (
 	google "github.com/inference-gateway/google-calendar-agent/internal/google"
 )
 
+// Delete modes: hard deletion, or marking the event cancelled so a record
+// stays behind.
+const (
+	deleteModeDelete = "delete"
+	deleteModeCancel = "cancel"
+)
+
 // DeleteCalendarEventTool struct holds the tool with dependencies
 type DeleteCalendarEventTool struct {
 	logger *zap.Logger
@@ -34,6 +41,12 @@ func NewDeleteCalendarEventTool(logger *zap.Logger, google google.CalendarServic
 					"description": "Event ID to delete (required)",
 					"type":        "string",
 				},
+				"mode": map[string]any{
+					"description": "'delete' removes the event; 'cancel' marks it cancelled instead, keeping a record that is still returned with showDeleted. Defaults to 'delete'.",
+					"enum":        []string{deleteModeDelete, deleteModeCancel},
+					"type":        "string",
+				},
+				"scope": scopeSchema,
 			},
 			"required": []string{"eventId"},
 		},
@@ -52,19 +65,46 @@ func (s *DeleteCalendarEventTool) DeleteCalendarEventHandler(ctx context.Context
 		return "", fmt.Errorf("eventId is required")
 	}
 
+	scope, err := parseEventScope(args)
+	if err != nil {
+		return "", err
+	}
+
+	mode := deleteModeDelete
+	if v, exists := args["mode"]; exists && v != nil {
+		m, ok := v.(string)
+		if !ok {
+			return "", fmt.Errorf("mode must be a string, got %T", v)
+		}
+		if m != deleteModeDelete && m != deleteModeCancel {
+			return "", fmt.Errorf("mode must be one of %s or %s, got %q", deleteModeDelete, deleteModeCancel, m)
+		}
+		mode = m
+	}
+	if mode == deleteModeCancel && scope == scopeFollowing {
+		return "", fmt.Errorf("mode %s does not support scope %s; use %s or %s", deleteModeCancel, scopeFollowing, scopeInstance, scopeAll)
+	}
+
 	calendarID := s.google.GetCalendarID()
-	err := s.google.DeleteEvent(calendarID, eventID)
+	deletedID, err := s.deleteInScope(ctx, calendarID, eventID, scope, mode)
 	if err != nil {
-		s.logger.Error("failed to delete calendar event", zap.Error(err), zap.String("eventId", eventID))
-		return "", fmt.Errorf("failed to delete calendar event: %w", err)
+		label := calendarLabel(ctx, s.google, calendarID)
+		s.logger.Error("failed to delete calendar event", zap.Error(err), zap.String("eventId", eventID), zap.String("scope", scope), zap.String("calendar", label))
+		return "", fmt.Errorf("failed to delete calendar event on '%s': %w", label, err)
 	}
 
-	s.logger.Info("calendar event deleted successfully", zap.String("eventId", eventID))
+	s.logger.Info("calendar event deleted successfully", zap.String("eventId", deletedID), zap.String("scope", scope), zap.String("mode", mode))
 
+	message := "Event deleted successfully"
+	if mode == deleteModeCancel {
+		message = "Event cancelled; it is kept and still returned with showDeleted"
+	}
 	result := map[string]any{
 		"success": true,
-		"eventId": eventID,
-		"message": "Event deleted successfully",
+		"eventId": deletedID,
+		"scope":   scope,
+		"mode":    mode,
+		"message": message,
 	}
 
 	resultJSON, err := json.Marshal(result)
@@ -74,3 +114,57 @@ func (s *DeleteCalendarEventTool) DeleteCalendarEventHandler(ctx context.Context
 
 	return string(resultJSON), nil
 }
+
+// deleteInScope deletes eventID, its whole series, or the series from this
+// occurrence onwards, and returns the ID of the event that was removed or
+// truncated. In cancel mode events are marked cancelled rather than deleted.
+func (s *DeleteCalendarEventTool) deleteInScope(ctx context.Context, calendarID, eventID, scope, mode string) (string, error) {
+	remove := s.google.DeleteEvent
+	if mode == deleteModeCancel {
+		remove = s.cancelEvent
+	}
+
+	switch scope {
+	case scopeAll:
+		event, err := s.google.GetEvent(ctx, calendarID, eventID)
+		if err != nil {
+			return "", err
+		}
+		if event.RecurringEventId != "" {
+			eventID = event.RecurringEventId
+		}
+		return eventID, remove(ctx, calendarID, eventID)
+	case scopeFollowing:
+		occurrence, err := getSeriesOccurrence(ctx, s.google, calendarID, eventID)
+		if err != nil {
+			return "", err
+		}
+		masterID := occurrence.master.Id
+		if occurrence.isFirst() {
+			return masterID, remove(ctx, calendarID, masterID)
+		}
+		recurrence, err := truncateRecurrence(occurrence.master.Recurrence, occurrence.instance.OriginalStartTime)
+		if err != nil {
+			return "", err
+		}
+		occurrence.master.Recurrence = recurrence
+		if _, err := s.google.UpdateEvent(ctx, calendarID, masterID, occurrence.master); err != nil {
+			return "", err
+		}
+		return masterID, nil
+	default:
+		return eventID, remove(ctx, calendarID, eventID)
+	}
+}
+
+// cancelEvent marks an event cancelled through an update, which Google keeps
+// as a record instead of erasing it.
+func (s *DeleteCalendarEventTool) cancelEvent(ctx context.Context, calendarID, eventID string) error {
+	event, err := s.google.GetEvent(ctx, calendarID, eventID)
+	if err != nil {
+		return err
+	}
+	event.Status = "cancelled"
+	_, err = s.google.UpdateEvent(ctx, calendarID, eventID, event)
+	return err
+}
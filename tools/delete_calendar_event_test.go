@@ -8,6 +8,10 @@ import (
 	"testing"
 
 	zap "go.uber.org/zap"
+	calendar "google.golang.org/api/calendar/v3"
+
+	config "github.com/inference-gateway/google-calendar-agent/config"
+	google "github.com/inference-gateway/google-calendar-agent/internal/google"
 )
 
 func TestDeleteCalendarEventHandler(t *testing.T) {
@@ -91,3 +95,233 @@ func TestDeleteCalendarEventHandler(t *testing.T) {
 		})
 	}
 }
+
+// weeklySeries returns a weekly standup master and its third occurrence.
+func weeklySeries() (master, instance *calendar.Event) {
+	master = &calendar.Event{
+		Id:         "standup",
+		Summary:    "Standup",
+		Recurrence: []string{"RRULE:FREQ=WEEKLY;COUNT=10", "EXDATE:20260511T090000Z"},
+		Start:      &calendar.EventDateTime{DateTime: "2026-05-04T09:00:00Z"},
+		End:        &calendar.EventDateTime{DateTime: "2026-05-04T09:15:00Z"},
+	}
+	instance = &calendar.Event{
+		Id:                "standup_20260518T090000Z",
+		Summary:           "Standup",
+		RecurringEventId:  "standup",
+		OriginalStartTime: &calendar.EventDateTime{DateTime: "2026-05-18T09:00:00Z"},
+		Start:             &calendar.EventDateTime{DateTime: "2026-05-18T09:00:00Z"},
+		End:               &calendar.EventDateTime{DateTime: "2026-05-18T09:15:00Z"},
+	}
+	return master, instance
+}
+
+func TestDeleteCalendarEventScope(t *testing.T) {
+	tests := []struct {
+		name           string
+		scope          any
+		eventID        string
+		wantDeleted    string
+		wantRecurrence []string
+		wantErrSub     string
+	}{
+		{
+			name:        "instance deletes only the occurrence",
+			scope:       "instance",
+			eventID:     "standup_20260518T090000Z",
+			wantDeleted: "standup_20260518T090000Z",
+		},
+		{
+			name:        "default scope is instance",
+			eventID:     "standup_20260518T090000Z",
+			wantDeleted: "standup_20260518T090000Z",
+		},
+		{
+			name:        "all deletes the recurring master",
+			scope:       "all",
+			eventID:     "standup_20260518T090000Z",
+			wantDeleted: "standup",
+		},
+		{
+			name:           "following ends the series before the occurrence",
+			scope:          "following",
+			eventID:        "standup_20260518T090000Z",
+			wantRecurrence: []string{"RRULE:FREQ=WEEKLY;UNTIL=20260518T085959Z", "EXDATE:20260511T090000Z"},
+		},
+		{
+			name:       "following requires a recurring occurrence",
+			scope:      "following",
+			eventID:    "standup",
+			wantErrSub: "not an occurrence of a recurring event",
+		},
+		{
+			name:       "unknown scope is rejected",
+			scope:      "series",
+			eventID:    "standup",
+			wantErrSub: "scope must be one of",
+		},
+		{
+			name:       "wrong-typed scope is rejected",
+			scope:      true,
+			eventID:    "standup",
+			wantErrSub: "scope must be a string",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			master, instance := weeklySeries()
+			var deleted string
+			var updated *calendar.Event
+			stub := &stubCalendarService{
+				getEventFn: func(calendarID, eventID string) (*calendar.Event, error) {
+					switch eventID {
+					case master.Id:
+						return master, nil
+					case instance.Id:
+						return instance, nil
+					}
+					return nil, errors.New("not found")
+				},
+				deleteEventFn: func(calendarID, eventID string) error {
+					deleted = eventID
+					return nil
+				},
+				updateEventFn: func(calendarID, eventID string, event *calendar.Event) (*calendar.Event, error) {
+					if eventID != master.Id {
+						t.Errorf("updated %s, want the master %s", eventID, master.Id)
+					}
+					updated = event
+					return event, nil
+				},
+			}
+			args := map[string]any{"eventId": tc.eventID}
+			if tc.scope != nil {
+				args["scope"] = tc.scope
+			}
+			tool := &DeleteCalendarEventTool{logger: zap.NewNop(), google: stub}
+			_, err := tool.DeleteCalendarEventHandler(context.Background(), args)
+
+			if tc.wantErrSub != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErrSub) {
+					t.Fatalf("error = %v, want substring %q", err, tc.wantErrSub)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if deleted != tc.wantDeleted {
+				t.Errorf("deleted = %q, want %q", deleted, tc.wantDeleted)
+			}
+			if tc.wantRecurrence != nil {
+				if updated == nil {
+					t.Fatal("master was not updated")
+				}
+				if strings.Join(updated.Recurrence, "\n") != strings.Join(tc.wantRecurrence, "\n") {
+					t.Errorf("recurrence = %v, want %v", updated.Recurrence, tc.wantRecurrence)
+				}
+			}
+		})
+	}
+}
+
+func TestDeleteCalendarEventFollowingFromFirstOccurrence(t *testing.T) {
+	master, instance := weeklySeries()
+	instance.OriginalStartTime = master.Start
+
+	var deleted string
+	stub := &stubCalendarService{
+		getEventFn: func(calendarID, eventID string) (*calendar.Event, error) {
+			if eventID == master.Id {
+				return master, nil
+			}
+			return instance, nil
+		},
+		deleteEventFn: func(calendarID, eventID string) error {
+			deleted = eventID
+			return nil
+		},
+	}
+	tool := &DeleteCalendarEventTool{logger: zap.NewNop(), google: stub}
+	if _, err := tool.DeleteCalendarEventHandler(context.Background(), map[string]any{"eventId": instance.Id, "scope": "following"}); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if deleted != master.Id {
+		t.Errorf("deleted = %q, want the whole series %q", deleted, master.Id)
+	}
+}
+
+func TestDeleteCalendarEventMode(t *testing.T) {
+	tests := []struct {
+		name       string
+		mode       any
+		wantStatus string
+		wantGone   bool
+		wantErrSub string
+	}{
+		{name: "default hard deletes", wantGone: true},
+		{name: "delete removes the event", mode: "delete", wantGone: true},
+		{name: "cancel keeps a cancelled record", mode: "cancel", wantStatus: "cancelled"},
+		{name: "unknown mode", mode: "archive", wantErrSub: "mode must be one of delete or cancel"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			svc := google.NewInMemoryCalendarService(zap.NewNop(), &config.Config{})
+			created, err := svc.CreateEvent(context.Background(), "primary", &calendar.Event{
+				Summary: "Retro",
+				Start:   &calendar.EventDateTime{DateTime: "2026-05-20T10:00:00Z"},
+				End:     &calendar.EventDateTime{DateTime: "2026-05-20T11:00:00Z"},
+			})
+			if err != nil {
+				t.Fatalf("create: %v", err)
+			}
+
+			args := map[string]any{"eventId": created.Id}
+			if tc.mode != nil {
+				args["mode"] = tc.mode
+			}
+			tool := &DeleteCalendarEventTool{logger: zap.NewNop(), google: svc}
+			result, err := tool.DeleteCalendarEventHandler(context.Background(), args)
+			if tc.wantErrSub != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErrSub) {
+					t.Fatalf("error = %v, want substring %q", err, tc.wantErrSub)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !strings.Contains(result, `"success":true`) {
+				t.Errorf("result = %s, want success", result)
+			}
+
+			event, err := svc.GetEvent(context.Background(), "primary", created.Id)
+			if tc.wantGone {
+				if err == nil {
+					t.Errorf("event still exists with status %q, want it deleted", event.Status)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("event is gone, want it kept: %v", err)
+			}
+			if event.Status != tc.wantStatus {
+				t.Errorf("status = %q, want %q", event.Status, tc.wantStatus)
+			}
+		})
+	}
+}
+
+func TestDeleteCalendarEventCancelRejectsFollowing(t *testing.T) {
+	tool := &DeleteCalendarEventTool{logger: zap.NewNop(), google: &stubCalendarService{}}
+	_, err := tool.DeleteCalendarEventHandler(context.Background(), map[string]any{
+		"eventId": "standup_20260518T090000Z",
+		"mode":    "cancel",
+		"scope":   "following",
+	})
+	if err == nil || !strings.Contains(err.Error(), "does not support scope following") {
+		t.Errorf("error = %v, want scope following to be rejected", err)
+	}
+}
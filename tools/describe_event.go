@@ -0,0 +1,171 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	zap "go.uber.org/zap"
+	calendar "google.golang.org/api/calendar/v3"
+
+	server "github.com/inference-gateway/adk/server"
+
+	google "github.com/inference-gateway/google-calendar-agent/internal/google"
+)
+
+// DescribeEventTool struct holds the tool with dependencies
+type DescribeEventTool struct {
+	logger *zap.Logger
+	google google.CalendarService
+}
+
+// NewDescribeEventTool creates a new describe_event tool
+func NewDescribeEventTool(logger *zap.Logger, google google.CalendarService) server.Tool {
+	tool := &DescribeEventTool{
+		logger: logger,
+		google: google,
+	}
+	return server.NewBasicTool(
+		"describe_event",
+		"Describe an event in one readable sentence: when and where it is, who is invited and how many have accepted",
+		map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"eventId": map[string]any{
+					"description": "Event ID to describe (required)",
+					"type":        "string",
+				},
+			},
+			"required": []string{"eventId"},
+		},
+		tool.DescribeEventHandler,
+	)
+}
+
+// DescribeEventHandler handles the describe_event tool execution
+func (s *DescribeEventTool) DescribeEventHandler(ctx context.Context, args map[string]any) (string, error) {
+	span := startToolSpan(ctx, "describe_event")
+	defer span.End()
+	s.logger.Debug("describing calendar event", zap.Any("args", args))
+
+	eventID, ok := args["eventId"].(string)
+	if !ok || eventID == "" {
+		return "", fmt.Errorf("eventId is required")
+	}
+
+	format, err := loadDateFormat()
+	if err != nil {
+		return "", err
+	}
+
+	calendarID := s.google.GetCalendarID()
+	event, err := s.google.GetEvent(ctx, calendarID, eventID)
+	if err != nil {
+		s.logger.Error("failed to get calendar event", zap.Error(err), zap.String("eventId", eventID))
+		return "", fmt.Errorf("failed to get calendar event on '%s': %w", calendarLabel(ctx, s.google, calendarID), err)
+	}
+
+	loc, _ := calendarTimezone(ctx, s.google, calendarID)
+	responses := countAttendeeResponses(event)
+
+	s.logger.Info("calendar event described", zap.String("eventId", event.Id))
+
+	result := map[string]any{
+		"success":   true,
+		"eventId":   event.Id,
+		"summary":   event.Summary,
+		"text":      describeEvent(event, time.Now(), loc, format),
+		"responses": responses,
+	}
+
+	resultJSON, err := json.Marshal(result)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal result: %w", err)
+	}
+
+	return string(resultJSON), nil
+}
+
+// describeEvent renders the event as a sentence such as "Your 'Weekly
+// Standup' is tomorrow from 09:00 to 09:30 in Room A with 4 attendees; 2
+// have accepted." Days near now are named relative to it.
+func describeEvent(event *calendar.Event, now time.Time, loc *time.Location, format dateFormat) string {
+	var b strings.Builder
+	if event.Summary != "" {
+		fmt.Fprintf(&b, "Your '%s'", event.Summary)
+	} else {
+		b.WriteString("Your untitled event")
+	}
+
+	if start, end, ok := eventTimes(event, loc); ok {
+		verb := "is"
+		if end.Before(now) {
+			verb = "was"
+		}
+		fmt.Fprintf(&b, " %s %s", verb, describeDay(start, now, loc, format))
+		if event.Start.DateTime == "" {
+			b.WriteString(" (all day)")
+		} else {
+			fmt.Fprintf(&b, " from %s to %s", format.clock(start), format.clock(end))
+		}
+	}
+
+	if event.Location != "" {
+		fmt.Fprintf(&b, " in %s", event.Location)
+	}
+
+	if responses := countAttendeeResponses(event); responses.Total > 0 {
+		fmt.Fprintf(&b, " with %d %s; %s", responses.Total, plural(responses.Total, "attendee", "attendees"), responses.summary())
+	}
+
+	b.WriteString(".")
+	return b.String()
+}
+
+// eventTimes returns the event's start and end in loc. All-day events start
+// and end at midnight.
+func eventTimes(event *calendar.Event, loc *time.Location) (time.Time, time.Time, bool) {
+	if event.Start == nil || event.End == nil {
+		return time.Time{}, time.Time{}, false
+	}
+	if event.Start.DateTime == "" {
+		start, err := time.ParseInLocation("2006-01-02", event.Start.Date, loc)
+		if err != nil {
+			return time.Time{}, time.Time{}, false
+		}
+		end, err := time.ParseInLocation("2006-01-02", event.End.Date, loc)
+		if err != nil {
+			end = start.AddDate(0, 0, 1)
+		}
+		return start, end, true
+	}
+	start, err := time.Parse(time.RFC3339, event.Start.DateTime)
+	if err != nil {
+		return time.Time{}, time.Time{}, false
+	}
+	end, err := time.Parse(time.RFC3339, event.End.DateTime)
+	if err != nil {
+		end = start
+	}
+	return start.In(loc), end.In(loc), true
+}
+
+// describeDay names t's day as today, tomorrow or yesterday relative to
+// now, and as "on <date>" otherwise.
+func describeDay(t, now time.Time, loc *time.Location, format dateFormat) string {
+	now = now.In(loc)
+	day := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	switch int(day.Sub(today).Hours() / 24) {
+	case 0:
+		return "today"
+	case 1:
+		return "tomorrow"
+	case -1:
+		return "yesterday"
+	default:
+		return "on " + format.date(t)
+	}
+}
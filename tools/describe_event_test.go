@@ -0,0 +1,134 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	zap "go.uber.org/zap"
+	calendar "google.golang.org/api/calendar/v3"
+)
+
+func TestDescribeEvent(t *testing.T) {
+	format, err := newDateFormat("en", "")
+	if err != nil {
+		t.Fatalf("newDateFormat: %v", err)
+	}
+	now := time.Date(2026, 5, 19, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name  string
+		event *calendar.Event
+		want  string
+	}{
+		{
+			name: "attendees and location",
+			event: &calendar.Event{
+				Summary:  "Weekly Standup",
+				Location: "Room A",
+				Start:    &calendar.EventDateTime{DateTime: "2026-05-20T09:00:00Z"},
+				End:      &calendar.EventDateTime{DateTime: "2026-05-20T09:30:00Z"},
+				Attendees: []*calendar.EventAttendee{
+					{Email: "a@example.com", ResponseStatus: "accepted"},
+					{Email: "b@example.com", ResponseStatus: "accepted"},
+					{Email: "c@example.com", ResponseStatus: "needsAction"},
+					{Email: "d@example.com", ResponseStatus: "needsAction"},
+					{Email: "room-a@resource.example.com", Resource: true, ResponseStatus: "accepted"},
+				},
+			},
+			want: "Your 'Weekly Standup' is tomorrow from 09:00 to 09:30 in Room A with 4 attendees; 2 have accepted, 2 have not replied.",
+		},
+		{
+			name: "no attendees or location",
+			event: &calendar.Event{
+				Summary: "Focus",
+				Start:   &calendar.EventDateTime{DateTime: "2026-05-19T14:00:00Z"},
+				End:     &calendar.EventDateTime{DateTime: "2026-05-19T16:00:00Z"},
+			},
+			want: "Your 'Focus' is today from 14:00 to 16:00.",
+		},
+		{
+			name: "mixed responses further out",
+			event: &calendar.Event{
+				Summary: "Planning",
+				Start:   &calendar.EventDateTime{DateTime: "2026-05-25T10:00:00Z"},
+				End:     &calendar.EventDateTime{DateTime: "2026-05-25T11:00:00Z"},
+				Attendees: []*calendar.EventAttendee{
+					{Email: "a@example.com", ResponseStatus: "accepted"},
+					{Email: "b@example.com", ResponseStatus: "tentative"},
+					{Email: "c@example.com", ResponseStatus: "declined"},
+				},
+			},
+			want: "Your 'Planning' is on Monday, May 25 2026 from 10:00 to 11:00 with 3 attendees; 1 has accepted, 1 is tentative, 1 declined.",
+		},
+		{
+			name: "past all-day event nobody answered",
+			event: &calendar.Event{
+				Summary:   "Offsite",
+				Location:  "Lisbon",
+				Start:     &calendar.EventDateTime{Date: "2026-05-18"},
+				End:       &calendar.EventDateTime{Date: "2026-05-19"},
+				Attendees: []*calendar.EventAttendee{{Email: "a@example.com", ResponseStatus: "needsAction"}},
+			},
+			want: "Your 'Offsite' was yesterday (all day) in Lisbon with 1 attendee; nobody has replied yet.",
+		},
+		{
+			name:  "untitled without times",
+			event: &calendar.Event{},
+			want:  "Your untitled event.",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := describeEvent(tc.event, now, time.UTC, format); got != tc.want {
+				t.Errorf("describeEvent =\n  %q\nwant\n  %q", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestDescribeEventHandler(t *testing.T) {
+	t.Setenv("GOOGLE_CALENDAR_TIMEZONE", "UTC")
+	t.Setenv("TZ", "")
+
+	stub := &stubCalendarService{
+		getEventFn: func(calendarID, eventID string) (*calendar.Event, error) {
+			return &calendar.Event{
+				Id:      eventID,
+				Summary: "Review",
+				Start:   &calendar.EventDateTime{DateTime: "2026-05-20T09:00:00Z"},
+				End:     &calendar.EventDateTime{DateTime: "2026-05-20T10:00:00Z"},
+				Attendees: []*calendar.EventAttendee{
+					{Email: "a@example.com", ResponseStatus: "accepted"},
+					{Email: "b@example.com", ResponseStatus: "declined"},
+				},
+			}, nil
+		},
+	}
+	tool := &DescribeEventTool{logger: zap.NewNop(), google: stub}
+	result, err := tool.DescribeEventHandler(context.Background(), map[string]any{"eventId": "evt-1"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var parsed struct {
+		EventID   string            `json:"eventId"`
+		Text      string            `json:"text"`
+		Responses attendeeResponses `json:"responses"`
+	}
+	if err := json.Unmarshal([]byte(result), &parsed); err != nil {
+		t.Fatalf("failed to unmarshal result: %v", err)
+	}
+	if parsed.EventID != "evt-1" || parsed.Text == "" {
+		t.Errorf("result = %+v, want eventId evt-1 and a description", parsed)
+	}
+	if want := (attendeeResponses{Total: 2, Accepted: 1, Declined: 1}); parsed.Responses != want {
+		t.Errorf("responses = %+v, want %+v", parsed.Responses, want)
+	}
+
+	if _, err := tool.DescribeEventHandler(context.Background(), map[string]any{}); err == nil {
+		t.Error("expected an error without eventId")
+	}
+}
@@ -0,0 +1,81 @@
+package tools
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	calendar "google.golang.org/api/calendar/v3"
+)
+
+// loadDescriptionTemplates reads the named description templates from
+// GOOGLE_CALENDAR_DESCRIPTION_TEMPLATES_FILE, a JSON object mapping each
+// name to its text. No file means no templates.
+func loadDescriptionTemplates() (map[string]string, error) {
+	cfg, err := loadCalendarSettings()
+	if err != nil {
+		return nil, err
+	}
+	if cfg.DescriptionTemplatesFile == "" {
+		return map[string]string{}, nil
+	}
+	data, err := os.ReadFile(cfg.DescriptionTemplatesFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read GOOGLE_CALENDAR_DESCRIPTION_TEMPLATES_FILE: %w", err)
+	}
+	templates := map[string]string{}
+	if err := json.Unmarshal(data, &templates); err != nil {
+		return nil, fmt.Errorf("invalid GOOGLE_CALENDAR_DESCRIPTION_TEMPLATES_FILE (expected a JSON object of name to text): %w", err)
+	}
+	return templates, nil
+}
+
+// applyDescriptionTemplate sets the event description from the template
+// named by the template argument, replacing {{summary}} with the requested
+// title, before any configured prefix or suffix, and {{date}} with the start
+// date in loc.
+func applyDescriptionTemplate(event *calendar.Event, args map[string]any, loc *time.Location) error {
+	v, exists := args["template"]
+	if !exists || v == nil {
+		return nil
+	}
+	name, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("template must be a string, got %T", v)
+	}
+	if desc, set := args["description"]; set && desc != nil {
+		return fmt.Errorf("description and template cannot both be set")
+	}
+
+	templates, err := loadDescriptionTemplates()
+	if err != nil {
+		return err
+	}
+	text, ok := templates[name]
+	if !ok {
+		if len(templates) == 0 {
+			return fmt.Errorf("unknown template %q (no description templates are configured)", name)
+		}
+		names := make([]string, 0, len(templates))
+		for n := range templates {
+			names = append(names, n)
+		}
+		sort.Strings(names)
+		return fmt.Errorf("unknown template %q (expected one of %s)", name, strings.Join(names, ", "))
+	}
+
+	format, err := loadDateFormat()
+	if err != nil {
+		return err
+	}
+	date := ""
+	if start, _, ok := eventTimes(event, loc); ok {
+		date = format.date(start)
+	}
+	summary, _ := args["summary"].(string)
+	event.Description = strings.NewReplacer("{{summary}}", summary, "{{date}}", date).Replace(text)
+	return nil
+}
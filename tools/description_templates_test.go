@@ -0,0 +1,136 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	zap "go.uber.org/zap"
+	calendar "google.golang.org/api/calendar/v3"
+)
+
+func TestCreateCalendarEventDescriptionTemplate(t *testing.T) {
+	t.Setenv("GOOGLE_CALENDAR_TIMEZONE", "UTC")
+	t.Setenv("TZ", "")
+	disableConflictCheck(t)
+	t.Setenv("GOOGLE_CALENDAR_EVENT_TITLE_PREFIX", "[AI]")
+
+	path := filepath.Join(t.TempDir(), "templates.json")
+	templates := `{
+		"standup": "Agenda:\n- \nNotes:\n",
+		"retro": "{{summary}} on {{date}}\nWhat went well:\n- "
+	}`
+	if err := os.WriteFile(path, []byte(templates), 0o600); err != nil {
+		t.Fatalf("write templates: %v", err)
+	}
+
+	tests := []struct {
+		name            string
+		templatesFile   string
+		locale          string
+		args            map[string]any
+		wantDescription string
+		wantErrSub      string
+	}{
+		{
+			name:            "plain template",
+			templatesFile:   path,
+			args:            map[string]any{"template": "standup"},
+			wantDescription: "Agenda:\n- \nNotes:\n",
+		},
+		{
+			name:            "summary and date are substituted",
+			templatesFile:   path,
+			locale:          "iso",
+			args:            map[string]any{"template": "retro"},
+			wantDescription: "Sprint 12 on 2026-05-18\nWhat went well:\n- ",
+		},
+		{
+			name:            "date follows the locale",
+			templatesFile:   path,
+			locale:          "en",
+			args:            map[string]any{"template": "retro"},
+			wantDescription: "Sprint 12 on Monday, May 18 2026\nWhat went well:\n- ",
+		},
+		{
+			name:          "unknown template",
+			templatesFile: path,
+			args:          map[string]any{"template": "planning"},
+			wantErrSub:    `unknown template "planning" (expected one of retro, standup)`,
+		},
+		{
+			name:       "no templates configured",
+			args:       map[string]any{"template": "standup"},
+			wantErrSub: "no description templates are configured",
+		},
+		{
+			name:          "template and description together",
+			templatesFile: path,
+			args:          map[string]any{"template": "standup", "description": "Notes"},
+			wantErrSub:    "description and template cannot both be set",
+		},
+		{
+			name:          "unreadable templates file",
+			templatesFile: filepath.Join(t.TempDir(), "missing.json"),
+			args:          map[string]any{"template": "standup"},
+			wantErrSub:    "failed to read GOOGLE_CALENDAR_DESCRIPTION_TEMPLATES_FILE",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Setenv("GOOGLE_CALENDAR_DESCRIPTION_TEMPLATES_FILE", tc.templatesFile)
+			locale := tc.locale
+			if locale == "" {
+				locale = "en"
+			}
+			t.Setenv("GOOGLE_CALENDAR_LOCALE", locale)
+
+			var created *calendar.Event
+			stub := &stubCalendarService{
+				createEventFn: func(calendarID string, event *calendar.Event) (*calendar.Event, error) {
+					created = event
+					event.Id = "evt-created"
+					return event, nil
+				},
+			}
+			args := map[string]any{
+				"summary":   "Sprint 12",
+				"startTime": "2026-05-18T10:00:00Z",
+				"endTime":   "2026-05-18T11:00:00Z",
+			}
+			for k, v := range tc.args {
+				args[k] = v
+			}
+			tool := &CreateCalendarEventTool{logger: zap.NewNop(), google: stub}
+			result, err := tool.CreateCalendarEventHandler(context.Background(), args)
+
+			if tc.wantErrSub != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErrSub) {
+					t.Fatalf("error = %v, want substring %q", err, tc.wantErrSub)
+				}
+				if created != nil {
+					t.Errorf("event was created despite the error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if created.Description != tc.wantDescription {
+				t.Errorf("description = %q, want %q", created.Description, tc.wantDescription)
+			}
+
+			var parsed map[string]any
+			if err := json.Unmarshal([]byte(result), &parsed); err != nil {
+				t.Fatalf("failed to unmarshal result: %v", err)
+			}
+			if parsed["description"] != tc.wantDescription {
+				t.Errorf("result description = %v, want %q", parsed["description"], tc.wantDescription)
+			}
+		})
+	}
+}
@@ -0,0 +1,29 @@
+package tools
+
+import (
+	calendar "google.golang.org/api/calendar/v3"
+)
+
+// attachmentPreviews summarises the files attached to event as
+// {title, fileUrl} pairs, or returns nil when it has none. Attachments
+// without a URL are skipped; a missing title falls back to the URL.
+func attachmentPreviews(event *calendar.Event) []map[string]string {
+	if event == nil {
+		return nil
+	}
+	var previews []map[string]string
+	for _, attachment := range event.Attachments {
+		if attachment == nil || attachment.FileUrl == "" {
+			continue
+		}
+		title := attachment.Title
+		if title == "" {
+			title = attachment.FileUrl
+		}
+		previews = append(previews, map[string]string{
+			"title":   title,
+			"fileUrl": attachment.FileUrl,
+		})
+	}
+	return previews
+}
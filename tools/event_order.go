@@ -0,0 +1,31 @@
+package tools
+
+import (
+	"sort"
+	"time"
+
+	calendar "google.golang.org/api/calendar/v3"
+)
+
+// calendarEvent is an event together with the calendar it was listed from,
+// for results merged across calendars.
+type calendarEvent struct {
+	calendarID string
+	event      *calendar.Event
+}
+
+// sortCalendarEvents orders events by start time, then calendar ID, then
+// event ID, so merged listings come out the same on every call even when
+// several events start at once.
+func sortCalendarEvents(events []calendarEvent, loc *time.Location) {
+	sort.Slice(events, func(i, j int) bool {
+		a, b := events[i], events[j]
+		if startA, startB := eventStart(a.event, loc), eventStart(b.event, loc); !startA.Equal(startB) {
+			return startA.Before(startB)
+		}
+		if a.calendarID != b.calendarID {
+			return a.calendarID < b.calendarID
+		}
+		return a.event.Id < b.event.Id
+	})
+}
@@ -0,0 +1,39 @@
+package tools
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	calendar "google.golang.org/api/calendar/v3"
+)
+
+func TestSortCalendarEvents(t *testing.T) {
+	at := func(id, start string) *calendar.Event {
+		return &calendar.Event{Id: id, Start: &calendar.EventDateTime{DateTime: start}}
+	}
+	allDay := &calendar.Event{Id: "holiday", Start: &calendar.EventDateTime{Date: "2026-05-18"}}
+
+	input := []calendarEvent{
+		{calendarID: "work", event: at("b", "2026-05-18T09:00:00Z")},
+		{calendarID: "team", event: at("z", "2026-05-18T09:00:00Z")},
+		{calendarID: "work", event: at("a", "2026-05-18T09:00:00Z")},
+		{calendarID: "primary", event: at("late", "2026-05-18T10:00:00Z")},
+		{calendarID: "work", event: allDay},
+		{calendarID: "primary", event: at("same", "2026-05-18T09:00:00Z")},
+	}
+	want := "work/holiday primary/same team/z work/a work/b primary/late"
+
+	// Every starting order must produce the same result.
+	for shift := range input {
+		events := append(append([]calendarEvent{}, input[shift:]...), input[:shift]...)
+		sortCalendarEvents(events, time.UTC)
+		var got []string
+		for _, e := range events {
+			got = append(got, e.calendarID+"/"+e.event.Id)
+		}
+		if strings.Join(got, " ") != want {
+			t.Errorf("shift %d: order = %s, want %s", shift, strings.Join(got, " "), want)
+		}
+	}
+}
@@ -0,0 +1,25 @@
+package tools
+
+import "strings"
+
+// decorateTitle adds the configured GOOGLE_CALENDAR_EVENT_TITLE_PREFIX and
+// _SUFFIX to summary, separated by a space. A summary that already carries
+// them is left alone, so renaming an agent-created event keeps a single tag.
+func decorateTitle(summary string) (string, error) {
+	cfg, err := loadCalendarSettings()
+	if err != nil {
+		return "", err
+	}
+	return decorateTitleWith(summary, cfg.EventTitlePrefix, cfg.EventTitleSuffix), nil
+}
+
+func decorateTitleWith(summary, prefix, suffix string) string {
+	summary = strings.TrimSpace(summary)
+	if prefix = strings.TrimSpace(prefix); prefix != "" && !strings.HasPrefix(summary, prefix) {
+		summary = prefix + " " + summary
+	}
+	if suffix = strings.TrimSpace(suffix); suffix != "" && !strings.HasSuffix(summary, suffix) {
+		summary = summary + " " + suffix
+	}
+	return summary
+}
@@ -0,0 +1,95 @@
+package tools
+
+import (
+	"context"
+	"testing"
+
+	zap "go.uber.org/zap"
+	calendar "google.golang.org/api/calendar/v3"
+)
+
+func TestDecorateTitleWith(t *testing.T) {
+	tests := []struct {
+		name    string
+		summary string
+		prefix  string
+		suffix  string
+		want    string
+	}{
+		{name: "no tags configured", summary: "Standup", want: "Standup"},
+		{name: "prefix", summary: "Standup", prefix: "[AI]", want: "[AI] Standup"},
+		{name: "suffix", summary: "Standup", suffix: "(via agent)", want: "Standup (via agent)"},
+		{name: "prefix and suffix", summary: "Standup", prefix: "[AI]", suffix: "(via agent)", want: "[AI] Standup (via agent)"},
+		{name: "configured whitespace is ignored", summary: "Standup", prefix: "[AI] ", want: "[AI] Standup"},
+		{name: "existing prefix is not repeated", summary: "[AI] Standup", prefix: "[AI]", want: "[AI] Standup"},
+		{name: "existing suffix is not repeated", summary: "Standup (via agent)", suffix: "(via agent)", want: "Standup (via agent)"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := decorateTitleWith(tt.summary, tt.prefix, tt.suffix); got != tt.want {
+				t.Errorf("decorateTitleWith(%q, %q, %q) = %q, want %q", tt.summary, tt.prefix, tt.suffix, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEventTitlePrefix(t *testing.T) {
+	disableConflictCheck(t)
+	t.Setenv("GOOGLE_CALENDAR_EVENT_TITLE_PREFIX", "[AI]")
+
+	var stored *calendar.Event
+	stub := &stubCalendarService{
+		createEventFn: func(calendarID string, event *calendar.Event) (*calendar.Event, error) {
+			event.Id = "evt-1"
+			stored = event
+			return event, nil
+		},
+		getEventFn: func(calendarID, eventID string) (*calendar.Event, error) {
+			copied := *stored
+			return &copied, nil
+		},
+		updateEventFn: func(calendarID, eventID string, event *calendar.Event) (*calendar.Event, error) {
+			stored = event
+			return event, nil
+		},
+	}
+
+	create := &CreateCalendarEventTool{logger: zap.NewNop(), google: stub}
+	if _, err := create.CreateCalendarEventHandler(context.Background(), map[string]any{
+		"summary":   "Standup",
+		"startTime": "2026-05-23T10:00:00Z",
+		"endTime":   "2026-05-23T10:30:00Z",
+	}); err != nil {
+		t.Fatalf("create: unexpected error: %v", err)
+	}
+	if stored.Summary != "[AI] Standup" {
+		t.Fatalf("created summary = %q, want %q", stored.Summary, "[AI] Standup")
+	}
+
+	update := &UpdateCalendarEventTool{logger: zap.NewNop(), google: stub}
+	for _, rename := range []struct{ summary, want string }{
+		{"[AI] Standup", "[AI] Standup"},
+		{"Daily standup", "[AI] Daily standup"},
+	} {
+		if _, err := update.UpdateCalendarEventHandler(context.Background(), map[string]any{
+			"eventId": "evt-1",
+			"summary": rename.summary,
+		}); err != nil {
+			t.Fatalf("update %q: unexpected error: %v", rename.summary, err)
+		}
+		if stored.Summary != rename.want {
+			t.Errorf("summary after renaming to %q = %q, want %q", rename.summary, stored.Summary, rename.want)
+		}
+	}
+
+	if _, err := update.UpdateCalendarEventHandler(context.Background(), map[string]any{
+		"eventId":  "evt-1",
+		"location": "Room 2",
+	}); err != nil {
+		t.Fatalf("update location: unexpected error: %v", err)
+	}
+	if stored.Summary != "[AI] Daily standup" {
+		t.Errorf("summary after unrelated update = %q, want it unchanged", stored.Summary)
+	}
+}
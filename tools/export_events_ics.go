@@ -0,0 +1,247 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	zap "go.uber.org/zap"
+	calendar "google.golang.org/api/calendar/v3"
+
+	server "github.com/inference-gateway/adk/server"
+
+	google "github.com/inference-gateway/google-calendar-agent/internal/google"
+)
+
+// icsLineLimit is the longest content line RFC 5545 allows before folding.
+const icsLineLimit = 75
+
+// ExportEventsICSTool struct holds the tool with dependencies
+type ExportEventsICSTool struct {
+	logger *zap.Logger
+	google google.CalendarService
+}
+
+// NewExportEventsICSTool creates a new export_events_ics tool
+func NewExportEventsICSTool(logger *zap.Logger, google google.CalendarService) server.Tool {
+	tool := &ExportEventsICSTool{
+		logger: logger,
+		google: google,
+	}
+	return server.NewBasicTool(
+		"export_events_ics",
+		"Export events in a time range as an iCalendar (.ics) document that other calendar apps can import",
+		map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"timeMax": map[string]any{
+					"description": "End of the range (RFC3339 format). Defaults to 7 days after timeMin.",
+					"type":        "string",
+				},
+				"timeMin": map[string]any{
+					"description": "Start of the range (RFC3339 format). Defaults to now.",
+					"type":        "string",
+				},
+			},
+		},
+		tool.ExportEventsICSHandler,
+	)
+}
+
+// ExportEventsICSHandler handles the export_events_ics tool execution
+func (s *ExportEventsICSTool) ExportEventsICSHandler(ctx context.Context, args map[string]any) (string, error) {
+	span := startToolSpan(ctx, "export_events_ics")
+	defer span.End()
+	s.logger.Debug("exporting events as ics", zap.Any("args", args))
+
+	timeMin := time.Now()
+	if tm, exists := args["timeMin"]; exists && tm != nil {
+		tmStr, ok := tm.(string)
+		if !ok {
+			return "", fmt.Errorf("timeMin must be a string, got %T", tm)
+		}
+		parsedTime, err := time.Parse(time.RFC3339, tmStr)
+		if err != nil {
+			return "", fmt.Errorf("invalid timeMin format (expected RFC3339): %w", err)
+		}
+		timeMin = parsedTime
+	}
+
+	timeMax := timeMin.AddDate(0, 0, 7)
+	if tm, exists := args["timeMax"]; exists && tm != nil {
+		tmStr, ok := tm.(string)
+		if !ok {
+			return "", fmt.Errorf("timeMax must be a string, got %T", tm)
+		}
+		parsedTime, err := time.Parse(time.RFC3339, tmStr)
+		if err != nil {
+			return "", fmt.Errorf("invalid timeMax format (expected RFC3339): %w", err)
+		}
+		timeMax = parsedTime
+	}
+	if !timeMax.After(timeMin) {
+		return "", fmt.Errorf("timeMax must be after timeMin")
+	}
+
+	calendarID := s.google.GetCalendarID()
+	events, err := s.google.ListEvents(ctx, calendarID, timeMin, timeMax)
+	if err != nil {
+		s.logger.Error("failed to list calendar events", zap.Error(err))
+		return "", fmt.Errorf("failed to list calendar events: %w", err)
+	}
+
+	var exported []*calendar.Event
+	for _, event := range events {
+		if event.Status != "cancelled" {
+			exported = append(exported, event)
+		}
+	}
+
+	loc, tzName := calendarTimezone(ctx, s.google, calendarID)
+	ics := renderICS(exported, loc, tzName, time.Now())
+
+	s.logger.Info("events exported as ics", zap.Int("count", len(exported)))
+
+	result := map[string]any{
+		"success":  true,
+		"ics":      ics,
+		"mimeType": "text/calendar",
+		"count":    len(exported),
+	}
+
+	resultJSON, err := json.Marshal(result)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal result: %w", err)
+	}
+
+	return string(resultJSON), nil
+}
+
+// icsZone is a timezone referenced by exported events and the span of
+// event times it has to cover.
+type icsZone struct {
+	loc         *time.Location
+	from, until time.Time
+}
+
+// renderICS builds a VCALENDAR holding the events. Timed events keep the
+// event's own timezone, or the calendar's when it has none, and every
+// non-UTC zone used gets a VTIMEZONE so importers read the times correctly.
+func renderICS(events []*calendar.Event, loc *time.Location, tzName string, now time.Time) string {
+	zones := map[string]*icsZone{}
+	var body strings.Builder
+	for _, event := range events {
+		writeICSEvent(&body, event, loc, tzName, now, zones)
+	}
+
+	var b strings.Builder
+	writeICSLine(&b, "BEGIN:VCALENDAR")
+	writeICSLine(&b, "VERSION:2.0")
+	writeICSLine(&b, "PRODID:-//inference-gateway//google-calendar-agent//EN")
+	writeICSLine(&b, "CALSCALE:GREGORIAN")
+	tzids := make([]string, 0, len(zones))
+	for tzid := range zones {
+		tzids = append(tzids, tzid)
+	}
+	sort.Strings(tzids)
+	for _, tzid := range tzids {
+		zone := zones[tzid]
+		writeVTimezone(&b, tzid, zone.loc, zone.from, zone.until)
+	}
+	b.WriteString(body.String())
+	writeICSLine(&b, "END:VCALENDAR")
+	return b.String()
+}
+
+// writeICSEvent writes one VEVENT, recording the zones its times use.
+func writeICSEvent(b *strings.Builder, event *calendar.Event, loc *time.Location, tzName string, now time.Time, zones map[string]*icsZone) {
+	writeICSLine(b, "BEGIN:VEVENT")
+	uid := event.ICalUID
+	if uid == "" {
+		uid = event.Id
+	}
+	writeICSLine(b, "UID:"+uid)
+	writeICSLine(b, "DTSTAMP:"+now.UTC().Format(icsLocalLayout)+"Z")
+	writeICSTime(b, "DTSTART", event.Start, loc, tzName, zones)
+	writeICSTime(b, "DTEND", event.End, loc, tzName, zones)
+	writeICSLine(b, "SUMMARY:"+escapeICSText(event.Summary))
+	if event.Location != "" {
+		writeICSLine(b, "LOCATION:"+escapeICSText(event.Location))
+	}
+	if event.Description != "" {
+		writeICSLine(b, "DESCRIPTION:"+escapeICSText(event.Description))
+	}
+	if event.HtmlLink != "" {
+		writeICSLine(b, "URL:"+event.HtmlLink)
+	}
+	writeICSLine(b, "END:VEVENT")
+}
+
+// writeICSTime writes DTSTART or DTEND: a DATE for all-day events, a UTC
+// time for events in UTC, and a TZID-qualified local time otherwise.
+func writeICSTime(b *strings.Builder, name string, dt *calendar.EventDateTime, loc *time.Location, tzName string, zones map[string]*icsZone) {
+	if dt == nil {
+		return
+	}
+	if dt.DateTime == "" {
+		day, err := time.Parse("2006-01-02", dt.Date)
+		if err != nil {
+			return
+		}
+		writeICSLine(b, name+";VALUE=DATE:"+day.Format("20060102"))
+		return
+	}
+	t, err := time.Parse(time.RFC3339, dt.DateTime)
+	if err != nil {
+		return
+	}
+	zoneLoc, tzid := loc, tzName
+	if dt.TimeZone != "" {
+		if l, err := time.LoadLocation(dt.TimeZone); err == nil {
+			zoneLoc, tzid = l, dt.TimeZone
+		}
+	}
+	if tzid == "" || tzid == "UTC" || tzid == "Etc/UTC" {
+		writeICSLine(b, name+":"+t.UTC().Format(icsLocalLayout)+"Z")
+		return
+	}
+	zone, ok := zones[tzid]
+	if !ok {
+		zone = &icsZone{loc: zoneLoc, from: t, until: t}
+		zones[tzid] = zone
+	}
+	if t.Before(zone.from) {
+		zone.from = t
+	}
+	if t.After(zone.until) {
+		zone.until = t
+	}
+	writeICSLine(b, name+";TZID="+tzid+":"+t.In(zoneLoc).Format(icsLocalLayout))
+}
+
+// escapeICSText escapes a TEXT property value.
+func escapeICSText(s string) string {
+	return strings.NewReplacer(`\`, `\\`, ";", `\;`, ",", `\,`, "\r\n", `\n`, "\n", `\n`).Replace(s)
+}
+
+// writeICSLine writes a content line terminated by CRLF, folding it at
+// icsLineLimit octets without splitting a UTF-8 sequence.
+func writeICSLine(b *strings.Builder, line string) {
+	limit := icsLineLimit
+	for len(line) > limit {
+		cut := limit
+		for cut > 0 && line[cut]&0xC0 == 0x80 {
+			cut--
+		}
+		b.WriteString(line[:cut])
+		b.WriteString("\r\n ")
+		line = line[cut:]
+		// Continuation lines lose one octet to the leading space.
+		limit = icsLineLimit - 1
+	}
+	b.WriteString(line)
+	b.WriteString("\r\n")
+}
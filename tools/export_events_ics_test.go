@@ -0,0 +1,125 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	zap "go.uber.org/zap"
+	calendar "google.golang.org/api/calendar/v3"
+)
+
+func TestExportEventsICSHandler(t *testing.T) {
+	t.Setenv("GOOGLE_CALENDAR_TIMEZONE", "UTC")
+	t.Setenv("TZ", "")
+
+	newYork := &calendar.Event{
+		Id:       "standup",
+		ICalUID:  "standup@google.com",
+		Summary:  "Standup, NYC",
+		Location: "Office; 5th floor",
+		Start:    &calendar.EventDateTime{DateTime: "2026-05-20T10:00:00-04:00", TimeZone: "America/New_York"},
+		End:      &calendar.EventDateTime{DateTime: "2026-05-20T10:30:00-04:00", TimeZone: "America/New_York"},
+	}
+	utc := &calendar.Event{
+		Id:      "sync",
+		Summary: "Sync",
+		Start:   &calendar.EventDateTime{DateTime: "2026-05-20T15:00:00Z"},
+		End:     &calendar.EventDateTime{DateTime: "2026-05-20T16:00:00Z"},
+	}
+	holiday := &calendar.Event{
+		Id:      "holiday",
+		Summary: "Holiday",
+		Start:   &calendar.EventDateTime{Date: "2026-05-21"},
+		End:     &calendar.EventDateTime{Date: "2026-05-22"},
+	}
+
+	tests := []struct {
+		name      string
+		events    []*calendar.Event
+		want      []string
+		wantNot   []string
+		wantCount int
+	}{
+		{
+			name:   "new york event carries a matching vtimezone",
+			events: []*calendar.Event{newYork},
+			want: []string{
+				"BEGIN:VTIMEZONE\r\nTZID:America/New_York\r\n",
+				"BEGIN:DAYLIGHT\r\nDTSTART:20260308T020000\r\nTZOFFSETFROM:-0500\r\nTZOFFSETTO:-0400\r\nTZNAME:EDT\r\nEND:DAYLIGHT\r\n",
+				"BEGIN:STANDARD\r\nDTSTART:20261101T020000\r\nTZOFFSETFROM:-0400\r\nTZOFFSETTO:-0500\r\nTZNAME:EST\r\nEND:STANDARD\r\n",
+				"DTSTART;TZID=America/New_York:20260520T100000\r\n",
+				"DTEND;TZID=America/New_York:20260520T103000\r\n",
+				"UID:standup@google.com\r\n",
+				"SUMMARY:Standup\\, NYC\r\n",
+				"LOCATION:Office\\; 5th floor\r\n",
+			},
+			wantCount: 1,
+		},
+		{
+			name:      "utc and all-day events need no vtimezone",
+			events:    []*calendar.Event{utc, holiday},
+			want:      []string{"DTSTART:20260520T150000Z\r\n", "DTSTART;VALUE=DATE:20260521\r\n", "DTEND;VALUE=DATE:20260522\r\n"},
+			wantNot:   []string{"VTIMEZONE"},
+			wantCount: 2,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			stub := &stubCalendarService{
+				listEventsFn: func(calendarID string, timeMin, timeMax time.Time) ([]*calendar.Event, error) {
+					return tc.events, nil
+				},
+			}
+			tool := &ExportEventsICSTool{logger: zap.NewNop(), google: stub}
+			result, err := tool.ExportEventsICSHandler(context.Background(), map[string]any{
+				"timeMin": "2026-05-18T00:00:00Z",
+				"timeMax": "2026-05-25T00:00:00Z",
+			})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			var parsed struct {
+				ICS   string `json:"ics"`
+				Count int    `json:"count"`
+			}
+			if err := json.Unmarshal([]byte(result), &parsed); err != nil {
+				t.Fatalf("failed to unmarshal result: %v", err)
+			}
+			if parsed.Count != tc.wantCount {
+				t.Errorf("count = %d, want %d", parsed.Count, tc.wantCount)
+			}
+			if !strings.HasPrefix(parsed.ICS, "BEGIN:VCALENDAR\r\n") || !strings.HasSuffix(parsed.ICS, "END:VCALENDAR\r\n") {
+				t.Errorf("ics is not a VCALENDAR:\n%s", parsed.ICS)
+			}
+			for _, want := range tc.want {
+				if !strings.Contains(parsed.ICS, want) {
+					t.Errorf("ics missing %q:\n%s", want, parsed.ICS)
+				}
+			}
+			for _, notWant := range tc.wantNot {
+				if strings.Contains(parsed.ICS, notWant) {
+					t.Errorf("ics unexpectedly contains %q:\n%s", notWant, parsed.ICS)
+				}
+			}
+		})
+	}
+}
+
+func TestWriteICSLineFolds(t *testing.T) {
+	var b strings.Builder
+	writeICSLine(&b, "DESCRIPTION:"+strings.Repeat("é", 60))
+	for _, line := range strings.Split(strings.TrimSuffix(b.String(), "\r\n"), "\r\n") {
+		if len(line) > icsLineLimit {
+			t.Errorf("line is %d octets, want at most %d", len(line), icsLineLimit)
+		}
+	}
+	unfolded := strings.ReplaceAll(b.String(), "\r\n ", "")
+	if unfolded != "DESCRIPTION:"+strings.Repeat("é", 60)+"\r\n" {
+		t.Errorf("unfolded line = %q", unfolded)
+	}
+}
@@ -0,0 +1,92 @@
+package tools
+
+import (
+	"fmt"
+	"maps"
+
+	calendar "google.golang.org/api/calendar/v3"
+)
+
+// privatePropertiesSchema and sharedPropertiesSchema describe the extended
+// property arguments of create_calendar_event and update_calendar_event.
+var (
+	privatePropertiesSchema = map[string]any{
+		"description":          "String key/value metadata visible only on this calendar's copy of the event, e.g. a correlation key from another system. Merged into existing properties on update. Optional.",
+		"additionalProperties": map[string]any{"type": "string"},
+		"type":                 "object",
+	}
+	sharedPropertiesSchema = map[string]any{
+		"description":          "String key/value metadata visible to every attendee's copy of the event. Merged into existing properties on update. Optional.",
+		"additionalProperties": map[string]any{"type": "string"},
+		"type":                 "object",
+	}
+)
+
+// parsePropertyMap reads an object argument whose values must be strings.
+func parsePropertyMap(args map[string]any, name string) (map[string]string, error) {
+	v, exists := args[name]
+	if !exists || v == nil {
+		return nil, nil
+	}
+	obj, ok := v.(map[string]any)
+	if !ok {
+		return nil, fmt.Errorf("%s must be an object, got %T", name, v)
+	}
+	props := make(map[string]string, len(obj))
+	for key, value := range obj {
+		s, ok := value.(string)
+		if !ok {
+			return nil, fmt.Errorf("%s.%s must be a string, got %T", name, key, value)
+		}
+		props[key] = s
+	}
+	return props, nil
+}
+
+// applyExtendedProperties merges the privateProperties and sharedProperties
+// arguments into event.ExtendedProperties, keeping keys the arguments do
+// not mention.
+func applyExtendedProperties(event *calendar.Event, args map[string]any) error {
+	private, err := parsePropertyMap(args, "privateProperties")
+	if err != nil {
+		return err
+	}
+	shared, err := parsePropertyMap(args, "sharedProperties")
+	if err != nil {
+		return err
+	}
+	if len(private) == 0 && len(shared) == 0 {
+		return nil
+	}
+
+	// Copy before merging, since a split series shares its master's maps.
+	props := &calendar.EventExtendedProperties{}
+	if event.ExtendedProperties != nil {
+		props.Private = maps.Clone(event.ExtendedProperties.Private)
+		props.Shared = maps.Clone(event.ExtendedProperties.Shared)
+	}
+	if len(private) > 0 && props.Private == nil {
+		props.Private = map[string]string{}
+	}
+	maps.Copy(props.Private, private)
+	if len(shared) > 0 && props.Shared == nil {
+		props.Shared = map[string]string{}
+	}
+	maps.Copy(props.Shared, shared)
+	event.ExtendedProperties = props
+	return nil
+}
+
+// addExtendedProperties copies an event's non-empty extended properties
+// into a tool result.
+func addExtendedProperties(result map[string]any, event *calendar.Event) {
+	if event.ExtendedProperties == nil {
+		return
+	}
+	if len(event.ExtendedProperties.Private) > 0 {
+		result["privateProperties"] = event.ExtendedProperties.Private
+	}
+	if len(event.ExtendedProperties.Shared) > 0 {
+		result["sharedProperties"] = event.ExtendedProperties.Shared
+	}
+}
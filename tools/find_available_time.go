@@ -33,6 +33,10 @@ func NewFindAvailableTimeTool(logger *zap.Logger, google google.CalendarService)
 		map[string]any{
 			"type": "object",
 			"properties": map[string]any{
+				"calendarId": map[string]any{
+					"description": "Calendar to check instead of the default one, e.g. a shared team calendar. Optional.",
+					"type":        "string",
+				},
 				"duration": map[string]any{
 					"description": "Duration in minutes for the desired time slot (default: 60)",
 					"maximum":     480,
@@ -43,6 +47,11 @@ func NewFindAvailableTimeTool(logger *zap.Logger, google google.CalendarService)
 					"description": "End date for search (RFC3339 format, e.g., 2024-01-01T23:59:59Z)",
 					"type":        "string",
 				},
+				"partOfDay": map[string]any{
+					"description": "Only search this part of each day, e.g. \"a morning slot this week\". Boundaries come from the agent configuration. Optional.",
+					"enum":        partsOfDay,
+					"type":        "string",
+				},
 				"startDate": map[string]any{
 					"description": "Start date for search (RFC3339 format, e.g., 2024-01-01T00:00:00Z)",
 					"type":        "string",
@@ -87,14 +96,53 @@ func (s *FindAvailableTimeTool) FindAvailableTimeHandler(ctx context.Context, ar
 		return "", fmt.Errorf("invalid endDate format: %w", err)
 	}
 
+	partOfDay := ""
+	if p, exists := args["partOfDay"]; exists && p != nil {
+		pStr, ok := p.(string)
+		if !ok {
+			return "", fmt.Errorf("partOfDay must be a string, got %T", p)
+		}
+		partOfDay = pStr
+	}
+
+	var partHours workingHours
+	if partOfDay != "" {
+		partHours, err = loadPartOfDay(partOfDay)
+		if err != nil {
+			return "", err
+		}
+	}
+
 	calendarID := s.google.GetCalendarID()
-	existingEvents, err := s.google.ListEvents(calendarID, startDate, endDate)
+	if c, exists := args["calendarId"]; exists && c != nil {
+		cStr, ok := c.(string)
+		if !ok {
+			return "", fmt.Errorf("calendarId must be a string, got %T", c)
+		}
+		if cStr != "" {
+			calendarID = cStr
+		}
+	}
+
+	existingEvents, err := s.google.ListEvents(ctx, calendarID, startDate, endDate)
 	if err != nil {
 		s.logger.Error("failed to list events for availability check", zap.Error(err))
 		return "", fmt.Errorf("failed to list events for availability check: %w", err)
 	}
 
-	availableSlots := s.findAvailableSlots(startDate, endDate, time.Duration(duration)*time.Minute, existingEvents)
+	loc, _ := calendarTimezone(ctx, s.google, calendarID)
+	busyPeriods := eventBusyPeriods(existingEvents, loc)
+	slotDuration := time.Duration(duration) * time.Minute
+
+	var availableSlots []timeSlot
+	if partOfDay == "" {
+		availableSlots = s.findAvailableSlots(startDate, endDate, slotDuration, busyPeriods)
+	} else {
+		for _, window := range partHours.dailyWindows(startDate, endDate, loc) {
+			windowBusy := clipBusyPeriods(busyPeriods, window.startTime, window.endTime)
+			availableSlots = append(availableSlots, s.findAvailableSlots(window.startTime, window.endTime, slotDuration, windowBusy)...)
+		}
+	}
 
 	s.logger.Info("available time slots found", zap.Int("slotCount", len(availableSlots)))
 
@@ -110,6 +158,7 @@ func (s *FindAvailableTimeTool) FindAvailableTimeHandler(ctx context.Context, ar
 	result := map[string]any{
 		"success":           true,
 		"availableSlots":    slots,
+		"calendarId":        calendarID,
 		"slotCount":         len(slots),
 		"requestedDuration": duration,
 		"searchRange": map[string]string{
@@ -117,6 +166,9 @@ func (s *FindAvailableTimeTool) FindAvailableTimeHandler(ctx context.Context, ar
 			"endDate":   endDateStr,
 		},
 	}
+	if partOfDay != "" {
+		result["partOfDay"] = partOfDay
+	}
 
 	resultJSON, err := json.Marshal(result)
 	if err != nil {
@@ -133,46 +185,15 @@ type timeSlot struct {
 	duration  time.Duration
 }
 
-// findAvailableSlots finds available time slots between existing events
-func (s *FindAvailableTimeTool) findAvailableSlots(startDate, endDate time.Time, duration time.Duration, events []*calendar.Event) []timeSlot {
-	loc, _, _ := resolveTimezone()
-
-	var busyPeriods []timeSlot
-	for _, event := range events {
-		if event.Start == nil || event.End == nil {
-			continue
-		}
-
-		if event.Start.DateTime != "" {
-			eventStart, err1 := time.Parse(time.RFC3339, event.Start.DateTime)
-			eventEnd, err2 := time.Parse(time.RFC3339, event.End.DateTime)
-			if err1 == nil && err2 == nil {
-				busyPeriods = append(busyPeriods, timeSlot{
-					startTime: eventStart,
-					endTime:   eventEnd,
-					duration:  eventEnd.Sub(eventStart),
-				})
-			}
-			continue
-		}
-
-		if event.Start.Date != "" && event.End.Date != "" {
-			startDay, err1 := time.ParseInLocation("2006-01-02", event.Start.Date, loc)
-			endDay, err2 := time.ParseInLocation("2006-01-02", event.End.Date, loc)
-			if err1 == nil && err2 == nil {
-				busyPeriods = append(busyPeriods, timeSlot{
-					startTime: startDay,
-					endTime:   endDay,
-					duration:  endDay.Sub(startDay),
-				})
-			}
-		}
-	}
-
-	sort.Slice(busyPeriods, func(i, j int) bool {
-		return busyPeriods[i].startTime.Before(busyPeriods[j].startTime)
-	})
+// overlaps reports whether t and other share any time. Slots that only
+// touch, one ending as the other starts, do not overlap.
+func (t timeSlot) overlaps(other timeSlot) bool {
+	return t.startTime.Before(other.endTime) && t.endTime.After(other.startTime)
+}
 
+// findAvailableSlots finds available time slots between the sorted busy
+// periods
+func (s *FindAvailableTimeTool) findAvailableSlots(startDate, endDate time.Time, duration time.Duration, busyPeriods []timeSlot) []timeSlot {
 	var availableSlots []timeSlot
 
 	if len(busyPeriods) > 0 {
@@ -184,11 +205,13 @@ func (s *FindAvailableTimeTool) findAvailableSlots(startDate, endDate time.Time,
 			})
 		}
 	} else {
-		availableSlots = append(availableSlots, timeSlot{
-			startTime: startDate,
-			endTime:   startDate.Add(duration),
-			duration:  duration,
-		})
+		if endDate.Sub(startDate) >= duration {
+			availableSlots = append(availableSlots, timeSlot{
+				startTime: startDate,
+				endTime:   startDate.Add(duration),
+				duration:  duration,
+			})
+		}
 		return availableSlots
 	}
 
@@ -219,3 +242,61 @@ func (s *FindAvailableTimeTool) findAvailableSlots(startDate, endDate time.Time,
 
 	return availableSlots
 }
+
+// eventBusyPeriods converts events into busy periods sorted by start time.
+// All-day events block whole days in loc.
+func eventBusyPeriods(events []*calendar.Event, loc *time.Location) []timeSlot {
+	var busyPeriods []timeSlot
+	for _, event := range events {
+		if event.Start == nil || event.End == nil || !google.BlocksTime(event) {
+			continue
+		}
+
+		if event.Start.DateTime != "" {
+			eventStart, err1 := time.Parse(time.RFC3339, event.Start.DateTime)
+			eventEnd, err2 := time.Parse(time.RFC3339, event.End.DateTime)
+			if err1 == nil && err2 == nil {
+				busyPeriods = append(busyPeriods, timeSlot{
+					startTime: eventStart,
+					endTime:   eventEnd,
+					duration:  eventEnd.Sub(eventStart),
+				})
+			}
+			continue
+		}
+
+		if event.Start.Date != "" && event.End.Date != "" {
+			startDay, err1 := time.ParseInLocation("2006-01-02", event.Start.Date, loc)
+			endDay, err2 := time.ParseInLocation("2006-01-02", event.End.Date, loc)
+			if err1 == nil && err2 == nil {
+				busyPeriods = append(busyPeriods, timeSlot{
+					startTime: startDay,
+					endTime:   endDay,
+					duration:  endDay.Sub(startDay),
+				})
+			}
+		}
+	}
+
+	sort.Slice(busyPeriods, func(i, j int) bool {
+		return busyPeriods[i].startTime.Before(busyPeriods[j].startTime)
+	})
+	return busyPeriods
+}
+
+// mergeBusyPeriods collapses overlapping or touching busy periods. The
+// input must be sorted by start time, as returned by eventBusyPeriods.
+func mergeBusyPeriods(periods []timeSlot) []timeSlot {
+	var merged []timeSlot
+	for _, p := range periods {
+		if n := len(merged); n > 0 && !p.startTime.After(merged[n-1].endTime) {
+			if p.endTime.After(merged[n-1].endTime) {
+				merged[n-1].endTime = p.endTime
+				merged[n-1].duration = merged[n-1].endTime.Sub(merged[n-1].startTime)
+			}
+			continue
+		}
+		merged = append(merged, p)
+	}
+	return merged
+}
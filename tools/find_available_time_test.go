@@ -145,6 +145,57 @@ func TestFindAvailableTimeHandler(t *testing.T) {
 				{"2026-05-23T09:00:00Z", "2026-05-23T10:00:00Z"},
 			},
 		},
+		{
+			name: "morning only searches each day's morning hours",
+			args: map[string]any{
+				"startDate": "2026-05-23T00:00:00Z",
+				"endDate":   "2026-05-25T00:00:00Z",
+				"duration":  float64(60),
+				"partOfDay": "morning",
+			},
+			events: []*calendar.Event{
+				timed("2026-05-23T07:00:00Z", "2026-05-23T10:00:00Z"),
+				timed("2026-05-24T08:00:00Z", "2026-05-24T11:30:00Z"),
+			},
+			wantSlots: []slotExpect{
+				{"2026-05-23T10:00:00Z", "2026-05-23T11:00:00Z"},
+			},
+		},
+		{
+			name: "afternoon slots are excluded from a morning request",
+			args: map[string]any{
+				"startDate": "2026-05-23T11:30:00Z",
+				"endDate":   "2026-05-23T18:00:00Z",
+				"duration":  float64(60),
+				"partOfDay": "morning",
+			},
+			wantSlots: nil,
+		},
+		{
+			name: "afternoon clips to the configured afternoon hours",
+			args: map[string]any{
+				"startDate": "2026-05-23T00:00:00Z",
+				"endDate":   "2026-05-23T23:59:59Z",
+				"duration":  float64(60),
+				"partOfDay": "afternoon",
+			},
+			events: []*calendar.Event{
+				timed("2026-05-23T09:00:00Z", "2026-05-23T13:00:00Z"),
+			},
+			wantSlots: []slotExpect{
+				{"2026-05-23T13:00:00Z", "2026-05-23T14:00:00Z"},
+			},
+		},
+		{
+			name: "unknown partOfDay returns error",
+			args: map[string]any{
+				"startDate": "2026-05-23T00:00:00Z",
+				"endDate":   "2026-05-23T23:59:59Z",
+				"partOfDay": "night",
+			},
+			wantErr:    true,
+			wantErrSub: "partOfDay must be one of",
+		},
 		{
 			name: "missing startDate returns error",
 			args: map[string]any{
@@ -239,3 +290,85 @@ func TestFindAvailableTimeHandler(t *testing.T) {
 		})
 	}
 }
+
+func TestFindAvailableTimeCalendarOverride(t *testing.T) {
+	t.Setenv("GOOGLE_CALENDAR_TIMEZONE", "UTC")
+	t.Setenv("TZ", "")
+
+	eventsByCalendar := map[string][]*calendar.Event{
+		"primary": {{
+			Id:    "own",
+			Start: &calendar.EventDateTime{DateTime: "2026-05-23T09:00:00Z"},
+			End:   &calendar.EventDateTime{DateTime: "2026-05-23T10:00:00Z"},
+		}},
+		"team@group.calendar.google.com": {{
+			Id:    "team",
+			Start: &calendar.EventDateTime{DateTime: "2026-05-23T10:00:00Z"},
+			End:   &calendar.EventDateTime{DateTime: "2026-05-23T11:00:00Z"},
+		}},
+	}
+
+	tests := []struct {
+		name         string
+		calendarID   any
+		wantCalendar string
+		wantFirst    string
+		wantErrSub   string
+	}{
+		{name: "default calendar", wantCalendar: "primary", wantFirst: "2026-05-23T10:00:00Z"},
+		{name: "empty falls back to default", calendarID: "", wantCalendar: "primary", wantFirst: "2026-05-23T10:00:00Z"},
+		{name: "override calendar", calendarID: "team@group.calendar.google.com", wantCalendar: "team@group.calendar.google.com", wantFirst: "2026-05-23T09:00:00Z"},
+		{name: "non-string calendarId", calendarID: 42.0, wantErrSub: "calendarId must be a string"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var listed string
+			stub := &stubCalendarService{
+				calendarID: "primary",
+				listEventsFn: func(calendarID string, timeMin, timeMax time.Time) ([]*calendar.Event, error) {
+					listed = calendarID
+					return eventsByCalendar[calendarID], nil
+				},
+			}
+			args := map[string]any{
+				"startDate": "2026-05-23T09:00:00Z",
+				"endDate":   "2026-05-23T12:00:00Z",
+			}
+			if tc.calendarID != nil {
+				args["calendarId"] = tc.calendarID
+			}
+			tool := &FindAvailableTimeTool{logger: zap.NewNop(), google: stub}
+			result, err := tool.FindAvailableTimeHandler(context.Background(), args)
+
+			if tc.wantErrSub != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErrSub) {
+					t.Fatalf("error = %v, want substring %q", err, tc.wantErrSub)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if listed != tc.wantCalendar {
+				t.Errorf("listed calendar = %q, want %q", listed, tc.wantCalendar)
+			}
+
+			var parsed struct {
+				CalendarID     string `json:"calendarId"`
+				AvailableSlots []struct {
+					StartTime string `json:"startTime"`
+				} `json:"availableSlots"`
+			}
+			if err := json.Unmarshal([]byte(result), &parsed); err != nil {
+				t.Fatalf("failed to unmarshal result: %v", err)
+			}
+			if parsed.CalendarID != tc.wantCalendar {
+				t.Errorf("calendarId = %q, want %q", parsed.CalendarID, tc.wantCalendar)
+			}
+			if len(parsed.AvailableSlots) == 0 || parsed.AvailableSlots[0].StartTime != tc.wantFirst {
+				t.Errorf("slots = %+v, want first slot at %s", parsed.AvailableSlots, tc.wantFirst)
+			}
+		})
+	}
+}
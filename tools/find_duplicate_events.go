@@ -0,0 +1,240 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	zap "go.uber.org/zap"
+	calendar "google.golang.org/api/calendar/v3"
+
+	server "github.com/inference-gateway/adk/server"
+
+	google "github.com/inference-gateway/google-calendar-agent/internal/google"
+)
+
+// FindDuplicateEventsTool struct holds the tool with dependencies
+type FindDuplicateEventsTool struct {
+	logger *zap.Logger
+	google google.CalendarService
+}
+
+// NewFindDuplicateEventsTool creates a new find_duplicate_events tool
+func NewFindDuplicateEventsTool(logger *zap.Logger, google google.CalendarService) server.Tool {
+	tool := &FindDuplicateEventsTool{
+		logger: logger,
+		google: google,
+	}
+	return server.NewBasicTool(
+		"find_duplicate_events",
+		"Find events with the same summary, start and end in a time range, optionally deleting all but the earliest-created copy",
+		map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"merge": map[string]any{
+					"description": "Delete every duplicate except the earliest-created event in each group (default: false). Only set after the user confirms.",
+					"type":        "boolean",
+				},
+				"timeMax": map[string]any{
+					"description": "End of the range (RFC3339 format). Defaults to 30 days after timeMin.",
+					"type":        "string",
+				},
+				"timeMin": map[string]any{
+					"description": "Start of the range (RFC3339 format). Defaults to now.",
+					"type":        "string",
+				},
+			},
+		},
+		tool.FindDuplicateEventsHandler,
+	)
+}
+
+// FindDuplicateEventsHandler handles the find_duplicate_events tool execution
+func (s *FindDuplicateEventsTool) FindDuplicateEventsHandler(ctx context.Context, args map[string]any) (string, error) {
+	span := startToolSpan(ctx, "find_duplicate_events")
+	defer span.End()
+	s.logger.Debug("finding duplicate events", zap.Any("args", args))
+
+	timeMin := time.Now()
+	if tm, exists := args["timeMin"]; exists && tm != nil {
+		tmStr, ok := tm.(string)
+		if !ok {
+			return "", fmt.Errorf("timeMin must be a string, got %T", tm)
+		}
+		parsedTime, err := time.Parse(time.RFC3339, tmStr)
+		if err != nil {
+			return "", fmt.Errorf("invalid timeMin format (expected RFC3339): %w", err)
+		}
+		timeMin = parsedTime
+	}
+
+	timeMax := timeMin.AddDate(0, 0, 30)
+	if tm, exists := args["timeMax"]; exists && tm != nil {
+		tmStr, ok := tm.(string)
+		if !ok {
+			return "", fmt.Errorf("timeMax must be a string, got %T", tm)
+		}
+		parsedTime, err := time.Parse(time.RFC3339, tmStr)
+		if err != nil {
+			return "", fmt.Errorf("invalid timeMax format (expected RFC3339): %w", err)
+		}
+		timeMax = parsedTime
+	}
+	if !timeMax.After(timeMin) {
+		return "", fmt.Errorf("timeMax must be after timeMin")
+	}
+
+	merge := false
+	if m, exists := args["merge"]; exists && m != nil {
+		mBool, ok := m.(bool)
+		if !ok {
+			return "", fmt.Errorf("merge must be a boolean, got %T", m)
+		}
+		merge = mBool
+	}
+
+	calendarID := s.google.GetCalendarID()
+	events, err := s.google.ListEvents(ctx, calendarID, timeMin, timeMax)
+	if err != nil {
+		s.logger.Error("failed to list calendar events", zap.Error(err))
+		return "", fmt.Errorf("failed to list calendar events: %w", err)
+	}
+
+	groups := duplicateGroups(events)
+
+	var deleted []string
+	var failed []map[string]any
+	var groupList []map[string]any
+	for _, group := range groups {
+		var copies []map[string]any
+		for i, event := range group {
+			copies = append(copies, map[string]any{
+				"eventId": event.Id,
+				"created": event.Created,
+				"keep":    i == 0,
+			})
+		}
+		groupList = append(groupList, map[string]any{
+			"summary":   group[0].Summary,
+			"startTime": eventDateTimeString(group[0].Start),
+			"endTime":   eventDateTimeString(group[0].End),
+			"events":    copies,
+		})
+
+		if !merge {
+			continue
+		}
+		for _, extra := range group[1:] {
+			if err := s.google.DeleteEvent(ctx, calendarID, extra.Id); err != nil {
+				s.logger.Warn("failed to delete duplicate event", zap.Error(err), zap.String("eventId", extra.Id))
+				failed = append(failed, map[string]any{"eventId": extra.Id, "error": err.Error()})
+				continue
+			}
+			deleted = append(deleted, extra.Id)
+		}
+	}
+
+	s.logger.Info("duplicate events found",
+		zap.Int("groups", len(groups)),
+		zap.Bool("merge", merge),
+		zap.Int("deleted", len(deleted)))
+
+	result := map[string]any{
+		"success": true,
+		"groups":  groupList,
+		"count":   len(groupList),
+	}
+	if merge {
+		result["deleted"] = deleted
+		if len(failed) > 0 {
+			result["success"] = false
+			result["failed"] = failed
+		}
+	}
+
+	resultJSON, err := json.Marshal(result)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal result: %w", err)
+	}
+
+	return string(resultJSON), nil
+}
+
+// duplicateGroups groups non-cancelled events sharing a summary, start and
+// end. Groups are ordered by start and each group lists its events from the
+// earliest created, which is the copy a merge keeps.
+func duplicateGroups(events []*calendar.Event) [][]*calendar.Event {
+	byKey := map[string][]*calendar.Event{}
+	var keys []string
+	for _, event := range events {
+		if event.Status == "cancelled" {
+			continue
+		}
+		key := duplicateKey(event)
+		if _, seen := byKey[key]; !seen {
+			keys = append(keys, key)
+		}
+		byKey[key] = append(byKey[key], event)
+	}
+
+	var groups [][]*calendar.Event
+	for _, key := range keys {
+		group := byKey[key]
+		if len(group) < 2 {
+			continue
+		}
+		sort.SliceStable(group, func(i, j int) bool {
+			return createdAt(group[i]).Before(createdAt(group[j]))
+		})
+		groups = append(groups, group)
+	}
+	sort.SliceStable(groups, func(i, j int) bool {
+		return normalizedDateTime(groups[i][0].Start) < normalizedDateTime(groups[j][0].Start)
+	})
+	return groups
+}
+
+// duplicateKey identifies an event by its trimmed, case-folded summary and
+// its start and end instants, so the same time written with different
+// offsets still matches.
+func duplicateKey(event *calendar.Event) string {
+	return strings.Join([]string{
+		strings.ToLower(strings.TrimSpace(event.Summary)),
+		normalizedDateTime(event.Start),
+		normalizedDateTime(event.End),
+	}, "|")
+}
+
+func normalizedDateTime(dt *calendar.EventDateTime) string {
+	if dt == nil {
+		return ""
+	}
+	if dt.DateTime != "" {
+		if t, err := time.Parse(time.RFC3339, dt.DateTime); err == nil {
+			return t.UTC().Format(time.RFC3339)
+		}
+	}
+	return eventDateTimeString(dt)
+}
+
+func eventDateTimeString(dt *calendar.EventDateTime) string {
+	if dt == nil {
+		return ""
+	}
+	if dt.DateTime != "" {
+		return dt.DateTime
+	}
+	return dt.Date
+}
+
+// createdAt parses an event's creation time; events without one sort last.
+func createdAt(event *calendar.Event) time.Time {
+	t, err := time.Parse(time.RFC3339, event.Created)
+	if err != nil {
+		return time.Date(9999, 1, 1, 0, 0, 0, 0, time.UTC)
+	}
+	return t
+}
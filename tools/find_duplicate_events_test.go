@@ -0,0 +1,155 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	zap "go.uber.org/zap"
+	calendar "google.golang.org/api/calendar/v3"
+)
+
+func TestFindDuplicateEventsHandler(t *testing.T) {
+	event := func(id, summary, start, end, created string) *calendar.Event {
+		return &calendar.Event{
+			Id:      id,
+			Summary: summary,
+			Status:  "confirmed",
+			Created: created,
+			Start:   &calendar.EventDateTime{DateTime: start},
+			End:     &calendar.EventDateTime{DateTime: end},
+		}
+	}
+	events := []*calendar.Event{
+		event("s2", "Standup", "2026-05-23T09:00:00Z", "2026-05-23T09:15:00Z", "2026-05-02T00:00:00Z"),
+		event("s1", "Standup", "2026-05-23T09:00:00Z", "2026-05-23T09:15:00Z", "2026-05-01T00:00:00Z"),
+		event("s3", " standup ", "2026-05-23T11:00:00+02:00", "2026-05-23T11:15:00+02:00", "2026-05-03T00:00:00Z"),
+		event("r1", "Review", "2026-05-22T14:00:00Z", "2026-05-22T15:00:00Z", "2026-05-05T00:00:00Z"),
+		event("r2", "Review", "2026-05-22T14:00:00Z", "2026-05-22T15:00:00Z", "2026-05-04T00:00:00Z"),
+		event("l1", "Lunch", "2026-05-23T12:00:00Z", "2026-05-23T13:00:00Z", "2026-05-01T00:00:00Z"),
+		event("l2", "Lunch", "2026-05-23T12:00:00Z", "2026-05-23T13:30:00Z", "2026-05-01T00:00:00Z"),
+		{Id: "c1", Summary: "Lunch", Status: "cancelled",
+			Start: &calendar.EventDateTime{DateTime: "2026-05-23T12:00:00Z"},
+			End:   &calendar.EventDateTime{DateTime: "2026-05-23T13:00:00Z"}},
+	}
+
+	tests := []struct {
+		name        string
+		args        map[string]any
+		deleteErr   error
+		wantErrSub  string
+		wantGroups  [][]string
+		wantDeleted []string
+		wantSuccess bool
+	}{
+		{
+			name:        "groups identical events, earliest created first",
+			args:        map[string]any{"timeMin": "2026-05-22T00:00:00Z", "timeMax": "2026-05-24T00:00:00Z"},
+			wantGroups:  [][]string{{"r2", "r1"}, {"s1", "s2", "s3"}},
+			wantSuccess: true,
+		},
+		{
+			name:        "merge deletes all but the earliest in each group",
+			args:        map[string]any{"merge": true},
+			wantGroups:  [][]string{{"r2", "r1"}, {"s1", "s2", "s3"}},
+			wantDeleted: []string{"r1", "s2", "s3"},
+			wantSuccess: true,
+		},
+		{
+			name:        "failed deletes are reported",
+			args:        map[string]any{"merge": true},
+			deleteErr:   errors.New("forbidden"),
+			wantGroups:  [][]string{{"r2", "r1"}, {"s1", "s2", "s3"}},
+			wantSuccess: false,
+		},
+		{
+			name:       "wrong-typed merge returns error",
+			args:       map[string]any{"merge": "yes"},
+			wantErrSub: "merge must be a boolean",
+		},
+		{
+			name:       "reversed range returns error",
+			args:       map[string]any{"timeMin": "2026-05-24T00:00:00Z", "timeMax": "2026-05-22T00:00:00Z"},
+			wantErrSub: "timeMax must be after timeMin",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var deleted []string
+			stub := &stubCalendarService{
+				listEventsFn: func(calendarID string, timeMin, timeMax time.Time) ([]*calendar.Event, error) {
+					return events, nil
+				},
+				deleteEventFn: func(calendarID, eventID string) error {
+					if tc.deleteErr != nil {
+						return tc.deleteErr
+					}
+					deleted = append(deleted, eventID)
+					return nil
+				},
+			}
+			tool := &FindDuplicateEventsTool{logger: zap.NewNop(), google: stub}
+			result, err := tool.FindDuplicateEventsHandler(context.Background(), tc.args)
+
+			if tc.wantErrSub != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErrSub) {
+					t.Fatalf("error = %v, want substring %q", err, tc.wantErrSub)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			var parsed struct {
+				Success bool `json:"success"`
+				Groups  []struct {
+					Events []struct {
+						EventID string `json:"eventId"`
+						Keep    bool   `json:"keep"`
+					} `json:"events"`
+				} `json:"groups"`
+				Failed []map[string]any `json:"failed"`
+			}
+			if err := json.Unmarshal([]byte(result), &parsed); err != nil {
+				t.Fatalf("failed to unmarshal result: %v", err)
+			}
+			if parsed.Success != tc.wantSuccess {
+				t.Errorf("success = %v, want %v", parsed.Success, tc.wantSuccess)
+			}
+
+			var gotGroups [][]string
+			for _, group := range parsed.Groups {
+				var ids []string
+				for i, e := range group.Events {
+					ids = append(ids, e.EventID)
+					if e.Keep != (i == 0) {
+						t.Errorf("event %s keep = %v, want %v", e.EventID, e.Keep, i == 0)
+					}
+				}
+				gotGroups = append(gotGroups, ids)
+			}
+			if got, want := joinGroups(gotGroups), joinGroups(tc.wantGroups); got != want {
+				t.Errorf("groups = %s, want %s", got, want)
+			}
+			if got, want := strings.Join(deleted, ","), strings.Join(tc.wantDeleted, ","); got != want {
+				t.Errorf("deleted = %s, want %s", got, want)
+			}
+			if tc.deleteErr != nil && len(parsed.Failed) != 3 {
+				t.Errorf("failed = %v, want 3 entries", parsed.Failed)
+			}
+		})
+	}
+}
+
+func joinGroups(groups [][]string) string {
+	var parts []string
+	for _, g := range groups {
+		parts = append(parts, strings.Join(g, ","))
+	}
+	return strings.Join(parts, " | ")
+}
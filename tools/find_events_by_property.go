@@ -0,0 +1,154 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	zap "go.uber.org/zap"
+
+	server "github.com/inference-gateway/adk/server"
+
+	google "github.com/inference-gateway/google-calendar-agent/internal/google"
+)
+
+// FindEventsByPropertyTool struct holds the tool with dependencies
+type FindEventsByPropertyTool struct {
+	logger *zap.Logger
+	google google.CalendarService
+}
+
+// NewFindEventsByPropertyTool creates a new find_events_by_property tool
+func NewFindEventsByPropertyTool(logger *zap.Logger, google google.CalendarService) server.Tool {
+	tool := &FindEventsByPropertyTool{
+		logger: logger,
+		google: google,
+	}
+	return server.NewBasicTool(
+		"find_events_by_property",
+		"Find events carrying an extended property, such as a correlation key set through privateProperties or sharedProperties",
+		map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"key": map[string]any{
+					"description": "Property key to match (required)",
+					"type":        "string",
+				},
+				"shared": map[string]any{
+					"description": "Match shared properties instead of private ones. Defaults to false.",
+					"type":        "boolean",
+				},
+				"timeMax": map[string]any{
+					"description": "Only return events starting before this time (RFC3339 format). Optional.",
+					"type":        "string",
+				},
+				"timeMin": map[string]any{
+					"description": "Only return events ending after this time (RFC3339 format). Optional; all dates are searched by default.",
+					"type":        "string",
+				},
+				"value": map[string]any{
+					"description": "Property value to match exactly (required)",
+					"type":        "string",
+				},
+			},
+			"required": []string{"key", "value"},
+		},
+		tool.FindEventsByPropertyHandler,
+	)
+}
+
+// FindEventsByPropertyHandler handles the find_events_by_property tool execution
+func (s *FindEventsByPropertyTool) FindEventsByPropertyHandler(ctx context.Context, args map[string]any) (string, error) {
+	span := startToolSpan(ctx, "find_events_by_property")
+	defer span.End()
+	s.logger.Debug("finding events by property", zap.Any("args", args))
+
+	key, ok := args["key"].(string)
+	if !ok || key == "" {
+		return "", fmt.Errorf("key is required")
+	}
+	if strings.Contains(key, "=") {
+		return "", fmt.Errorf("key must not contain '='")
+	}
+
+	value, ok := args["value"].(string)
+	if !ok {
+		return "", fmt.Errorf("value is required")
+	}
+
+	shared := false
+	if v, exists := args["shared"]; exists && v != nil {
+		b, ok := v.(bool)
+		if !ok {
+			return "", fmt.Errorf("shared must be a boolean, got %T", v)
+		}
+		shared = b
+	}
+
+	var timeMin, timeMax time.Time
+	if tm, exists := args["timeMin"]; exists && tm != nil {
+		tmStr, ok := tm.(string)
+		if !ok {
+			return "", fmt.Errorf("timeMin must be a string, got %T", tm)
+		}
+		parsedTime, err := time.Parse(time.RFC3339, tmStr)
+		if err != nil {
+			return "", fmt.Errorf("invalid timeMin format (expected RFC3339): %w", err)
+		}
+		timeMin = parsedTime
+	}
+	if tm, exists := args["timeMax"]; exists && tm != nil {
+		tmStr, ok := tm.(string)
+		if !ok {
+			return "", fmt.Errorf("timeMax must be a string, got %T", tm)
+		}
+		parsedTime, err := time.Parse(time.RFC3339, tmStr)
+		if err != nil {
+			return "", fmt.Errorf("invalid timeMax format (expected RFC3339): %w", err)
+		}
+		timeMax = parsedTime
+	}
+	if !timeMin.IsZero() && !timeMax.IsZero() && !timeMax.After(timeMin) {
+		return "", fmt.Errorf("timeMax must be after timeMin")
+	}
+
+	calendarID := s.google.GetCalendarID()
+	events, err := s.google.ListEventsByProperty(ctx, calendarID, key+"="+value, shared, timeMin, timeMax)
+	if err != nil {
+		s.logger.Error("failed to list events by property", zap.Error(err), zap.String("key", key))
+		return "", fmt.Errorf("failed to list events by property: %w", err)
+	}
+
+	matches := []map[string]any{}
+	for _, event := range events {
+		if event.Status == "cancelled" {
+			continue
+		}
+		match := map[string]any{
+			"eventId":   event.Id,
+			"summary":   event.Summary,
+			"startTime": eventDateTimeString(event.Start),
+			"endTime":   eventDateTimeString(event.End),
+			"htmlLink":  event.HtmlLink,
+		}
+		addExtendedProperties(match, event)
+		matches = append(matches, match)
+	}
+
+	s.logger.Info("events found by property", zap.String("key", key), zap.Int("count", len(matches)))
+
+	result := map[string]any{
+		"success": true,
+		"events":  matches,
+		"count":   len(matches),
+	}
+
+	resultJSON, err := json.Marshal(result)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal result: %w", err)
+	}
+
+	return string(resultJSON), nil
+}
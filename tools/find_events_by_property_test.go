@@ -0,0 +1,183 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	zap "go.uber.org/zap"
+	calendar "google.golang.org/api/calendar/v3"
+
+	config "github.com/inference-gateway/google-calendar-agent/config"
+	google "github.com/inference-gateway/google-calendar-agent/internal/google"
+)
+
+func TestFindEventsByPropertyHandler(t *testing.T) {
+	tagged := &calendar.Event{
+		Id:                 "evt-1",
+		Summary:            "Onboarding",
+		Start:              &calendar.EventDateTime{DateTime: "2026-05-20T10:00:00Z"},
+		End:                &calendar.EventDateTime{DateTime: "2026-05-20T11:00:00Z"},
+		ExtendedProperties: &calendar.EventExtendedProperties{Private: map[string]string{"ticket": "ABC-1"}},
+	}
+
+	tests := []struct {
+		name         string
+		args         map[string]any
+		wantProperty string
+		wantShared   bool
+		wantCount    int
+		wantErrSub   string
+	}{
+		{
+			name:         "private property by default",
+			args:         map[string]any{"key": "ticket", "value": "ABC-1"},
+			wantProperty: "ticket=ABC-1",
+			wantCount:    1,
+		},
+		{
+			name:         "shared property on request",
+			args:         map[string]any{"key": "source", "value": "crm", "shared": true},
+			wantProperty: "source=crm",
+			wantShared:   true,
+			wantCount:    1,
+		},
+		{
+			name:       "missing key returns error",
+			args:       map[string]any{"value": "ABC-1"},
+			wantErrSub: "key is required",
+		},
+		{
+			name:       "key with separator returns error",
+			args:       map[string]any{"key": "ticket=ABC", "value": "1"},
+			wantErrSub: "key must not contain '='",
+		},
+		{
+			name:       "non-boolean shared returns error",
+			args:       map[string]any{"key": "ticket", "value": "ABC-1", "shared": "yes"},
+			wantErrSub: "shared must be a boolean",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			stub := &stubCalendarService{
+				listByPropertyFn: func(calendarID, property string, shared bool, timeMin, timeMax time.Time) ([]*calendar.Event, error) {
+					if property != tc.wantProperty || shared != tc.wantShared {
+						t.Errorf("queried %q shared=%v, want %q shared=%v", property, shared, tc.wantProperty, tc.wantShared)
+					}
+					if !timeMin.IsZero() || !timeMax.IsZero() {
+						t.Errorf("range = %v..%v, want unbounded", timeMin, timeMax)
+					}
+					return []*calendar.Event{tagged}, nil
+				},
+			}
+			tool := &FindEventsByPropertyTool{logger: zap.NewNop(), google: stub}
+			result, err := tool.FindEventsByPropertyHandler(context.Background(), tc.args)
+
+			if tc.wantErrSub != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErrSub) {
+					t.Fatalf("error = %v, want substring %q", err, tc.wantErrSub)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			var parsed struct {
+				Count int `json:"count"`
+			}
+			if err := json.Unmarshal([]byte(result), &parsed); err != nil {
+				t.Fatalf("failed to unmarshal result: %v", err)
+			}
+			if parsed.Count != tc.wantCount {
+				t.Errorf("count = %d, want %d", parsed.Count, tc.wantCount)
+			}
+		})
+	}
+}
+
+func TestExtendedPropertiesRoundTrip(t *testing.T) {
+	t.Setenv("GOOGLE_CALENDAR_TIMEZONE", "UTC")
+	t.Setenv("TZ", "")
+
+	svc := google.NewInMemoryCalendarService(zap.NewNop(), &config.Config{})
+	ctx := context.Background()
+
+	created, err := (&CreateCalendarEventTool{logger: zap.NewNop(), google: svc}).CreateCalendarEventHandler(ctx, map[string]any{
+		"summary":           "Onboarding",
+		"startTime":         "2026-05-20T10:00:00Z",
+		"endTime":           "2026-05-20T11:00:00Z",
+		"privateProperties": map[string]any{"ticket": "ABC-1"},
+	})
+	if err != nil {
+		t.Fatalf("create: %v", err)
+	}
+	var createdEvent struct {
+		EventID           string            `json:"eventId"`
+		PrivateProperties map[string]string `json:"privateProperties"`
+	}
+	if err := json.Unmarshal([]byte(created), &createdEvent); err != nil {
+		t.Fatalf("failed to unmarshal create result: %v", err)
+	}
+	if createdEvent.PrivateProperties["ticket"] != "ABC-1" {
+		t.Errorf("created privateProperties = %v, want ticket=ABC-1", createdEvent.PrivateProperties)
+	}
+
+	_, err = (&UpdateCalendarEventTool{logger: zap.NewNop(), google: svc}).UpdateCalendarEventHandler(ctx, map[string]any{
+		"eventId":          createdEvent.EventID,
+		"sharedProperties": map[string]any{"source": "crm"},
+	})
+	if err != nil {
+		t.Fatalf("update: %v", err)
+	}
+
+	got, err := (&GetCalendarEventTool{logger: zap.NewNop(), google: svc}).GetCalendarEventHandler(ctx, map[string]any{"eventId": createdEvent.EventID})
+	if err != nil {
+		t.Fatalf("get: %v", err)
+	}
+	var fetched struct {
+		PrivateProperties map[string]string `json:"privateProperties"`
+		SharedProperties  map[string]string `json:"sharedProperties"`
+	}
+	if err := json.Unmarshal([]byte(got), &fetched); err != nil {
+		t.Fatalf("failed to unmarshal get result: %v", err)
+	}
+	if fetched.PrivateProperties["ticket"] != "ABC-1" || fetched.SharedProperties["source"] != "crm" {
+		t.Errorf("get properties = private %v shared %v, want the ticket kept and the source added", fetched.PrivateProperties, fetched.SharedProperties)
+	}
+
+	find := &FindEventsByPropertyTool{logger: zap.NewNop(), google: svc}
+	for _, q := range []struct {
+		args      map[string]any
+		wantCount int
+	}{
+		{map[string]any{"key": "ticket", "value": "ABC-1"}, 1},
+		{map[string]any{"key": "source", "value": "crm", "shared": true}, 1},
+		{map[string]any{"key": "source", "value": "crm"}, 0},
+		{map[string]any{"key": "ticket", "value": "ABC-2"}, 0},
+	} {
+		result, err := find.FindEventsByPropertyHandler(ctx, q.args)
+		if err != nil {
+			t.Fatalf("find %v: %v", q.args, err)
+		}
+		var parsed struct {
+			Events []struct {
+				EventID string `json:"eventId"`
+			} `json:"events"`
+			Count int `json:"count"`
+		}
+		if err := json.Unmarshal([]byte(result), &parsed); err != nil {
+			t.Fatalf("failed to unmarshal find result: %v", err)
+		}
+		if parsed.Count != q.wantCount {
+			t.Errorf("find %v: count = %d, want %d", q.args, parsed.Count, q.wantCount)
+		}
+		if q.wantCount > 0 && parsed.Events[0].EventID != createdEvent.EventID {
+			t.Errorf("find %v: eventId = %s, want %s", q.args, parsed.Events[0].EventID, createdEvent.EventID)
+		}
+	}
+}
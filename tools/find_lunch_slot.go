@@ -0,0 +1,124 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	zap "go.uber.org/zap"
+
+	server "github.com/inference-gateway/adk/server"
+
+	google "github.com/inference-gateway/google-calendar-agent/internal/google"
+)
+
+// lunchHours is the midday window find_lunch_slot searches.
+var lunchHours = workingHours{start: 11*time.Hour + 30*time.Minute, end: 14 * time.Hour}
+
+const (
+	minLunchDuration = 30 * time.Minute
+	maxLunchDuration = 60 * time.Minute
+)
+
+// FindLunchSlotTool struct holds the tool with dependencies
+type FindLunchSlotTool struct {
+	logger *zap.Logger
+	google google.CalendarService
+}
+
+// NewFindLunchSlotTool creates a new find_lunch_slot tool
+func NewFindLunchSlotTool(logger *zap.Logger, google google.CalendarService) server.Tool {
+	tool := &FindLunchSlotTool{
+		logger: logger,
+		google: google,
+	}
+	return server.NewBasicTool(
+		"find_lunch_slot",
+		"Find the earliest free 30-60 minute lunch break between 11:30 and 14:00 on a given day",
+		map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"date": map[string]any{
+					"description": "Day to search (YYYY-MM-DD) in the user's timezone. Defaults to today.",
+					"type":        "string",
+				},
+			},
+		},
+		tool.FindLunchSlotHandler,
+	)
+}
+
+// FindLunchSlotHandler handles the find_lunch_slot tool execution
+func (s *FindLunchSlotTool) FindLunchSlotHandler(ctx context.Context, args map[string]any) (string, error) {
+	span := startToolSpan(ctx, "find_lunch_slot")
+	defer span.End()
+	s.logger.Debug("finding lunch slot", zap.Any("args", args))
+
+	calendarID := s.google.GetCalendarID()
+	loc, _ := calendarTimezone(ctx, s.google, calendarID)
+	day := time.Now().In(loc)
+	if d, exists := args["date"]; exists && d != nil {
+		dStr, ok := d.(string)
+		if !ok {
+			return "", fmt.Errorf("date must be a string, got %T", d)
+		}
+		parsed, err := time.ParseInLocation("2006-01-02", dStr, loc)
+		if err != nil {
+			return "", fmt.Errorf("invalid date format (expected YYYY-MM-DD): %w", err)
+		}
+		day = parsed
+	}
+	dayStart := time.Date(day.Year(), day.Month(), day.Day(), 0, 0, 0, 0, loc)
+	window := lunchHours.dailyWindows(dayStart, dayStart.AddDate(0, 0, 1), loc)[0]
+
+	events, err := s.google.ListEvents(ctx, calendarID, window.startTime, window.endTime)
+	if err != nil {
+		s.logger.Error("failed to list events for lunch slot", zap.Error(err))
+		return "", fmt.Errorf("failed to list calendar events: %w", err)
+	}
+
+	busy := mergeBusyPeriods(clipBusyPeriods(eventBusyPeriods(events, loc), window.startTime, window.endTime))
+	slot, found := earliestLunchSlot(busy, window)
+
+	result := map[string]any{
+		"success": true,
+		"date":    dayStart.Format("2006-01-02"),
+		"found":   found,
+	}
+	if found {
+		s.logger.Info("lunch slot found", zap.Time("startTime", slot.startTime))
+		result["startTime"] = slot.startTime.Format(time.RFC3339)
+		result["endTime"] = slot.endTime.Format(time.RFC3339)
+		result["duration"] = int(slot.duration.Minutes())
+	} else {
+		result["message"] = fmt.Sprintf("No free %d-minute window between 11:30 and 14:00 on %s", int(minLunchDuration.Minutes()), dayStart.Format("2006-01-02"))
+	}
+
+	resultJSON, err := json.Marshal(result)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal result: %w", err)
+	}
+
+	return string(resultJSON), nil
+}
+
+// earliestLunchSlot returns the first gap of at least minLunchDuration
+// between the merged busy periods inside window, capped at
+// maxLunchDuration.
+func earliestLunchSlot(busy []timeSlot, window timeSlot) (timeSlot, bool) {
+	free := window.startTime
+	gaps := append(busy, timeSlot{startTime: window.endTime, endTime: window.endTime})
+	for _, b := range gaps {
+		if gap := b.startTime.Sub(free); gap >= minLunchDuration {
+			if gap > maxLunchDuration {
+				gap = maxLunchDuration
+			}
+			return timeSlot{startTime: free, endTime: free.Add(gap), duration: gap}, true
+		}
+		if b.endTime.After(free) {
+			free = b.endTime.In(window.startTime.Location())
+		}
+	}
+	return timeSlot{}, false
+}
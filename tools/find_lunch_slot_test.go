@@ -0,0 +1,94 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	zap "go.uber.org/zap"
+	calendar "google.golang.org/api/calendar/v3"
+)
+
+func TestFindLunchSlotHandler(t *testing.T) {
+	t.Setenv("GOOGLE_CALENDAR_TIMEZONE", "UTC")
+	t.Setenv("TZ", "")
+
+	timed := func(start, end string) *calendar.Event {
+		return &calendar.Event{
+			Start: &calendar.EventDateTime{DateTime: "2026-05-20T" + start + ":00Z"},
+			End:   &calendar.EventDateTime{DateTime: "2026-05-20T" + end + ":00Z"},
+		}
+	}
+
+	tests := []struct {
+		name         string
+		events       []*calendar.Event
+		wantFound    bool
+		wantStart    string
+		wantEnd      string
+		wantDuration float64
+	}{
+		{
+			name:         "free midday takes an hour from 11:30",
+			wantFound:    true,
+			wantStart:    "2026-05-20T11:30:00Z",
+			wantEnd:      "2026-05-20T12:30:00Z",
+			wantDuration: 60,
+		},
+		{
+			name:         "short gaps are skipped for the first half hour",
+			events:       []*calendar.Event{timed("11:00", "11:45"), timed("12:00", "12:50"), timed("13:25", "15:00")},
+			wantFound:    true,
+			wantStart:    "2026-05-20T12:50:00Z",
+			wantEnd:      "2026-05-20T13:25:00Z",
+			wantDuration: 35,
+		},
+		{
+			name:   "no free midday slot",
+			events: []*calendar.Event{timed("11:30", "12:15"), timed("12:30", "13:00"), timed("13:00", "13:40")},
+		},
+		{
+			name:   "all-day event blocks lunch",
+			events: []*calendar.Event{{Start: &calendar.EventDateTime{Date: "2026-05-20"}, End: &calendar.EventDateTime{Date: "2026-05-21"}}},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var gotMin, gotMax time.Time
+			stub := &stubCalendarService{
+				listEventsFn: func(calendarID string, timeMin, timeMax time.Time) ([]*calendar.Event, error) {
+					gotMin, gotMax = timeMin, timeMax
+					return tc.events, nil
+				},
+			}
+			tool := &FindLunchSlotTool{logger: zap.NewNop(), google: stub}
+			result, err := tool.FindLunchSlotHandler(context.Background(), map[string]any{"date": "2026-05-20"})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if gotMin.Format(time.RFC3339) != "2026-05-20T11:30:00Z" || gotMax.Format(time.RFC3339) != "2026-05-20T14:00:00Z" {
+				t.Errorf("searched %s-%s, want 11:30-14:00", gotMin.Format(time.RFC3339), gotMax.Format(time.RFC3339))
+			}
+
+			var parsed map[string]any
+			if err := json.Unmarshal([]byte(result), &parsed); err != nil {
+				t.Fatalf("failed to unmarshal result: %v", err)
+			}
+			if parsed["found"] != tc.wantFound {
+				t.Fatalf("found = %v, want %v (result=%s)", parsed["found"], tc.wantFound, result)
+			}
+			if !tc.wantFound {
+				if parsed["message"] == nil {
+					t.Error("message missing when no slot is free")
+				}
+				return
+			}
+			if parsed["startTime"] != tc.wantStart || parsed["endTime"] != tc.wantEnd || parsed["duration"] != tc.wantDuration {
+				t.Errorf("slot = %v-%v (%v min), want %s-%s (%v min)", parsed["startTime"], parsed["endTime"], parsed["duration"], tc.wantStart, tc.wantEnd, tc.wantDuration)
+			}
+		})
+	}
+}
@@ -0,0 +1,199 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	zap "go.uber.org/zap"
+	calendar "google.golang.org/api/calendar/v3"
+
+	server "github.com/inference-gateway/adk/server"
+
+	google "github.com/inference-gateway/google-calendar-agent/internal/google"
+)
+
+// FindOverlapsTool struct holds the tool with dependencies
+type FindOverlapsTool struct {
+	logger *zap.Logger
+	google google.CalendarService
+}
+
+// NewFindOverlapsTool creates a new find_overlaps tool
+func NewFindOverlapsTool(logger *zap.Logger, google google.CalendarService) server.Tool {
+	tool := &FindOverlapsTool{
+		logger: logger,
+		google: google,
+	}
+	return server.NewBasicTool(
+		"find_overlaps",
+		"Find every pair of events that overlap in time within a range, to clean up double-bookings",
+		map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"excludeAllDay": map[string]any{
+					"description": "Ignore all-day events such as holidays and birthdays. Defaults to true.",
+					"type":        "boolean",
+				},
+				"excludeTransparent": map[string]any{
+					"description": "Ignore events marked as free (transparent). Defaults to true.",
+					"type":        "boolean",
+				},
+				"timeMax": map[string]any{
+					"description": "End of the range to check (RFC3339 format). Defaults to 7 days after timeMin.",
+					"type":        "string",
+				},
+				"timeMin": map[string]any{
+					"description": "Start of the range to check (RFC3339 format). Defaults to now.",
+					"type":        "string",
+				},
+			},
+		},
+		tool.FindOverlapsHandler,
+	)
+}
+
+// timedEvent pairs an event with its parsed time span.
+type timedEvent struct {
+	event *calendar.Event
+	slot  timeSlot
+}
+
+// FindOverlapsHandler handles the find_overlaps tool execution
+func (s *FindOverlapsTool) FindOverlapsHandler(ctx context.Context, args map[string]any) (string, error) {
+	span := startToolSpan(ctx, "find_overlaps")
+	defer span.End()
+	s.logger.Debug("finding overlapping events", zap.Any("args", args))
+
+	timeMin := time.Now()
+	if tm, exists := args["timeMin"]; exists && tm != nil {
+		tmStr, ok := tm.(string)
+		if !ok {
+			return "", fmt.Errorf("timeMin must be a string, got %T", tm)
+		}
+		parsedTime, err := time.Parse(time.RFC3339, tmStr)
+		if err != nil {
+			return "", fmt.Errorf("invalid timeMin format (expected RFC3339): %w", err)
+		}
+		timeMin = parsedTime
+	}
+
+	timeMax := timeMin.AddDate(0, 0, 7)
+	if tm, exists := args["timeMax"]; exists && tm != nil {
+		tmStr, ok := tm.(string)
+		if !ok {
+			return "", fmt.Errorf("timeMax must be a string, got %T", tm)
+		}
+		parsedTime, err := time.Parse(time.RFC3339, tmStr)
+		if err != nil {
+			return "", fmt.Errorf("invalid timeMax format (expected RFC3339): %w", err)
+		}
+		timeMax = parsedTime
+	}
+	if !timeMax.After(timeMin) {
+		return "", fmt.Errorf("timeMax must be after timeMin")
+	}
+
+	excludeAllDay := true
+	if v, exists := args["excludeAllDay"]; exists && v != nil {
+		b, ok := v.(bool)
+		if !ok {
+			return "", fmt.Errorf("excludeAllDay must be a boolean, got %T", v)
+		}
+		excludeAllDay = b
+	}
+
+	excludeTransparent := true
+	if v, exists := args["excludeTransparent"]; exists && v != nil {
+		b, ok := v.(bool)
+		if !ok {
+			return "", fmt.Errorf("excludeTransparent must be a boolean, got %T", v)
+		}
+		excludeTransparent = b
+	}
+
+	calendarID := s.google.GetCalendarID()
+	events, err := s.google.ListEvents(ctx, calendarID, timeMin, timeMax)
+	if err != nil {
+		s.logger.Error("failed to list calendar events", zap.Error(err))
+		return "", fmt.Errorf("failed to list calendar events: %w", err)
+	}
+
+	loc, _ := calendarTimezone(ctx, s.google, calendarID)
+	var timed []timedEvent
+	for _, event := range events {
+		if event.Status == "cancelled" || !google.BlocksTime(event) {
+			continue
+		}
+		if excludeTransparent && event.Transparency == "transparent" {
+			continue
+		}
+		if excludeAllDay && event.Start != nil && event.Start.DateTime == "" {
+			continue
+		}
+		periods := eventBusyPeriods([]*calendar.Event{event}, loc)
+		if len(periods) == 0 {
+			continue
+		}
+		timed = append(timed, timedEvent{event: event, slot: periods[0]})
+	}
+
+	overlaps := overlappingPairs(timed)
+
+	s.logger.Info("overlapping events found", zap.Int("count", len(overlaps)))
+
+	result := map[string]any{
+		"success":  true,
+		"overlaps": overlaps,
+		"count":    len(overlaps),
+	}
+
+	resultJSON, err := json.Marshal(result)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal result: %w", err)
+	}
+
+	return string(resultJSON), nil
+}
+
+// overlappingPairs returns every pair of events whose spans overlap, with
+// how many minutes they share.
+func overlappingPairs(timed []timedEvent) []map[string]any {
+	sort.SliceStable(timed, func(i, j int) bool {
+		return timed[i].slot.startTime.Before(timed[j].slot.startTime)
+	})
+
+	overlaps := []map[string]any{}
+	for i, a := range timed {
+		for _, b := range timed[i+1:] {
+			if !b.slot.startTime.Before(a.slot.endTime) {
+				break
+			}
+			if !a.slot.overlaps(b.slot) {
+				continue
+			}
+			overlapEnd := a.slot.endTime
+			if b.slot.endTime.Before(overlapEnd) {
+				overlapEnd = b.slot.endTime
+			}
+			overlaps = append(overlaps, map[string]any{
+				"first":          overlapEventSummary(a),
+				"second":         overlapEventSummary(b),
+				"overlapMinutes": int(overlapEnd.Sub(b.slot.startTime).Minutes()),
+			})
+		}
+	}
+	return overlaps
+}
+
+// overlapEventSummary describes one side of an overlapping pair.
+func overlapEventSummary(e timedEvent) map[string]any {
+	return map[string]any{
+		"eventId":   e.event.Id,
+		"summary":   e.event.Summary,
+		"startTime": eventDateTimeString(e.event.Start),
+		"endTime":   eventDateTimeString(e.event.End),
+	}
+}
@@ -0,0 +1,134 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	zap "go.uber.org/zap"
+	calendar "google.golang.org/api/calendar/v3"
+)
+
+func TestFindOverlapsHandler(t *testing.T) {
+	t.Setenv("GOOGLE_CALENDAR_TIMEZONE", "UTC")
+	t.Setenv("TZ", "")
+
+	timed := func(id, start, end string) *calendar.Event {
+		return &calendar.Event{
+			Id:    id,
+			Start: &calendar.EventDateTime{DateTime: "2026-05-20T" + start + ":00Z"},
+			End:   &calendar.EventDateTime{DateTime: "2026-05-20T" + end + ":00Z"},
+		}
+	}
+	free := timed("free", "09:30", "10:30")
+	free.Transparency = "transparent"
+	allDay := &calendar.Event{
+		Id:    "holiday",
+		Start: &calendar.EventDateTime{Date: "2026-05-20"},
+		End:   &calendar.EventDateTime{Date: "2026-05-21"},
+	}
+
+	tests := []struct {
+		name        string
+		events      []*calendar.Event
+		args        map[string]any
+		wantPairs   []string
+		wantMinutes []float64
+		wantErrSub  string
+	}{
+		{
+			name:        "overlapping pair is reported",
+			events:      []*calendar.Event{timed("review", "10:00", "11:00"), timed("sync", "10:30", "11:30")},
+			wantPairs:   []string{"review+sync"},
+			wantMinutes: []float64{30},
+		},
+		{
+			name:   "back-to-back events do not overlap",
+			events: []*calendar.Event{timed("standup", "09:00", "09:15"), timed("review", "09:15", "10:00"), timed("lunch", "12:00", "13:00")},
+		},
+		{
+			name:        "an event inside another overlaps both neighbours",
+			events:      []*calendar.Event{timed("late", "10:30", "10:45"), timed("workshop", "09:00", "12:00"), timed("early", "09:30", "10:00")},
+			wantPairs:   []string{"workshop+early", "workshop+late"},
+			wantMinutes: []float64{30, 15},
+		},
+		{
+			name:   "transparent and all-day events are excluded by default",
+			events: []*calendar.Event{timed("review", "10:00", "11:00"), free, allDay},
+		},
+		{
+			name:        "transparent events are included on request",
+			events:      []*calendar.Event{timed("review", "10:00", "11:00"), free, allDay},
+			args:        map[string]any{"excludeTransparent": false},
+			wantPairs:   []string{"free+review"},
+			wantMinutes: []float64{30},
+		},
+		{
+			name:        "all-day events are included on request",
+			events:      []*calendar.Event{timed("review", "10:00", "11:00"), allDay},
+			args:        map[string]any{"excludeAllDay": false},
+			wantPairs:   []string{"holiday+review"},
+			wantMinutes: []float64{60},
+		},
+		{
+			name:       "inverted range returns error",
+			args:       map[string]any{"timeMin": "2026-05-21T00:00:00Z", "timeMax": "2026-05-20T00:00:00Z"},
+			wantErrSub: "timeMax must be after timeMin",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			stub := &stubCalendarService{
+				listEventsFn: func(calendarID string, timeMin, timeMax time.Time) ([]*calendar.Event, error) {
+					return tc.events, nil
+				},
+			}
+			args := map[string]any{"timeMin": "2026-05-20T00:00:00Z", "timeMax": "2026-05-21T00:00:00Z"}
+			for k, v := range tc.args {
+				args[k] = v
+			}
+			tool := &FindOverlapsTool{logger: zap.NewNop(), google: stub}
+			result, err := tool.FindOverlapsHandler(context.Background(), args)
+
+			if tc.wantErrSub != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErrSub) {
+					t.Fatalf("error = %v, want substring %q", err, tc.wantErrSub)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			var parsed struct {
+				Overlaps []struct {
+					First struct {
+						EventID string `json:"eventId"`
+					} `json:"first"`
+					Second struct {
+						EventID string `json:"eventId"`
+					} `json:"second"`
+					OverlapMinutes float64 `json:"overlapMinutes"`
+				} `json:"overlaps"`
+				Count int `json:"count"`
+			}
+			if err := json.Unmarshal([]byte(result), &parsed); err != nil {
+				t.Fatalf("failed to unmarshal result: %v", err)
+			}
+			if parsed.Count != len(tc.wantPairs) || len(parsed.Overlaps) != len(tc.wantPairs) {
+				t.Fatalf("got %d overlaps (count %d), want %d: %s", len(parsed.Overlaps), parsed.Count, len(tc.wantPairs), result)
+			}
+			for i, o := range parsed.Overlaps {
+				if pair := o.First.EventID + "+" + o.Second.EventID; pair != tc.wantPairs[i] {
+					t.Errorf("overlaps[%d] = %s, want %s", i, pair, tc.wantPairs[i])
+				}
+				if o.OverlapMinutes != tc.wantMinutes[i] {
+					t.Errorf("overlaps[%d].overlapMinutes = %v, want %v", i, o.OverlapMinutes, tc.wantMinutes[i])
+				}
+			}
+		})
+	}
+}
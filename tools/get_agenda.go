@@ -0,0 +1,209 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	zap "go.uber.org/zap"
+	calendar "google.golang.org/api/calendar/v3"
+
+	server "github.com/inference-gateway/adk/server"
+
+	google "github.com/inference-gateway/google-calendar-agent/internal/google"
+)
+
+// eventColor is the friendly label and emoji for a Google event colorId
+type eventColor struct {
+	label string
+	emoji string
+}
+
+// eventColors maps Google Calendar's fixed event colorIds to the names the
+// Calendar UI shows for them.
+var eventColors = map[string]eventColor{
+	"1":  {"Lavender", "🪻"},
+	"2":  {"Sage", "🌿"},
+	"3":  {"Grape", "🍇"},
+	"4":  {"Flamingo", "🦩"},
+	"5":  {"Banana", "🍌"},
+	"6":  {"Tangerine", "🍊"},
+	"7":  {"Peacock", "🦚"},
+	"8":  {"Graphite", "✏️"},
+	"9":  {"Blueberry", "🫐"},
+	"10": {"Basil", "🌱"},
+	"11": {"Tomato", "🍅"},
+}
+
+// defaultEventColor marks events that use the calendar's own color
+var defaultEventColor = eventColor{"Calendar default", "•"}
+
+// GetAgendaTool struct holds the tool with dependencies
+type GetAgendaTool struct {
+	logger *zap.Logger
+	google google.CalendarService
+}
+
+// NewGetAgendaTool creates a new get_agenda tool
+func NewGetAgendaTool(logger *zap.Logger, google google.CalendarService) server.Tool {
+	tool := &GetAgendaTool{
+		logger: logger,
+		google: google,
+	}
+	return server.NewBasicTool(
+		"get_agenda",
+		"Get a formatted, color-coded agenda for a day with an emoji legend of event colors",
+		map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"date": map[string]any{
+					"description": "Day to summarize (YYYY-MM-DD) in the user's timezone. Defaults to today.",
+					"type":        "string",
+				},
+			},
+		},
+		tool.GetAgendaHandler,
+	)
+}
+
+// GetAgendaHandler handles the get_agenda tool execution
+func (s *GetAgendaTool) GetAgendaHandler(ctx context.Context, args map[string]any) (string, error) {
+	span := startToolSpan(ctx, "get_agenda")
+	defer span.End()
+	s.logger.Debug("building agenda", zap.Any("args", args))
+
+	calendarID := s.google.GetCalendarID()
+	loc, tzName := calendarTimezone(ctx, s.google, calendarID)
+	format, err := loadDateFormat()
+	if err != nil {
+		return "", err
+	}
+	day := time.Now().In(loc)
+	if d, exists := args["date"]; exists && d != nil {
+		dStr, ok := d.(string)
+		if !ok {
+			return "", fmt.Errorf("date must be a string, got %T", d)
+		}
+		parsed, err := time.ParseInLocation("2006-01-02", dStr, loc)
+		if err != nil {
+			return "", fmt.Errorf("invalid date format (expected YYYY-MM-DD): %w", err)
+		}
+		day = parsed
+	}
+	dayStart := time.Date(day.Year(), day.Month(), day.Day(), 0, 0, 0, 0, loc)
+	dayEnd := dayStart.AddDate(0, 0, 1)
+
+	events, err := s.google.ListEvents(ctx, calendarID, dayStart, dayEnd)
+	if err != nil {
+		s.logger.Error("failed to list events for agenda", zap.Error(err))
+		return "", fmt.Errorf("failed to list calendar events: %w", err)
+	}
+
+	palette := map[string]calendar.ColorDefinition{}
+	if colors, err := s.google.GetColors(ctx); err != nil {
+		s.logger.Warn("failed to fetch color palette, legend will omit hex values", zap.Error(err))
+	} else if colors != nil {
+		palette = colors.Event
+	}
+
+	lines := []string{fmt.Sprintf("Agenda for %s (%s)", format.date(dayStart), tzName)}
+	used := map[string]bool{}
+	for _, event := range events {
+		color := agendaColor(event.ColorId)
+		used[event.ColorId] = true
+		lines = append(lines, fmt.Sprintf("%s %s %s", color.emoji, agendaTimeRange(event, loc, format), event.Summary))
+	}
+	if len(events) == 0 {
+		lines = append(lines, "No events scheduled.")
+	}
+
+	legend := agendaLegend(used, palette)
+	agenda := strings.Join(lines, "\n")
+	if len(legend) > 0 {
+		agenda += "\n\nLegend:\n" + strings.Join(legend, "\n")
+	}
+
+	s.logger.Info("agenda built successfully", zap.Int("count", len(events)))
+
+	result := map[string]any{
+		"success":  true,
+		"date":     dayStart.Format("2006-01-02"),
+		"timezone": tzName,
+		"agenda":   agenda,
+		"legend":   legend,
+		"count":    len(events),
+	}
+
+	resultJSON, err := json.Marshal(result)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal result: %w", err)
+	}
+
+	return string(resultJSON), nil
+}
+
+// agendaColor returns the label and emoji for a colorId, falling back to a
+// generic label for ids Google may add in the future.
+func agendaColor(colorID string) eventColor {
+	if colorID == "" {
+		return defaultEventColor
+	}
+	if c, ok := eventColors[colorID]; ok {
+		return c
+	}
+	return eventColor{fmt.Sprintf("Color %s", colorID), "🎨"}
+}
+
+// agendaTimeRange formats an event's start and end in loc, or "All day"
+// for date-only events.
+func agendaTimeRange(event *calendar.Event, loc *time.Location, format dateFormat) string {
+	if event.Start == nil || event.Start.DateTime == "" {
+		return "All day"
+	}
+	start, err := time.Parse(time.RFC3339, event.Start.DateTime)
+	if err != nil {
+		return event.Start.DateTime
+	}
+	if event.End == nil || event.End.DateTime == "" {
+		return format.clock(start.In(loc))
+	}
+	end, err := time.Parse(time.RFC3339, event.End.DateTime)
+	if err != nil {
+		return format.clock(start.In(loc))
+	}
+	return fmt.Sprintf("%s–%s", format.clock(start.In(loc)), format.clock(end.In(loc)))
+}
+
+// agendaLegend lists the colors used by the agenda in colorId order, with
+// the calendar default last.
+func agendaLegend(used map[string]bool, palette map[string]calendar.ColorDefinition) []string {
+	var ids []string
+	for id := range used {
+		if id != "" {
+			ids = append(ids, id)
+		}
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		if len(ids[i]) != len(ids[j]) {
+			return len(ids[i]) < len(ids[j])
+		}
+		return ids[i] < ids[j]
+	})
+
+	var legend []string
+	for _, id := range ids {
+		color := agendaColor(id)
+		line := fmt.Sprintf("%s %s", color.emoji, color.label)
+		if def, ok := palette[id]; ok && def.Background != "" {
+			line += fmt.Sprintf(" (%s)", def.Background)
+		}
+		legend = append(legend, line)
+	}
+	if used[""] {
+		legend = append(legend, fmt.Sprintf("%s %s", defaultEventColor.emoji, defaultEventColor.label))
+	}
+	return legend
+}
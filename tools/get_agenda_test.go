@@ -0,0 +1,156 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	zap "go.uber.org/zap"
+	calendar "google.golang.org/api/calendar/v3"
+)
+
+func TestGetAgendaHandler(t *testing.T) {
+	t.Setenv("GOOGLE_CALENDAR_TIMEZONE", "UTC")
+
+	events := []*calendar.Event{
+		{
+			Summary: "Standup",
+			ColorId: "11",
+			Start:   &calendar.EventDateTime{DateTime: "2026-05-23T09:00:00Z"},
+			End:     &calendar.EventDateTime{DateTime: "2026-05-23T09:15:00Z"},
+		},
+		{
+			Summary: "Offsite",
+			ColorId: "2",
+			Start:   &calendar.EventDateTime{Date: "2026-05-23"},
+			End:     &calendar.EventDateTime{Date: "2026-05-24"},
+		},
+		{
+			Summary: "Lunch",
+			Start:   &calendar.EventDateTime{DateTime: "2026-05-23T12:00:00Z"},
+			End:     &calendar.EventDateTime{DateTime: "2026-05-23T13:00:00Z"},
+		},
+	}
+	palette := &calendar.Colors{Event: map[string]calendar.ColorDefinition{
+		"2":  {Background: "#7ae7bf"},
+		"11": {Background: "#dc2127"},
+	}}
+
+	tests := []struct {
+		name       string
+		args       map[string]any
+		events     []*calendar.Event
+		colors     *calendar.Colors
+		colorsErr  error
+		wantErrSub string
+		wantLines  []string
+		wantLegend []string
+	}{
+		{
+			name:   "maps colorIds to legend with palette hex",
+			args:   map[string]any{"date": "2026-05-23"},
+			events: events,
+			colors: palette,
+			wantLines: []string{
+				"Agenda for Saturday, May 23 2026 (UTC)",
+				"🍅 09:00–09:15 Standup",
+				"🌿 All day Offsite",
+				"• 12:00–13:00 Lunch",
+			},
+			wantLegend: []string{
+				"🌿 Sage (#7ae7bf)",
+				"🍅 Tomato (#dc2127)",
+				"• Calendar default",
+			},
+		},
+		{
+			name:       "palette failure still yields legend labels",
+			args:       map[string]any{"date": "2026-05-23"},
+			events:     events[:1],
+			colorsErr:  errors.New("boom"),
+			wantLines:  []string{"🍅 09:00–09:15 Standup"},
+			wantLegend: []string{"🍅 Tomato"},
+		},
+		{
+			name:       "unknown colorId gets a generic label",
+			args:       map[string]any{"date": "2026-05-23"},
+			events:     []*calendar.Event{{Summary: "New", ColorId: "12", Start: &calendar.EventDateTime{Date: "2026-05-23"}}},
+			colors:     palette,
+			wantLines:  []string{"🎨 All day New"},
+			wantLegend: []string{"🎨 Color 12"},
+		},
+		{
+			name:      "empty day",
+			args:      map[string]any{"date": "2026-05-23"},
+			colors:    palette,
+			wantLines: []string{"No events scheduled."},
+		},
+		{
+			name:       "invalid date",
+			args:       map[string]any{"date": "23/05/2026"},
+			wantErrSub: "invalid date format",
+		},
+		{
+			name:       "non-string date",
+			args:       map[string]any{"date": float64(1)},
+			wantErrSub: "date must be a string",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var gotMin, gotMax time.Time
+			stub := &stubCalendarService{
+				listEventsFn: func(calendarID string, timeMin, timeMax time.Time) ([]*calendar.Event, error) {
+					gotMin, gotMax = timeMin, timeMax
+					return tt.events, nil
+				},
+				getColorsFn: func() (*calendar.Colors, error) {
+					return tt.colors, tt.colorsErr
+				},
+			}
+			tool := &GetAgendaTool{logger: zap.NewNop(), google: stub}
+
+			out, err := tool.GetAgendaHandler(context.Background(), tt.args)
+			if tt.wantErrSub != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErrSub) {
+					t.Fatalf("error = %v, want containing %q", err, tt.wantErrSub)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if want := "2026-05-23T00:00:00Z"; gotMin.Format(time.RFC3339) != want {
+				t.Errorf("timeMin = %s, want %s", gotMin.Format(time.RFC3339), want)
+			}
+			if want := "2026-05-24T00:00:00Z"; gotMax.Format(time.RFC3339) != want {
+				t.Errorf("timeMax = %s, want %s", gotMax.Format(time.RFC3339), want)
+			}
+
+			var result struct {
+				Agenda string   `json:"agenda"`
+				Legend []string `json:"legend"`
+				Count  int      `json:"count"`
+			}
+			if err := json.Unmarshal([]byte(out), &result); err != nil {
+				t.Fatalf("invalid JSON: %v", err)
+			}
+			for _, line := range tt.wantLines {
+				if !strings.Contains(result.Agenda, line+"\n") && !strings.HasSuffix(result.Agenda, line) {
+					t.Errorf("agenda missing line %q:\n%s", line, result.Agenda)
+				}
+			}
+			if strings.Join(result.Legend, "|") != strings.Join(tt.wantLegend, "|") {
+				t.Errorf("legend = %q, want %q", result.Legend, tt.wantLegend)
+			}
+			if result.Count != len(tt.events) {
+				t.Errorf("count = %d, want %d", result.Count, len(tt.events))
+			}
+		})
+	}
+}
@@ -0,0 +1,131 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"time"
+
+	zap "go.uber.org/zap"
+	calendar "google.golang.org/api/calendar/v3"
+
+	server "github.com/inference-gateway/adk/server"
+
+	google "github.com/inference-gateway/google-calendar-agent/internal/google"
+)
+
+// GetBusynessTool struct holds the tool with dependencies
+type GetBusynessTool struct {
+	logger *zap.Logger
+	google google.CalendarService
+}
+
+// NewGetBusynessTool creates a new get_busyness tool
+func NewGetBusynessTool(logger *zap.Logger, google google.CalendarService) server.Tool {
+	tool := &GetBusynessTool{
+		logger: logger,
+		google: google,
+	}
+	return server.NewBasicTool(
+		"get_busyness",
+		"Report how booked a day is: the percentage of its working hours taken up by events, ignoring events marked as free",
+		map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"date": map[string]any{
+					"description": "Day to measure (YYYY-MM-DD) in the user's timezone. Defaults to today.",
+					"type":        "string",
+				},
+			},
+		},
+		tool.GetBusynessHandler,
+	)
+}
+
+// GetBusynessHandler handles the get_busyness tool execution
+func (s *GetBusynessTool) GetBusynessHandler(ctx context.Context, args map[string]any) (string, error) {
+	span := startToolSpan(ctx, "get_busyness")
+	defer span.End()
+	s.logger.Debug("measuring busyness", zap.Any("args", args))
+
+	calendarID := s.google.GetCalendarID()
+	loc, _ := calendarTimezone(ctx, s.google, calendarID)
+	day := time.Now().In(loc)
+	if d, exists := args["date"]; exists && d != nil {
+		dStr, ok := d.(string)
+		if !ok {
+			return "", fmt.Errorf("date must be a string, got %T", d)
+		}
+		parsed, err := time.ParseInLocation("2006-01-02", dStr, loc)
+		if err != nil {
+			return "", fmt.Errorf("invalid date format (expected YYYY-MM-DD): %w", err)
+		}
+		day = parsed
+	}
+
+	hours, err := loadWorkingHours()
+	if err != nil {
+		return "", err
+	}
+
+	result := map[string]any{
+		"success": true,
+		"date":    day.Format("2006-01-02"),
+	}
+
+	workStart, workEnd, ok := hours.window(day)
+	if !ok {
+		result["busyPercent"] = 0
+		result["busyMinutes"] = 0
+		result["workingMinutes"] = 0
+		result["message"] = fmt.Sprintf("%s is not a working day.", day.Format("Monday"))
+	} else {
+		events, err := s.google.ListEvents(ctx, calendarID, workStart, workEnd)
+		if err != nil {
+			s.logger.Error("failed to list events for busyness", zap.Error(err))
+			return "", fmt.Errorf("failed to list calendar events: %w", err)
+		}
+
+		busy := busyTime(events, workStart, workEnd, loc)
+		working := workEnd.Sub(workStart)
+		percent := int(math.Round(100 * busy.Seconds() / working.Seconds()))
+
+		s.logger.Info("busyness measured",
+			zap.String("date", day.Format("2006-01-02")),
+			zap.Int("busyPercent", percent))
+
+		result["busyPercent"] = percent
+		result["busyMinutes"] = int(busy.Minutes())
+		result["workingMinutes"] = int(working.Minutes())
+		result["workingHours"] = map[string]string{
+			"startTime": workStart.Format(time.RFC3339),
+			"endTime":   workEnd.Format(time.RFC3339),
+		}
+	}
+
+	resultJSON, err := json.Marshal(result)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal result: %w", err)
+	}
+
+	return string(resultJSON), nil
+}
+
+// busyTime returns how much of [start, end) the events occupy, counting
+// overlapping events once. Cancelled and transparent events are free.
+func busyTime(events []*calendar.Event, start, end time.Time, loc *time.Location) time.Duration {
+	var blocking []*calendar.Event
+	for _, event := range events {
+		if event.Status == "cancelled" || event.Transparency == "transparent" {
+			continue
+		}
+		blocking = append(blocking, event)
+	}
+
+	var total time.Duration
+	for _, period := range clipBusyPeriods(mergeBusyPeriods(eventBusyPeriods(blocking, loc)), start, end) {
+		total += period.duration
+	}
+	return total
+}
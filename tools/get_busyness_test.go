@@ -0,0 +1,110 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	zap "go.uber.org/zap"
+	calendar "google.golang.org/api/calendar/v3"
+)
+
+func TestGetBusynessHandler(t *testing.T) {
+	t.Setenv("GOOGLE_CALENDAR_TIMEZONE", "UTC")
+	t.Setenv("TZ", "")
+	t.Setenv("GOOGLE_CALENDAR_WORKING_HOURS_START", "09:00")
+	t.Setenv("GOOGLE_CALENDAR_WORKING_HOURS_END", "17:00")
+
+	timed := func(start, end string) *calendar.Event {
+		return &calendar.Event{
+			Id:    "evt-" + start,
+			Start: &calendar.EventDateTime{DateTime: start},
+			End:   &calendar.EventDateTime{DateTime: end},
+		}
+	}
+	free := timed("2026-05-18T13:00:00Z", "2026-05-18T17:00:00Z")
+	free.Transparency = "transparent"
+
+	tests := []struct {
+		name        string
+		date        string
+		events      []*calendar.Event
+		wantPercent int
+		wantBusy    int
+		wantMessage string
+		wantErrSub  string
+	}{
+		{
+			name:        "empty day",
+			date:        "2026-05-18",
+			wantPercent: 0,
+		},
+		{
+			name:        "fully booked day",
+			date:        "2026-05-18",
+			events:      []*calendar.Event{timed("2026-05-18T08:00:00Z", "2026-05-18T12:30:00Z"), timed("2026-05-18T12:00:00Z", "2026-05-18T18:00:00Z")},
+			wantPercent: 100,
+			wantBusy:    480,
+		},
+		{
+			name:        "overlaps count once and free events are ignored",
+			date:        "2026-05-18",
+			events:      []*calendar.Event{timed("2026-05-18T09:00:00Z", "2026-05-18T10:00:00Z"), timed("2026-05-18T09:30:00Z", "2026-05-18T11:00:00Z"), free},
+			wantPercent: 25,
+			wantBusy:    120,
+		},
+		{
+			name:        "weekend",
+			date:        "2026-05-23",
+			wantPercent: 0,
+			wantMessage: "Saturday is not a working day.",
+		},
+		{
+			name:       "invalid date",
+			date:       "May 18",
+			wantErrSub: "invalid date format",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			stub := &stubCalendarService{
+				listEventsFn: func(calendarID string, timeMin, timeMax time.Time) ([]*calendar.Event, error) {
+					return tc.events, nil
+				},
+			}
+			tool := &GetBusynessTool{logger: zap.NewNop(), google: stub}
+			result, err := tool.GetBusynessHandler(context.Background(), map[string]any{"date": tc.date})
+
+			if tc.wantErrSub != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErrSub) {
+					t.Fatalf("error = %v, want substring %q", err, tc.wantErrSub)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			var parsed struct {
+				BusyPercent int    `json:"busyPercent"`
+				BusyMinutes int    `json:"busyMinutes"`
+				Message     string `json:"message"`
+			}
+			if err := json.Unmarshal([]byte(result), &parsed); err != nil {
+				t.Fatalf("failed to unmarshal result: %v", err)
+			}
+			if parsed.BusyPercent != tc.wantPercent {
+				t.Errorf("busyPercent = %d, want %d", parsed.BusyPercent, tc.wantPercent)
+			}
+			if parsed.BusyMinutes != tc.wantBusy {
+				t.Errorf("busyMinutes = %d, want %d", parsed.BusyMinutes, tc.wantBusy)
+			}
+			if parsed.Message != tc.wantMessage {
+				t.Errorf("message = %q, want %q", parsed.Message, tc.wantMessage)
+			}
+		})
+	}
+}
@@ -53,10 +53,10 @@ func (s *GetCalendarEventTool) GetCalendarEventHandler(ctx context.Context, args
 	}
 
 	calendarID := s.google.GetCalendarID()
-	event, err := s.google.GetEvent(calendarID, eventID)
+	event, err := s.google.GetEvent(ctx, calendarID, eventID)
 	if err != nil {
 		s.logger.Error("failed to get calendar event", zap.Error(err), zap.String("eventId", eventID))
-		return "", fmt.Errorf("failed to get calendar event: %w", err)
+		return "", fmt.Errorf("failed to get calendar event on '%s': %w", calendarLabel(ctx, s.google, calendarID), err)
 	}
 
 	s.logger.Info("calendar event retrieved successfully",
@@ -81,10 +81,23 @@ func (s *GetCalendarEventTool) GetCalendarEventHandler(ctx context.Context, args
 	}
 	if event.Location != "" {
 		result["location"] = event.Location
+		if link := mapsLink(event.Location); link != "" {
+			result["mapsLink"] = link
+		}
+	}
+	if len(event.Recurrence) > 0 {
+		result["recurrence"] = event.Recurrence
+		result["rule"] = humanizeRecurrence(event.Recurrence)
 	}
 	if event.HtmlLink != "" {
 		result["htmlLink"] = event.HtmlLink
 	}
+	if viewLink := viewLinkFor(calendarID, event); viewLink != "" {
+		result["viewLink"] = viewLink
+	}
+	if attachments := attachmentPreviews(event); len(attachments) > 0 {
+		result["attachments"] = attachments
+	}
 	if len(event.Attendees) > 0 {
 		var attendees []string
 		for _, attendee := range event.Attendees {
@@ -92,6 +105,7 @@ func (s *GetCalendarEventTool) GetCalendarEventHandler(ctx context.Context, args
 		}
 		result["attendees"] = attendees
 	}
+	addExtendedProperties(result, event)
 
 	resultJSON, err := json.Marshal(result)
 	if err != nil {
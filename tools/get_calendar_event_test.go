@@ -26,6 +26,12 @@ func TestGetCalendarEventHandler(t *testing.T) {
 			{Email: "b@example.com"},
 		},
 	}
+	recurringEvent := &calendar.Event{
+		Id:         "evt-rec",
+		Summary:    "Standup",
+		Status:     "confirmed",
+		Recurrence: []string{"RRULE:FREQ=WEEKLY;BYDAY=MO;COUNT=10"},
+	}
 	minimalEvent := &calendar.Event{
 		Id:      "evt-min",
 		Summary: "Bare event",
@@ -53,11 +59,24 @@ func TestGetCalendarEventHandler(t *testing.T) {
 				"summary":     "Quarterly review",
 				"description": "Discuss Q2 metrics",
 				"location":    "HQ-3F",
+				"mapsLink":    "https://www.google.com/maps/search/?api=1&query=HQ-3F",
 				"htmlLink":    "https://example.com/evt-1",
 				"startTime":   "2026-05-23T10:00:00Z",
 				"endTime":     "2026-05-23T11:00:00Z",
 			},
 		},
+		{
+			name: "recurring event carries a readable rule",
+			args: map[string]any{"eventId": "evt-rec"},
+			getEventFn: func(calendarID, eventID string) (*calendar.Event, error) {
+				return recurringEvent, nil
+			},
+			wantFields: map[string]any{
+				"success": true,
+				"eventId": "evt-rec",
+				"rule":    "Weekly on Monday, 10 times",
+			},
+		},
 		{
 			name: "minimal event omits empty optional fields",
 			args: map[string]any{"eventId": "evt-min"},
@@ -69,7 +88,7 @@ func TestGetCalendarEventHandler(t *testing.T) {
 				"eventId": "evt-min",
 				"summary": "Bare event",
 			},
-			wantNoKey: []string{"description", "location", "htmlLink", "attendees"},
+			wantNoKey: []string{"description", "location", "mapsLink", "htmlLink", "attendees", "attachments", "rule", "recurrence"},
 		},
 		{
 			name:       "missing eventId returns error",
@@ -0,0 +1,119 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	zap "go.uber.org/zap"
+
+	server "github.com/inference-gateway/adk/server"
+
+	google "github.com/inference-gateway/google-calendar-agent/internal/google"
+)
+
+// settingsTimezone holds the IANA timezone from the user's Google Calendar
+// settings. resolveTimezone prefers it over a GOOGLE_CALENDAR_TIMEZONE left
+// at UTC.
+var settingsTimezone atomic.Value
+
+// UseCalendarSettingsTimezone loads the user's timezone from their Google
+// Calendar settings so tools can use it when GOOGLE_CALENDAR_TIMEZONE is not
+// set to something other than UTC.
+func UseCalendarSettingsTimezone(ctx context.Context, svc google.CalendarService) (string, error) {
+	timezone, _, err := svc.GetCalendarSettings(ctx)
+	if err != nil {
+		return "", fmt.Errorf("failed to get calendar settings: %w", err)
+	}
+	rememberSettingsTimezone(timezone)
+	return timezone, nil
+}
+
+func rememberSettingsTimezone(timezone string) {
+	if _, err := time.LoadLocation(timezone); timezone == "" || err != nil {
+		return
+	}
+	settingsTimezone.Store(timezone)
+}
+
+func loadSettingsTimezone() string {
+	timezone, _ := settingsTimezone.Load().(string)
+	return timezone
+}
+
+// GetCalendarSettingsTool struct holds the tool with dependencies
+type GetCalendarSettingsTool struct {
+	logger *zap.Logger
+	google google.CalendarService
+}
+
+// NewGetCalendarSettingsTool creates a new get_calendar_settings tool
+func NewGetCalendarSettingsTool(logger *zap.Logger, google google.CalendarService) server.Tool {
+	tool := &GetCalendarSettingsTool{
+		logger: logger,
+		google: google,
+	}
+	return server.NewBasicTool(
+		"get_calendar_settings",
+		"Get the user's Google Calendar settings: their timezone and the first day of the week",
+		map[string]any{
+			"type":       "object",
+			"properties": map[string]any{},
+		},
+		tool.GetCalendarSettingsHandler,
+	)
+}
+
+// GetCalendarSettingsHandler handles the get_calendar_settings tool execution
+func (s *GetCalendarSettingsTool) GetCalendarSettingsHandler(ctx context.Context, args map[string]any) (string, error) {
+	span := startToolSpan(ctx, "get_calendar_settings")
+	defer span.End()
+	s.logger.Debug("getting calendar settings", zap.Any("args", args))
+
+	timezone, weekStart, err := s.google.GetCalendarSettings(ctx)
+	if err != nil {
+		s.logger.Error("failed to get calendar settings", zap.Error(err))
+		return "", fmt.Errorf("failed to get calendar settings: %w", err)
+	}
+	rememberSettingsTimezone(timezone)
+
+	_, agentTimezone, source := resolveTimezone()
+	s.logger.Info("calendar settings retrieved successfully",
+		zap.String("timezone", timezone),
+		zap.String("weekStart", weekStart))
+
+	result := map[string]any{
+		"success":             true,
+		"timezone":            timezone,
+		"weekStart":           weekStart,
+		"agentTimezone":       agentTimezone,
+		"agentTimezoneSource": source,
+	}
+	if day, ok := weekStartDay(weekStart); ok {
+		result["weekStartDay"] = day.String()
+	}
+
+	resultJSON, err := json.Marshal(result)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal result: %w", err)
+	}
+
+	return string(resultJSON), nil
+}
+
+// weekStartDay maps Google's weekStart setting ("0" Sunday, "1" Monday,
+// "6" Saturday) to a weekday.
+func weekStartDay(weekStart string) (time.Weekday, bool) {
+	switch weekStart {
+	case "0":
+		return time.Sunday, true
+	case "1":
+		return time.Monday, true
+	case "6":
+		return time.Saturday, true
+	default:
+		return time.Sunday, false
+	}
+}
@@ -0,0 +1,119 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	zap "go.uber.org/zap"
+)
+
+func TestGetCalendarSettingsHandler(t *testing.T) {
+	tests := []struct {
+		name         string
+		gctz         string
+		timezone     string
+		weekStart    string
+		settingsErr  error
+		wantErrSub   string
+		wantWeekDay  string
+		wantAgentTZ  string
+		wantTZSource string
+	}{
+		{
+			name:         "settings timezone overrides a UTC config",
+			gctz:         "UTC",
+			timezone:     "Europe/Berlin",
+			weekStart:    "1",
+			wantWeekDay:  "Monday",
+			wantAgentTZ:  "Europe/Berlin",
+			wantTZSource: "google_calendar_settings",
+		},
+		{
+			name:         "explicit config timezone wins over settings",
+			gctz:         "America/New_York",
+			timezone:     "Europe/Berlin",
+			weekStart:    "0",
+			wantWeekDay:  "Sunday",
+			wantAgentTZ:  "America/New_York",
+			wantTZSource: "GOOGLE_CALENDAR_TIMEZONE",
+		},
+		{
+			name:         "unknown settings timezone is ignored",
+			gctz:         "UTC",
+			timezone:     "Mars/Olympus",
+			weekStart:    "1",
+			wantWeekDay:  "Monday",
+			wantAgentTZ:  "UTC",
+			wantTZSource: "GOOGLE_CALENDAR_TIMEZONE",
+		},
+		{
+			name:        "settings failure is wrapped",
+			settingsErr: errors.New("403"),
+			wantErrSub:  "failed to get calendar settings",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Setenv("GOOGLE_CALENDAR_TIMEZONE", tc.gctz)
+			t.Setenv("TZ", "")
+			settingsTimezone.Store("")
+			t.Cleanup(func() { settingsTimezone.Store("") })
+
+			stub := &stubCalendarService{
+				getSettingsFn: func() (string, string, error) {
+					return tc.timezone, tc.weekStart, tc.settingsErr
+				},
+			}
+			tool := &GetCalendarSettingsTool{logger: zap.NewNop(), google: stub}
+			result, err := tool.GetCalendarSettingsHandler(context.Background(), map[string]any{})
+
+			if tc.wantErrSub != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErrSub) {
+					t.Fatalf("error = %v, want substring %q", err, tc.wantErrSub)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			var parsed map[string]any
+			if err := json.Unmarshal([]byte(result), &parsed); err != nil {
+				t.Fatalf("failed to unmarshal result: %v", err)
+			}
+			if parsed["timezone"] != tc.timezone {
+				t.Errorf("timezone = %v, want %v", parsed["timezone"], tc.timezone)
+			}
+			if parsed["weekStartDay"] != tc.wantWeekDay {
+				t.Errorf("weekStartDay = %v, want %v", parsed["weekStartDay"], tc.wantWeekDay)
+			}
+			if parsed["agentTimezone"] != tc.wantAgentTZ {
+				t.Errorf("agentTimezone = %v, want %v", parsed["agentTimezone"], tc.wantAgentTZ)
+			}
+			if parsed["agentTimezoneSource"] != tc.wantTZSource {
+				t.Errorf("agentTimezoneSource = %v, want %v", parsed["agentTimezoneSource"], tc.wantTZSource)
+			}
+		})
+	}
+}
+
+func TestUseCalendarSettingsTimezone(t *testing.T) {
+	t.Setenv("GOOGLE_CALENDAR_TIMEZONE", "")
+	t.Setenv("TZ", "America/Los_Angeles")
+	settingsTimezone.Store("")
+	t.Cleanup(func() { settingsTimezone.Store("") })
+
+	stub := &stubCalendarService{
+		getSettingsFn: func() (string, string, error) { return "Asia/Tokyo", "1", nil },
+	}
+	if _, err := UseCalendarSettingsTimezone(context.Background(), stub); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if _, name, source := resolveTimezone(); name != "Asia/Tokyo" || source != "google_calendar_settings" {
+		t.Errorf("resolveTimezone = %s (%s), want Asia/Tokyo from settings", name, source)
+	}
+}
@@ -24,30 +24,50 @@ func NewGetCurrentDatetimeTool(logger *zap.Logger) server.Tool {
 	}
 	return server.NewBasicTool(
 		"get_current_datetime",
-		"Return the current date/time and the user's IANA timezone. Call this FIRST for any time-relative request (today, tomorrow, next Friday) before emitting RFC3339 timestamps to other calendar tools, so events land in the user's local timezone instead of an LLM-assumed default.",
+		"Return the current date/time and the user's IANA timezone. Call this FIRST for any time-relative request (today, tomorrow, next Friday, in 30 minutes) before emitting RFC3339 timestamps to other calendar tools, so events land in the user's local timezone instead of an LLM-assumed default.",
 		map[string]any{
-			"type":       "object",
-			"properties": map[string]any{},
+			"type": "object",
+			"properties": map[string]any{
+				"offsetHours": map[string]any{
+					"description": "Hours to add to now for requests like \"in 2 hours\". The result is returned as offset_time. Optional.",
+					"minimum":     0,
+					"type":        "integer",
+				},
+				"offsetMinutes": map[string]any{
+					"description": "Minutes to add to now for requests like \"in 30 minutes\"; combines with offsetHours for \"in 1 hour 30 minutes\". Optional.",
+					"minimum":     0,
+					"type":        "integer",
+				},
+			},
 		},
 		tool.GetCurrentDatetimeHandler,
 	)
 }
 
 // resolveTimezone picks the user's timezone in this order:
-//  1. GOOGLE_CALENDAR_TIMEZONE (agent-specific override)
-//  2. TZ (standard POSIX system timezone)
-//  3. UTC fallback
+//  1. GOOGLE_CALENDAR_TIMEZONE (agent-specific override) unless it is UTC
+//  2. The timezone from the user's Google Calendar settings, if loaded
+//  3. GOOGLE_CALENDAR_TIMEZONE=UTC
+//  4. TZ (standard POSIX system timezone)
+//  5. UTC fallback
 //
 // Returns the loaded *time.Location, the IANA name reported back to the
 // LLM, and the source label for logging.
 func resolveTimezone() (*time.Location, string, string) {
-	candidates := []struct {
+	type candidate struct {
 		name  string
 		value string
-	}{
-		{"GOOGLE_CALENDAR_TIMEZONE", os.Getenv("GOOGLE_CALENDAR_TIMEZONE")},
-		{"TZ", os.Getenv("TZ")},
 	}
+	configured := os.Getenv("GOOGLE_CALENDAR_TIMEZONE")
+	var candidates []candidate
+	if configured != "UTC" {
+		candidates = append(candidates, candidate{"GOOGLE_CALENDAR_TIMEZONE", configured})
+	}
+	candidates = append(candidates, candidate{"google_calendar_settings", loadSettingsTimezone()})
+	if configured == "UTC" {
+		candidates = append(candidates, candidate{"GOOGLE_CALENDAR_TIMEZONE", configured})
+	}
+	candidates = append(candidates, candidate{"TZ", os.Getenv("TZ")})
 	for _, c := range candidates {
 		if c.value == "" {
 			continue
@@ -68,6 +88,17 @@ func (t *GetCurrentDatetimeTool) GetCurrentDatetimeHandler(ctx context.Context,
 	loc, tzName, source := resolveTimezone()
 	now := time.Now().In(loc)
 
+	offset, err := relativeOffset(args)
+	if err != nil {
+		return "", err
+	}
+
+	firstDay, err := loadWeekStart()
+	if err != nil {
+		return "", err
+	}
+	weekStart, weekEnd := weekBounds(now, loc, firstDay)
+
 	t.logger.Debug("resolved current datetime",
 		zap.String("timezone", tzName),
 		zap.String("source", source),
@@ -81,6 +112,11 @@ func (t *GetCurrentDatetimeTool) GetCurrentDatetimeHandler(ctx context.Context,
 		"date":            now.Format("2006-01-02"),
 		"time":            now.Format("15:04:05"),
 		"utc_offset":      now.Format("-07:00"),
+		"week_start":      weekStart.Format(time.RFC3339),
+		"week_end":        weekEnd.Format(time.RFC3339),
+	}
+	if offset > 0 {
+		result["offset_time"] = now.Add(offset).Format(time.RFC3339)
 	}
 
 	resultJSON, err := json.Marshal(result)
@@ -89,3 +125,27 @@ func (t *GetCurrentDatetimeTool) GetCurrentDatetimeHandler(ctx context.Context,
 	}
 	return string(resultJSON), nil
 }
+
+// relativeOffset reads offsetHours and offsetMinutes, so "in 1 hour 30
+// minutes" is computed here rather than by the model.
+func relativeOffset(args map[string]any) (time.Duration, error) {
+	var offset time.Duration
+	for _, field := range []struct {
+		name string
+		unit time.Duration
+	}{{"offsetHours", time.Hour}, {"offsetMinutes", time.Minute}} {
+		v, exists := args[field.name]
+		if !exists || v == nil {
+			continue
+		}
+		n, ok := v.(float64)
+		if !ok {
+			return 0, fmt.Errorf("%s must be a number, got %T", field.name, v)
+		}
+		if n < 0 || n != float64(int64(n)) {
+			return 0, fmt.Errorf("%s must be a non-negative whole number, got %v", field.name, n)
+		}
+		offset += time.Duration(n) * field.unit
+	}
+	return offset, nil
+}
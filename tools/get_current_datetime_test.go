@@ -1,7 +1,13 @@
 package tools
 
 import (
+	"context"
+	"encoding/json"
+	"strings"
 	"testing"
+	"time"
+
+	zap "go.uber.org/zap"
 )
 
 func TestResolveTimezone(t *testing.T) {
@@ -67,3 +73,68 @@ func TestResolveTimezone(t *testing.T) {
 		})
 	}
 }
+
+func TestGetCurrentDatetimeOffset(t *testing.T) {
+	t.Setenv("GOOGLE_CALENDAR_TIMEZONE", "Europe/Berlin")
+	t.Setenv("TZ", "")
+
+	tests := []struct {
+		name       string
+		args       map[string]any
+		want       time.Duration
+		wantErrSub string
+	}{
+		{name: "no offset omits offset_time", args: map[string]any{}},
+		{name: "in 30 minutes", args: map[string]any{"offsetMinutes": float64(30)}, want: 30 * time.Minute},
+		{name: "in 2 hours", args: map[string]any{"offsetHours": float64(2)}, want: 2 * time.Hour},
+		{
+			name: "in 1 hour 30 minutes",
+			args: map[string]any{"offsetHours": float64(1), "offsetMinutes": float64(30)},
+			want: 90 * time.Minute,
+		},
+		{name: "negative offsets are rejected", args: map[string]any{"offsetMinutes": float64(-5)}, wantErrSub: "non-negative whole number"},
+		{name: "fractional offsets are rejected", args: map[string]any{"offsetHours": 1.5}, wantErrSub: "non-negative whole number"},
+		{name: "wrong-typed offsets are rejected", args: map[string]any{"offsetHours": "2"}, wantErrSub: "offsetHours must be a number"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			tool := &GetCurrentDatetimeTool{logger: zap.NewNop()}
+			result, err := tool.GetCurrentDatetimeHandler(context.Background(), tc.args)
+			if tc.wantErrSub != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErrSub) {
+					t.Fatalf("error = %v, want substring %q", err, tc.wantErrSub)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			var parsed struct {
+				Now        string `json:"now"`
+				OffsetTime string `json:"offset_time"`
+			}
+			if err := json.Unmarshal([]byte(result), &parsed); err != nil {
+				t.Fatalf("failed to unmarshal result: %v", err)
+			}
+			if tc.want == 0 {
+				if parsed.OffsetTime != "" {
+					t.Errorf("offset_time = %q, want omitted", parsed.OffsetTime)
+				}
+				return
+			}
+			now, err := time.Parse(time.RFC3339, parsed.Now)
+			if err != nil {
+				t.Fatalf("invalid now %q: %v", parsed.Now, err)
+			}
+			offsetTime, err := time.Parse(time.RFC3339, parsed.OffsetTime)
+			if err != nil {
+				t.Fatalf("invalid offset_time %q: %v", parsed.OffsetTime, err)
+			}
+			if got := offsetTime.Sub(now); got != tc.want {
+				t.Errorf("offset_time - now = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
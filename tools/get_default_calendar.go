@@ -0,0 +1,57 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	zap "go.uber.org/zap"
+
+	server "github.com/inference-gateway/adk/server"
+
+	google "github.com/inference-gateway/google-calendar-agent/internal/google"
+)
+
+// GetDefaultCalendarTool struct holds the tool with dependencies
+type GetDefaultCalendarTool struct {
+	logger *zap.Logger
+	google google.CalendarService
+}
+
+// NewGetDefaultCalendarTool creates a new get_default_calendar tool
+func NewGetDefaultCalendarTool(logger *zap.Logger, google google.CalendarService) server.Tool {
+	tool := &GetDefaultCalendarTool{
+		logger: logger,
+		google: google,
+	}
+	return server.NewBasicTool(
+		"get_default_calendar",
+		"Get the calendar the other tools currently operate on",
+		map[string]any{
+			"type":       "object",
+			"properties": map[string]any{},
+		},
+		tool.GetDefaultCalendarHandler,
+	)
+}
+
+// GetDefaultCalendarHandler handles the get_default_calendar tool execution
+func (s *GetDefaultCalendarTool) GetDefaultCalendarHandler(ctx context.Context, args map[string]any) (string, error) {
+	span := startToolSpan(ctx, "get_default_calendar")
+	defer span.End()
+	s.logger.Debug("getting default calendar")
+
+	calendarID := s.google.GetCalendarID()
+	result := map[string]any{
+		"success":    true,
+		"calendarId": calendarID,
+		"label":      calendarLabel(ctx, s.google, calendarID),
+	}
+
+	resultJSON, err := json.Marshal(result)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal result: %w", err)
+	}
+
+	return string(resultJSON), nil
+}
@@ -0,0 +1,184 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"time"
+
+	zap "go.uber.org/zap"
+	calendar "google.golang.org/api/calendar/v3"
+
+	server "github.com/inference-gateway/adk/server"
+
+	google "github.com/inference-gateway/google-calendar-agent/internal/google"
+)
+
+// GetWeeklyStatsTool struct holds the tool with dependencies
+type GetWeeklyStatsTool struct {
+	logger *zap.Logger
+	google google.CalendarService
+}
+
+// NewGetWeeklyStatsTool creates a new get_weekly_stats tool
+func NewGetWeeklyStatsTool(logger *zap.Logger, google google.CalendarService) server.Tool {
+	tool := &GetWeeklyStatsTool{
+		logger: logger,
+		google: google,
+	}
+	return server.NewBasicTool(
+		"get_weekly_stats",
+		"Summarize a week's meeting load: total meeting hours, number of meetings, the longest meeting-free block within working hours, and the busiest day",
+		map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"date": map[string]any{
+					"description": "Any time in the week to summarize (RFC3339 format). Defaults to the current week.",
+					"type":        "string",
+				},
+			},
+		},
+		tool.GetWeeklyStatsHandler,
+	)
+}
+
+// GetWeeklyStatsHandler handles the get_weekly_stats tool execution
+func (s *GetWeeklyStatsTool) GetWeeklyStatsHandler(ctx context.Context, args map[string]any) (string, error) {
+	span := startToolSpan(ctx, "get_weekly_stats")
+	defer span.End()
+	s.logger.Debug("getting weekly stats", zap.Any("args", args))
+
+	calendarID := s.google.GetCalendarID()
+	loc, _ := calendarTimezone(ctx, s.google, calendarID)
+	date := time.Now()
+	if v, exists := args["date"]; exists && v != nil {
+		str, ok := v.(string)
+		if !ok {
+			return "", fmt.Errorf("date must be a string, got %T", v)
+		}
+		parsed, err := time.Parse(time.RFC3339, str)
+		if err != nil {
+			return "", fmt.Errorf("invalid date format (expected RFC3339): %w", err)
+		}
+		date = parsed
+	}
+
+	hours, err := loadWorkingHours()
+	if err != nil {
+		return "", err
+	}
+
+	firstDay, err := loadWeekStart()
+	if err != nil {
+		return "", err
+	}
+
+	weekStart, weekEnd := weekBounds(date, loc, firstDay)
+	events, err := s.google.ListEvents(ctx, calendarID, weekStart, weekEnd)
+	if err != nil {
+		s.logger.Error("failed to list calendar events", zap.Error(err))
+		return "", fmt.Errorf("failed to list calendar events: %w", err)
+	}
+
+	stats := weeklyStats(events, weekStart, weekEnd, hours, loc)
+
+	s.logger.Info("weekly stats computed",
+		zap.Time("weekStart", weekStart),
+		zap.Int("meetingCount", stats.meetingCount))
+
+	result := map[string]any{
+		"success":      true,
+		"weekStart":    weekStart.Format(time.RFC3339),
+		"weekEnd":      weekEnd.Format(time.RFC3339),
+		"meetingCount": stats.meetingCount,
+		"meetingHours": roundHours(stats.meetingTime),
+	}
+	if stats.longestFree.duration > 0 {
+		result["longestFreeBlock"] = map[string]any{
+			"startTime": stats.longestFree.startTime.Format(time.RFC3339),
+			"endTime":   stats.longestFree.endTime.Format(time.RFC3339),
+			"hours":     roundHours(stats.longestFree.duration),
+		}
+	}
+	if stats.busiestDay.meetingTime > 0 {
+		result["busiestDay"] = map[string]any{
+			"date":         stats.busiestDay.date.Format("2006-01-02"),
+			"weekday":      stats.busiestDay.date.Weekday().String(),
+			"meetingCount": stats.busiestDay.meetingCount,
+			"meetingHours": roundHours(stats.busiestDay.meetingTime),
+		}
+	}
+
+	resultJSON, err := json.Marshal(result)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal result: %w", err)
+	}
+
+	return string(resultJSON), nil
+}
+
+type dayStats struct {
+	date         time.Time
+	meetingCount int
+	meetingTime  time.Duration
+}
+
+type weekStats struct {
+	meetingCount int
+	meetingTime  time.Duration
+	longestFree  timeSlot
+	busiestDay   dayStats
+}
+
+// weeklyStats aggregates the timed, time-blocking events of a week.
+// Overlapping meetings are only counted once towards meeting time.
+func weeklyStats(events []*calendar.Event, weekStart, weekEnd time.Time, hours workingHours, loc *time.Location) weekStats {
+	var meetings []*calendar.Event
+	for _, event := range events {
+		if event.Status == "cancelled" || event.Transparency == "transparent" || !google.BlocksTime(event) || event.Start == nil || event.Start.DateTime == "" {
+			continue
+		}
+		meetings = append(meetings, event)
+	}
+	busy := mergeBusyPeriods(clipBusyPeriods(eventBusyPeriods(meetings, loc), weekStart, weekEnd))
+
+	var stats weekStats
+	for day := weekStart; day.Before(weekEnd); day = day.AddDate(0, 0, 1) {
+		next := day.AddDate(0, 0, 1)
+		current := dayStats{date: day}
+		for _, b := range clipBusyPeriods(busy, day, next) {
+			current.meetingTime += b.duration
+		}
+		for _, event := range meetings {
+			if start, err := time.Parse(time.RFC3339, event.Start.DateTime); err == nil && !start.In(loc).Before(day) && start.In(loc).Before(next) {
+				current.meetingCount++
+			}
+		}
+		stats.meetingCount += current.meetingCount
+		stats.meetingTime += current.meetingTime
+		if current.meetingTime > stats.busiestDay.meetingTime {
+			stats.busiestDay = current
+		}
+
+		windowStart, windowEnd, ok := hours.window(day)
+		if !ok {
+			continue
+		}
+		free := windowStart
+		for _, b := range clipBusyPeriods(busy, windowStart, windowEnd) {
+			if gap := b.startTime.Sub(free); gap > stats.longestFree.duration {
+				stats.longestFree = timeSlot{startTime: free, endTime: b.startTime, duration: gap}
+			}
+			free = b.endTime.In(loc)
+		}
+		if gap := windowEnd.Sub(free); gap > stats.longestFree.duration {
+			stats.longestFree = timeSlot{startTime: free, endTime: windowEnd, duration: gap}
+		}
+	}
+	return stats
+}
+
+func roundHours(d time.Duration) float64 {
+	return math.Round(d.Hours()*100) / 100
+}
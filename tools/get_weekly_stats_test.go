@@ -0,0 +1,95 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	zap "go.uber.org/zap"
+	calendar "google.golang.org/api/calendar/v3"
+)
+
+func TestGetWeeklyStatsHandler(t *testing.T) {
+	t.Setenv("GOOGLE_CALENDAR_TIMEZONE", "UTC")
+	t.Setenv("TZ", "")
+
+	timed := func(id, start, end string) *calendar.Event {
+		return &calendar.Event{
+			Id:     id,
+			Status: "confirmed",
+			Start:  &calendar.EventDateTime{DateTime: start},
+			End:    &calendar.EventDateTime{DateTime: end},
+		}
+	}
+	transparent := timed("focus", "2026-05-21T10:00:00Z", "2026-05-21T11:00:00Z")
+	transparent.Transparency = "transparent"
+	cancelled := timed("cancelled", "2026-05-22T12:00:00Z", "2026-05-22T13:00:00Z")
+	cancelled.Status = "cancelled"
+
+	events := []*calendar.Event{
+		timed("standup", "2026-05-18T09:00:00Z", "2026-05-18T10:00:00Z"),
+		timed("review", "2026-05-18T09:30:00Z", "2026-05-18T11:00:00Z"),
+		timed("workshop", "2026-05-19T13:00:00Z", "2026-05-19T16:00:00Z"),
+		{Id: "holiday", Status: "confirmed", Start: &calendar.EventDateTime{Date: "2026-05-20"}, End: &calendar.EventDateTime{Date: "2026-05-21"}},
+		transparent,
+		cancelled,
+		timed("retro", "2026-05-22T16:00:00Z", "2026-05-22T17:00:00Z"),
+	}
+
+	var gotMin, gotMax time.Time
+	stub := &stubCalendarService{
+		listEventsFn: func(calendarID string, timeMin, timeMax time.Time) ([]*calendar.Event, error) {
+			gotMin, gotMax = timeMin, timeMax
+			return events, nil
+		},
+	}
+	tool := &GetWeeklyStatsTool{logger: zap.NewNop(), google: stub}
+	result, err := tool.GetWeeklyStatsHandler(context.Background(), map[string]any{"date": "2026-05-20T15:00:00Z"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if got, want := gotMin.Format(time.RFC3339), "2026-05-18T00:00:00Z"; got != want {
+		t.Errorf("timeMin = %s, want %s", got, want)
+	}
+	if got, want := gotMax.Format(time.RFC3339), "2026-05-25T00:00:00Z"; got != want {
+		t.Errorf("timeMax = %s, want %s", got, want)
+	}
+
+	var parsed struct {
+		Success          bool    `json:"success"`
+		MeetingCount     int     `json:"meetingCount"`
+		MeetingHours     float64 `json:"meetingHours"`
+		LongestFreeBlock struct {
+			StartTime string  `json:"startTime"`
+			EndTime   string  `json:"endTime"`
+			Hours     float64 `json:"hours"`
+		} `json:"longestFreeBlock"`
+		BusiestDay struct {
+			Date         string  `json:"date"`
+			Weekday      string  `json:"weekday"`
+			MeetingCount int     `json:"meetingCount"`
+			MeetingHours float64 `json:"meetingHours"`
+		} `json:"busiestDay"`
+	}
+	if err := json.Unmarshal([]byte(result), &parsed); err != nil {
+		t.Fatalf("failed to unmarshal result: %v", err)
+	}
+
+	if !parsed.Success {
+		t.Error("success = false, want true")
+	}
+	if parsed.MeetingCount != 4 {
+		t.Errorf("meetingCount = %d, want 4", parsed.MeetingCount)
+	}
+	if parsed.MeetingHours != 6 {
+		t.Errorf("meetingHours = %v, want 6 (overlapping meetings counted once)", parsed.MeetingHours)
+	}
+	if parsed.BusiestDay.Date != "2026-05-19" || parsed.BusiestDay.Weekday != "Tuesday" || parsed.BusiestDay.MeetingHours != 3 || parsed.BusiestDay.MeetingCount != 1 {
+		t.Errorf("busiestDay = %+v, want Tuesday 2026-05-19 with 3h in 1 meeting", parsed.BusiestDay)
+	}
+	if parsed.LongestFreeBlock.StartTime != "2026-05-20T09:00:00Z" || parsed.LongestFreeBlock.EndTime != "2026-05-20T17:00:00Z" || parsed.LongestFreeBlock.Hours != 8 {
+		t.Errorf("longestFreeBlock = %+v, want the whole working day on Wednesday", parsed.LongestFreeBlock)
+	}
+}
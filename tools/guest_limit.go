@@ -0,0 +1,69 @@
+package tools
+
+import (
+	"fmt"
+	"maps"
+	"strconv"
+
+	calendar "google.golang.org/api/calendar/v3"
+)
+
+// guestLimitProperty is the private extended property holding an event's
+// advisory capacity. Google Calendar does not enforce it.
+const guestLimitProperty = "maxAttendees"
+
+// guestLimitSchema describes the maxAttendees argument of
+// create_calendar_event and update_calendar_event.
+var guestLimitSchema = map[string]any{
+	"description": "Capacity hint for the event, stored with it. Inviting more guests is still allowed, but the response then carries a warning so extra people can be told they are on a waitlist. Optional.",
+	"minimum":     1,
+	"type":        "integer",
+}
+
+// applyGuestLimit stores the maxAttendees argument in the event's private
+// extended properties.
+func applyGuestLimit(event *calendar.Event, args map[string]any) error {
+	v, exists := args["maxAttendees"]
+	if !exists || v == nil {
+		return nil
+	}
+	f, ok := v.(float64)
+	if !ok || f < 1 || f != float64(int(f)) {
+		return fmt.Errorf("maxAttendees must be a positive integer, got %v", v)
+	}
+
+	props := &calendar.EventExtendedProperties{}
+	if event.ExtendedProperties != nil {
+		props.Private = maps.Clone(event.ExtendedProperties.Private)
+		props.Shared = event.ExtendedProperties.Shared
+	}
+	if props.Private == nil {
+		props.Private = map[string]string{}
+	}
+	props.Private[guestLimitProperty] = strconv.Itoa(int(f))
+	event.ExtendedProperties = props
+	return nil
+}
+
+// guestLimitWarning returns a warning when the event invites more guests
+// than its stored capacity, or "" when it fits or has no capacity set.
+// Resources such as meeting rooms do not count as guests.
+func guestLimitWarning(event *calendar.Event) string {
+	if event.ExtendedProperties == nil {
+		return ""
+	}
+	limit, err := strconv.Atoi(event.ExtendedProperties.Private[guestLimitProperty])
+	if err != nil || limit < 1 {
+		return ""
+	}
+	guests := 0
+	for _, attendee := range event.Attendees {
+		if attendee != nil && !attendee.Resource {
+			guests++
+		}
+	}
+	if guests <= limit {
+		return ""
+	}
+	return fmt.Sprintf("%d guests are invited but the event holds %d; %d will need to be waitlisted", guests, limit, guests-limit)
+}
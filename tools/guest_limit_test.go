@@ -0,0 +1,165 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	zap "go.uber.org/zap"
+	calendar "google.golang.org/api/calendar/v3"
+)
+
+func TestCreateCalendarEventGuestLimit(t *testing.T) {
+	disableConflictCheck(t)
+	t.Setenv("GOOGLE_CALENDAR_TIMEZONE", "UTC")
+	t.Setenv("TZ", "")
+
+	tests := []struct {
+		name        string
+		attendees   []any
+		limit       any
+		wantWarning string
+		wantErrSub  string
+	}{
+		{
+			name:        "more guests than the limit warns",
+			attendees:   []any{"a@example.com", "b@example.com", "c@example.com", "d@example.com"},
+			limit:       float64(3),
+			wantWarning: "4 guests are invited but the event holds 3; 1 will need to be waitlisted",
+		},
+		{
+			name:      "guests within the limit",
+			attendees: []any{"a@example.com", "b@example.com"},
+			limit:     float64(3),
+		},
+		{
+			name:      "no limit set",
+			attendees: []any{"a@example.com", "b@example.com"},
+		},
+		{
+			name:       "limit must be positive",
+			attendees:  []any{"a@example.com"},
+			limit:      float64(0),
+			wantErrSub: "maxAttendees must be a positive integer",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var created *calendar.Event
+			stub := &stubCalendarService{
+				createEventFn: func(calendarID string, event *calendar.Event) (*calendar.Event, error) {
+					created = event
+					out := *event
+					out.Id = "evt-1"
+					return &out, nil
+				},
+			}
+			args := map[string]any{
+				"summary":   "Workshop",
+				"startTime": "2026-05-20T10:00:00Z",
+				"endTime":   "2026-05-20T12:00:00Z",
+				"attendees": tc.attendees,
+			}
+			if tc.limit != nil {
+				args["maxAttendees"] = tc.limit
+			}
+			tool := &CreateCalendarEventTool{logger: zap.NewNop(), google: stub}
+			result, err := tool.CreateCalendarEventHandler(context.Background(), args)
+			if tc.wantErrSub != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErrSub) {
+					t.Fatalf("error = %v, want substring %q", err, tc.wantErrSub)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			var parsed struct {
+				Warning string `json:"warning"`
+			}
+			if err := json.Unmarshal([]byte(result), &parsed); err != nil {
+				t.Fatalf("failed to unmarshal result: %v", err)
+			}
+			if parsed.Warning != tc.wantWarning {
+				t.Errorf("warning = %q, want %q", parsed.Warning, tc.wantWarning)
+			}
+			if tc.limit != nil {
+				if got := created.ExtendedProperties.Private[guestLimitProperty]; got != "3" {
+					t.Errorf("stored limit = %q, want 3", got)
+				}
+			}
+		})
+	}
+}
+
+func TestUpdateCalendarEventGuestLimit(t *testing.T) {
+	t.Setenv("GOOGLE_CALENDAR_TIMEZONE", "UTC")
+	t.Setenv("TZ", "")
+
+	existing := func() *calendar.Event {
+		return &calendar.Event{
+			Id:      "evt-1",
+			Summary: "Workshop",
+			Start:   &calendar.EventDateTime{DateTime: "2026-05-20T10:00:00Z"},
+			End:     &calendar.EventDateTime{DateTime: "2026-05-20T12:00:00Z"},
+			Attendees: []*calendar.EventAttendee{
+				{Email: "a@example.com"},
+				{Email: "b@example.com"},
+				{Email: "room@resource.example.com", Resource: true},
+			},
+			ExtendedProperties: &calendar.EventExtendedProperties{
+				Private: map[string]string{"source": "crm"},
+			},
+		}
+	}
+
+	tests := []struct {
+		name        string
+		args        map[string]any
+		wantWarning string
+	}{
+		{
+			name:        "lowering the limit below the guest list warns",
+			args:        map[string]any{"eventId": "evt-1", "maxAttendees": float64(1)},
+			wantWarning: "2 guests are invited but the event holds 1; 1 will need to be waitlisted",
+		},
+		{
+			name: "rooms do not count against the limit",
+			args: map[string]any{"eventId": "evt-1", "maxAttendees": float64(2)},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			stub := &stubCalendarService{
+				getEventFn: func(calendarID, eventID string) (*calendar.Event, error) {
+					return existing(), nil
+				},
+				updateEventFn: func(calendarID, eventID string, event *calendar.Event) (*calendar.Event, error) {
+					if event.ExtendedProperties.Private["source"] != "crm" {
+						t.Errorf("existing private properties were dropped: %v", event.ExtendedProperties.Private)
+					}
+					return event, nil
+				},
+			}
+			tool := &UpdateCalendarEventTool{logger: zap.NewNop(), google: stub}
+			result, err := tool.UpdateCalendarEventHandler(context.Background(), tc.args)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			var parsed struct {
+				Warning string `json:"warning"`
+			}
+			if err := json.Unmarshal([]byte(result), &parsed); err != nil {
+				t.Fatalf("failed to unmarshal result: %v", err)
+			}
+			if parsed.Warning != tc.wantWarning {
+				t.Errorf("warning = %q, want %q", parsed.Warning, tc.wantWarning)
+			}
+		})
+	}
+}
@@ -0,0 +1,83 @@
+package tools
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// icsLocalLayout is the iCalendar form of a local date-time.
+const icsLocalLayout = "20060102T150405"
+
+// writeVTimezone writes a VTIMEZONE component for loc covering the years
+// from through until. Go does not expose a zone's rules, so each offset
+// change in that span is found by probing and written as its own
+// STANDARD or DAYLIGHT observance, preceded by the observance in force at
+// the start of the span.
+func writeVTimezone(b *strings.Builder, tzid string, loc *time.Location, from, until time.Time) {
+	start := time.Date(from.In(loc).Year(), time.January, 1, 0, 0, 0, 0, loc)
+	end := time.Date(until.In(loc).Year()+1, time.January, 1, 0, 0, 0, 0, loc)
+
+	writeICSLine(b, "BEGIN:VTIMEZONE")
+	writeICSLine(b, "TZID:"+tzid)
+	_, offset := start.Zone()
+	writeObservance(b, start, offset)
+	for t := start; t.Before(end); {
+		next := t.Add(24 * time.Hour)
+		if _, nextOffset := next.Zone(); nextOffset != offset {
+			change := zoneTransition(t, next)
+			writeObservance(b, change, offset)
+			offset = nextOffset
+		}
+		t = next
+	}
+	writeICSLine(b, "END:VTIMEZONE")
+}
+
+// zoneTransition returns the first second after lo at which the offset
+// differs from lo's, given that it differs by hi.
+func zoneTransition(lo, hi time.Time) time.Time {
+	_, offset := lo.Zone()
+	for hi.Sub(lo) > time.Second {
+		mid := lo.Add(hi.Sub(lo) / 2)
+		if _, o := mid.Zone(); o == offset {
+			lo = mid
+		} else {
+			hi = mid
+		}
+	}
+	return hi
+}
+
+// writeObservance writes the observance starting at t, whose DTSTART is
+// expressed in the wall-clock time of the offset it replaces.
+func writeObservance(b *strings.Builder, t time.Time, fromOffset int) {
+	name, toOffset := t.Zone()
+	kind := "STANDARD"
+	if t.IsDST() {
+		kind = "DAYLIGHT"
+	}
+	writeICSLine(b, "BEGIN:"+kind)
+	writeICSLine(b, "DTSTART:"+t.UTC().Add(time.Duration(fromOffset)*time.Second).Format(icsLocalLayout))
+	writeICSLine(b, "TZOFFSETFROM:"+icsOffset(fromOffset))
+	writeICSLine(b, "TZOFFSETTO:"+icsOffset(toOffset))
+	if name != "" {
+		writeICSLine(b, "TZNAME:"+name)
+	}
+	writeICSLine(b, "END:"+kind)
+}
+
+// icsOffset formats a UTC offset in seconds as +HHMM, adding seconds only
+// when the zone needs them.
+func icsOffset(seconds int) string {
+	sign := "+"
+	if seconds < 0 {
+		sign = "-"
+		seconds = -seconds
+	}
+	s := fmt.Sprintf("%s%02d%02d", sign, seconds/3600, seconds%3600/60)
+	if seconds%60 != 0 {
+		s += fmt.Sprintf("%02d", seconds%60)
+	}
+	return s
+}
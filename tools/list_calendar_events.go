@@ -4,6 +4,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"slices"
 	"strings"
 	"time"
 
@@ -33,12 +34,31 @@ func NewListCalendarEventsTool(logger *zap.Logger, google google.CalendarService
 		map[string]any{
 			"type": "object",
 			"properties": map[string]any{
+				"eventTypes": map[string]any{
+					"description": "Only return events of these types, e.g. [\"focusTime\"] for focus time or [\"outOfOffice\"] for time off. Optional; defaults to all types.",
+					"items":       map[string]any{"enum": listableEventTypes, "type": "string"},
+					"minItems":    1,
+					"type":        "array",
+				},
+				"groupByDay": map[string]any{
+					"description": "Return the events nested under their start date (YYYY-MM-DD in the user's timezone) instead of as a flat list, each day sorted by time (default: false)",
+					"type":        "boolean",
+				},
+				"location": map[string]any{
+					"description": "Only return events whose location contains this text, ignoring case, e.g. \"Conference Room A\". Optional.",
+					"type":        "string",
+				},
 				"maxResults": map[string]any{
 					"description": "Maximum number of events to return (default: 10, max: 100)",
 					"maximum":     100,
 					"minimum":     1,
 					"type":        "integer",
 				},
+				"ownership": map[string]any{
+					"description": "organizer returns only events the user organizes, attendee only events they are invited to by someone else (default: all)",
+					"enum":        eventOwnerships,
+					"type":        "string",
+				},
 				"query": map[string]any{
 					"description": "Free text search terms to find events. Optional.",
 					"type":        "string",
@@ -81,6 +101,41 @@ func (s *ListCalendarEventsTool) ListCalendarEventsHandler(ctx context.Context,
 		query = qStr
 	}
 
+	location := ""
+	if l, exists := args["location"]; exists && l != nil {
+		lStr, ok := l.(string)
+		if !ok {
+			return "", fmt.Errorf("location must be a string, got %T", l)
+		}
+		location = strings.TrimSpace(lStr)
+	}
+
+	groupByDay := false
+	if g, exists := args["groupByDay"]; exists && g != nil {
+		gBool, ok := g.(bool)
+		if !ok {
+			return "", fmt.Errorf("groupByDay must be a boolean, got %T", g)
+		}
+		groupByDay = gBool
+	}
+
+	ownership := "all"
+	if o, exists := args["ownership"]; exists && o != nil {
+		oStr, ok := o.(string)
+		if !ok {
+			return "", fmt.Errorf("ownership must be a string, got %T", o)
+		}
+		ownership = oStr
+	}
+	if ownership != "all" && ownership != "organizer" && ownership != "attendee" {
+		return "", fmt.Errorf("ownership must be one of %s, got %q", strings.Join(eventOwnerships, ", "), ownership)
+	}
+
+	types, err := parseEventTypes(args)
+	if err != nil {
+		return "", err
+	}
+
 	timeMin := time.Now()
 	if tm, exists := args["timeMin"]; exists && tm != nil {
 		tmStr, ok := tm.(string)
@@ -108,12 +163,27 @@ func (s *ListCalendarEventsTool) ListCalendarEventsHandler(ctx context.Context,
 	}
 
 	calendarID := s.google.GetCalendarID()
-	events, err := s.google.ListEvents(calendarID, timeMin, timeMax)
+	var events []*calendar.Event
+	if len(types) > 0 {
+		events, err = s.google.ListEventsByType(ctx, calendarID, types, timeMin, timeMax)
+	} else {
+		events, err = s.google.ListEvents(ctx, calendarID, timeMin, timeMax)
+	}
 	if err != nil {
 		s.logger.Error("failed to list calendar events", zap.Error(err))
 		return "", fmt.Errorf("failed to list calendar events: %w", err)
 	}
 
+	if ownership != "all" {
+		var owned []*calendar.Event
+		for _, event := range events {
+			if organizedBySelf(event) == (ownership == "organizer") {
+				owned = append(owned, event)
+			}
+		}
+		events = owned
+	}
+
 	filteredEvents := events
 	if query != "" {
 		filteredEvents = []*calendar.Event{}
@@ -125,19 +195,57 @@ func (s *ListCalendarEventsTool) ListCalendarEventsHandler(ctx context.Context,
 		}
 	}
 
+	if location != "" {
+		var located []*calendar.Event
+		for _, event := range filteredEvents {
+			if strings.Contains(strings.ToLower(event.Location), strings.ToLower(location)) {
+				located = append(located, event)
+			}
+		}
+		filteredEvents = located
+	}
+
 	if len(filteredEvents) > maxResults {
 		filteredEvents = filteredEvents[:maxResults]
 	}
 
+	cfg, err := loadCalendarSettings()
+	if err != nil {
+		return "", err
+	}
+	omitted := 0
+	if limit := cfg.MaxEventsInResponse; limit > 0 && len(filteredEvents) > limit {
+		omitted = len(filteredEvents) - limit
+		filteredEvents = filteredEvents[:limit]
+		s.logger.Warn("truncating event list to the response cap",
+			zap.Int("limit", limit),
+			zap.Int("omitted", omitted))
+	}
+
 	s.logger.Info("calendar events retrieved successfully", zap.Int("count", len(filteredEvents)))
 
+	loc, _ := calendarTimezone(ctx, s.google, calendarID)
 	var eventList []map[string]any
+	days := map[string][]map[string]any{}
+	if groupByDay {
+		ordered := make([]calendarEvent, len(filteredEvents))
+		for i, event := range filteredEvents {
+			ordered[i] = calendarEvent{calendarID: calendarID, event: event}
+		}
+		sortCalendarEvents(ordered, loc)
+		for i, entry := range ordered {
+			filteredEvents[i] = entry.event
+		}
+	}
 	for _, event := range filteredEvents {
 		eventData := map[string]any{
 			"eventId": event.Id,
 			"summary": event.Summary,
 			"status":  event.Status,
 		}
+		if event.EventType != "" && event.EventType != "default" {
+			eventData["eventType"] = event.EventType
+		}
 
 		if event.Start != nil {
 			eventData["startTime"] = event.Start.DateTime
@@ -150,10 +258,19 @@ func (s *ListCalendarEventsTool) ListCalendarEventsHandler(ctx context.Context,
 		}
 		if event.Location != "" {
 			eventData["location"] = event.Location
+			if link := mapsLink(event.Location); link != "" {
+				eventData["mapsLink"] = link
+			}
 		}
 		if event.HtmlLink != "" {
 			eventData["htmlLink"] = event.HtmlLink
 		}
+		if viewLink := viewLinkFor(calendarID, event); viewLink != "" {
+			eventData["viewLink"] = viewLink
+		}
+		if attachments := attachmentPreviews(event); len(attachments) > 0 {
+			eventData["attachments"] = attachments
+		}
 		if len(event.Attendees) > 0 {
 			var attendees []string
 			for _, attendee := range event.Attendees {
@@ -163,13 +280,25 @@ func (s *ListCalendarEventsTool) ListCalendarEventsHandler(ctx context.Context,
 		}
 
 		eventList = append(eventList, eventData)
+		if groupByDay {
+			day := eventStart(event, loc).Format("2006-01-02")
+			days[day] = append(days[day], eventData)
+		}
 	}
 
 	result := map[string]any{
 		"success": true,
-		"events":  eventList,
 		"count":   len(eventList),
 	}
+	if groupByDay {
+		result["days"] = days
+	} else {
+		result["events"] = eventList
+	}
+	if omitted > 0 {
+		result["truncated"] = true
+		result["omittedCount"] = omitted
+	}
 
 	resultJSON, err := json.Marshal(result)
 	if err != nil {
@@ -178,3 +307,60 @@ func (s *ListCalendarEventsTool) ListCalendarEventsHandler(ctx context.Context,
 
 	return string(resultJSON), nil
 }
+
+// eventOwnerships are the ownership values list_calendar_events accepts.
+var eventOwnerships = []string{"all", "organizer", "attendee"}
+
+// listableEventTypes are the event types list_calendar_events can filter
+// on. Unlike eventTypes they include the kinds Google creates itself.
+var listableEventTypes = []string{"birthday", "default", "focusTime", "fromGmail", "outOfOffice", "workingLocation"}
+
+// parseEventTypes reads the optional eventTypes argument.
+func parseEventTypes(args map[string]any) ([]string, error) {
+	v, exists := args["eventTypes"]
+	if !exists || v == nil {
+		return nil, nil
+	}
+	list, ok := v.([]any)
+	if !ok {
+		return nil, fmt.Errorf("eventTypes must be an array, got %T", v)
+	}
+	var types []string
+	for _, item := range list {
+		name, ok := item.(string)
+		if !ok {
+			return nil, fmt.Errorf("eventTypes must contain strings, got %T", item)
+		}
+		if !slices.Contains(listableEventTypes, name) {
+			return nil, fmt.Errorf("eventTypes entries must be one of %s, got %q", strings.Join(listableEventTypes, ", "), name)
+		}
+		types = append(types, name)
+	}
+	return types, nil
+}
+
+// organizedBySelf reports whether the calendar owner organizes event.
+// Google marks the organizer with Self; an event without an organizer was
+// created on the owner's calendar and counts as theirs.
+func organizedBySelf(event *calendar.Event) bool {
+	return event.Organizer == nil || event.Organizer.Self
+}
+
+// eventStart returns when event starts in loc. All-day events start at
+// midnight of their date, so they sort before the timed events of that day.
+// Events without a parseable start return the zero time.
+func eventStart(event *calendar.Event, loc *time.Location) time.Time {
+	if event.Start == nil {
+		return time.Time{}
+	}
+	if event.Start.DateTime != "" {
+		if t, err := time.Parse(time.RFC3339, event.Start.DateTime); err == nil {
+			return t.In(loc)
+		}
+		return time.Time{}
+	}
+	if t, err := time.ParseInLocation("2006-01-02", event.Start.Date, loc); err == nil {
+		return t
+	}
+	return time.Time{}
+}
@@ -190,3 +190,366 @@ func TestListCalendarEventsHandler(t *testing.T) {
 		})
 	}
 }
+
+func TestListCalendarEventsOwnership(t *testing.T) {
+	events := []*calendar.Event{
+		{Id: "mine", Summary: "Planning", Organizer: &calendar.EventOrganizer{Email: "me@example.com", Self: true}},
+		{Id: "invited", Summary: "Vendor call", Organizer: &calendar.EventOrganizer{Email: "vendor@example.com"},
+			Attendees: []*calendar.EventAttendee{{Email: "me@example.com", Self: true}}},
+		{Id: "no-organizer", Summary: "Focus"},
+		{Id: "team", Summary: "Team sync", Organizer: &calendar.EventOrganizer{Email: "lead@example.com"}},
+	}
+
+	tests := []struct {
+		name       string
+		ownership  any
+		wantIDs    []string
+		wantErrSub string
+	}{
+		{name: "default returns everything", wantIDs: []string{"mine", "invited", "no-organizer", "team"}},
+		{name: "all", ownership: "all", wantIDs: []string{"mine", "invited", "no-organizer", "team"}},
+		{name: "organizer", ownership: "organizer", wantIDs: []string{"mine", "no-organizer"}},
+		{name: "attendee", ownership: "attendee", wantIDs: []string{"invited", "team"}},
+		{name: "unknown value", ownership: "owner", wantErrSub: "ownership must be one of"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			stub := &stubCalendarService{
+				listEventsFn: func(calendarID string, timeMin, timeMax time.Time) ([]*calendar.Event, error) {
+					return events, nil
+				},
+			}
+			args := map[string]any{}
+			if tc.ownership != nil {
+				args["ownership"] = tc.ownership
+			}
+			tool := &ListCalendarEventsTool{logger: zap.NewNop(), google: stub}
+			result, err := tool.ListCalendarEventsHandler(context.Background(), args)
+
+			if tc.wantErrSub != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErrSub) {
+					t.Fatalf("error = %v, want substring %q", err, tc.wantErrSub)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			var parsed struct {
+				Events []struct {
+					EventID string `json:"eventId"`
+				} `json:"events"`
+			}
+			if err := json.Unmarshal([]byte(result), &parsed); err != nil {
+				t.Fatalf("failed to unmarshal result: %v", err)
+			}
+			var got []string
+			for _, e := range parsed.Events {
+				got = append(got, e.EventID)
+			}
+			if strings.Join(got, ",") != strings.Join(tc.wantIDs, ",") {
+				t.Errorf("events = %v, want %v", got, tc.wantIDs)
+			}
+		})
+	}
+}
+
+func TestListCalendarEventsLocation(t *testing.T) {
+	events := []*calendar.Event{
+		{Id: "room-a", Summary: "Planning", Location: "Conference Room A, 3rd floor"},
+		{Id: "room-ab", Summary: "Retro", Location: "Conference Room AB"},
+		{Id: "room-b", Summary: "1:1", Location: "Conference Room B"},
+		{Id: "online", Summary: "Vendor call", Location: "https://meet.google.com/abc-defg-hij"},
+		{Id: "nowhere", Summary: "Focus"},
+	}
+
+	tests := []struct {
+		name       string
+		location   any
+		query      string
+		wantIDs    []string
+		wantErrSub string
+	}{
+		{name: "no filter", wantIDs: []string{"room-a", "room-ab", "room-b", "online", "nowhere"}},
+		{name: "substring ignoring case", location: "conference room a", wantIDs: []string{"room-a", "room-ab"}},
+		{name: "surrounding spaces are trimmed", location: "  Room B ", wantIDs: []string{"room-b"}},
+		{name: "combined with query", location: "Conference Room A", query: "retro", wantIDs: []string{"room-ab"}},
+		{name: "no match", location: "Lobby"},
+		{name: "non-string location", location: 3, wantErrSub: "location must be a string"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			stub := &stubCalendarService{
+				listEventsFn: func(calendarID string, timeMin, timeMax time.Time) ([]*calendar.Event, error) {
+					return events, nil
+				},
+			}
+			args := map[string]any{}
+			if tc.location != nil {
+				args["location"] = tc.location
+			}
+			if tc.query != "" {
+				args["query"] = tc.query
+			}
+			tool := &ListCalendarEventsTool{logger: zap.NewNop(), google: stub}
+			result, err := tool.ListCalendarEventsHandler(context.Background(), args)
+
+			if tc.wantErrSub != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErrSub) {
+					t.Fatalf("error = %v, want substring %q", err, tc.wantErrSub)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			var parsed struct {
+				Events []struct {
+					EventID string `json:"eventId"`
+				} `json:"events"`
+			}
+			if err := json.Unmarshal([]byte(result), &parsed); err != nil {
+				t.Fatalf("failed to unmarshal result: %v", err)
+			}
+			var got []string
+			for _, e := range parsed.Events {
+				got = append(got, e.EventID)
+			}
+			if strings.Join(got, ",") != strings.Join(tc.wantIDs, ",") {
+				t.Errorf("events = %v, want %v", got, tc.wantIDs)
+			}
+		})
+	}
+}
+
+func TestListCalendarEventsEventTypes(t *testing.T) {
+	focus := &calendar.Event{Id: "focus", Summary: "Deep work", EventType: "focusTime"}
+
+	tests := []struct {
+		name       string
+		eventTypes any
+		wantTypes  []string
+		wantErrSub string
+	}{
+		{name: "single type is forwarded", eventTypes: []any{"focusTime"}, wantTypes: []string{"focusTime"}},
+		{name: "several types are forwarded", eventTypes: []any{"outOfOffice", "focusTime"}, wantTypes: []string{"outOfOffice", "focusTime"}},
+		{name: "unknown type", eventTypes: []any{"meeting"}, wantErrSub: "eventTypes entries must be one of"},
+		{name: "not an array", eventTypes: "focusTime", wantErrSub: "eventTypes must be an array"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var gotTypes []string
+			stub := &stubCalendarService{
+				listByTypeFn: func(calendarID string, eventTypes []string, timeMin, timeMax time.Time) ([]*calendar.Event, error) {
+					gotTypes = eventTypes
+					return []*calendar.Event{focus}, nil
+				},
+			}
+			tool := &ListCalendarEventsTool{logger: zap.NewNop(), google: stub}
+			result, err := tool.ListCalendarEventsHandler(context.Background(), map[string]any{"eventTypes": tc.eventTypes})
+
+			if tc.wantErrSub != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErrSub) {
+					t.Fatalf("error = %v, want substring %q", err, tc.wantErrSub)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if strings.Join(gotTypes, ",") != strings.Join(tc.wantTypes, ",") {
+				t.Errorf("forwarded eventTypes = %v, want %v", gotTypes, tc.wantTypes)
+			}
+
+			var parsed struct {
+				Events []struct {
+					EventID   string `json:"eventId"`
+					EventType string `json:"eventType"`
+				} `json:"events"`
+			}
+			if err := json.Unmarshal([]byte(result), &parsed); err != nil {
+				t.Fatalf("failed to unmarshal result: %v", err)
+			}
+			if len(parsed.Events) != 1 || parsed.Events[0].EventType != "focusTime" {
+				t.Errorf("events = %+v, want the focus time event", parsed.Events)
+			}
+		})
+	}
+}
+
+func TestListCalendarEventsGroupByDay(t *testing.T) {
+	t.Setenv("GOOGLE_CALENDAR_TIMEZONE", "UTC")
+	t.Setenv("TZ", "")
+
+	timed := func(id, start string) *calendar.Event {
+		return &calendar.Event{Id: id, Start: &calendar.EventDateTime{DateTime: start}, End: &calendar.EventDateTime{DateTime: start}}
+	}
+	events := []*calendar.Event{
+		timed("wed-late", "2026-05-20T16:00:00Z"),
+		timed("mon", "2026-05-18T09:00:00Z"),
+		timed("wed-early", "2026-05-20T08:00:00Z"),
+		{Id: "tue-all-day", Start: &calendar.EventDateTime{Date: "2026-05-19"}, End: &calendar.EventDateTime{Date: "2026-05-20"}},
+		timed("tue", "2026-05-19T13:00:00Z"),
+	}
+	stub := &stubCalendarService{
+		listEventsFn: func(calendarID string, timeMin, timeMax time.Time) ([]*calendar.Event, error) {
+			return events, nil
+		},
+	}
+	tool := &ListCalendarEventsTool{logger: zap.NewNop(), google: stub}
+
+	result, err := tool.ListCalendarEventsHandler(context.Background(), map[string]any{"groupByDay": true})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var grouped struct {
+		Count  int                                   `json:"count"`
+		Events []any                                 `json:"events"`
+		Days   map[string][]struct{ EventID string } `json:"days"`
+	}
+	if err := json.Unmarshal([]byte(result), &grouped); err != nil {
+		t.Fatalf("failed to unmarshal result: %v", err)
+	}
+	if grouped.Count != 5 || grouped.Events != nil {
+		t.Errorf("count = %d, events = %v; want 5 and no flat list", grouped.Count, grouped.Events)
+	}
+	want := map[string]string{
+		"2026-05-18": "mon",
+		"2026-05-19": "tue-all-day,tue",
+		"2026-05-20": "wed-early,wed-late",
+	}
+	if len(grouped.Days) != len(want) {
+		t.Errorf("days = %v, want %d days", grouped.Days, len(want))
+	}
+	for day, wantIDs := range want {
+		var got []string
+		for _, e := range grouped.Days[day] {
+			got = append(got, e.EventID)
+		}
+		if strings.Join(got, ",") != wantIDs {
+			t.Errorf("%s = %v, want %s", day, got, wantIDs)
+		}
+	}
+
+	flat, err := tool.ListCalendarEventsHandler(context.Background(), map[string]any{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	var parsed map[string]any
+	if err := json.Unmarshal([]byte(flat), &parsed); err != nil {
+		t.Fatalf("failed to unmarshal result: %v", err)
+	}
+	if _, ok := parsed["days"]; ok {
+		t.Error("days present without groupByDay")
+	}
+	if list, _ := parsed["events"].([]any); len(list) != 5 {
+		t.Errorf("events = %v, want the flat list of 5", parsed["events"])
+	}
+}
+
+func TestListCalendarEventsAttachments(t *testing.T) {
+	events := []*calendar.Event{
+		{Id: "review", Summary: "Design review", Attachments: []*calendar.EventAttachment{
+			{Title: "Spec", FileUrl: "https://drive.google.com/file/d/spec"},
+			{Title: "Slides", FileUrl: "https://drive.google.com/file/d/slides"},
+		}},
+		{Id: "standup", Summary: "Standup"},
+	}
+	stub := &stubCalendarService{
+		listEventsFn: func(calendarID string, timeMin, timeMax time.Time) ([]*calendar.Event, error) {
+			return events, nil
+		},
+	}
+	tool := &ListCalendarEventsTool{logger: zap.NewNop(), google: stub}
+	result, err := tool.ListCalendarEventsHandler(context.Background(), map[string]any{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	var parsed struct {
+		Events []struct {
+			EventID     string              `json:"eventId"`
+			Attachments []map[string]string `json:"attachments"`
+		} `json:"events"`
+	}
+	if err := json.Unmarshal([]byte(result), &parsed); err != nil {
+		t.Fatalf("failed to unmarshal result: %v", err)
+	}
+	if len(parsed.Events) != 2 {
+		t.Fatalf("got %d events, want 2", len(parsed.Events))
+	}
+
+	review := parsed.Events[0].Attachments
+	if len(review) != 2 {
+		t.Fatalf("attachments = %v, want 2", review)
+	}
+	if review[0]["title"] != "Spec" || review[0]["fileUrl"] != "https://drive.google.com/file/d/spec" {
+		t.Errorf("attachments[0] = %v, want Spec", review[0])
+	}
+	if review[1]["title"] != "Slides" || review[1]["fileUrl"] != "https://drive.google.com/file/d/slides" {
+		t.Errorf("attachments[1] = %v, want Slides", review[1])
+	}
+	if parsed.Events[1].Attachments != nil {
+		t.Errorf("standup attachments = %v, want the key omitted", parsed.Events[1].Attachments)
+	}
+}
+
+func TestListCalendarEventsResponseCap(t *testing.T) {
+	events := make([]*calendar.Event, 5)
+	for i := range events {
+		events[i] = &calendar.Event{Id: string(rune('a' + i)), Summary: "Event"}
+	}
+
+	tests := []struct {
+		name        string
+		limit       string
+		wantCount   int
+		wantOmitted float64
+	}{
+		{name: "under the cap returns everything", limit: "10", wantCount: 5},
+		{name: "at the cap returns everything", limit: "5", wantCount: 5},
+		{name: "over the cap is truncated", limit: "3", wantCount: 3, wantOmitted: 2},
+		{name: "zero disables the cap", limit: "0", wantCount: 5},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Setenv("GOOGLE_CALENDAR_MAX_EVENTS_IN_RESPONSE", tc.limit)
+			stub := &stubCalendarService{
+				listEventsFn: func(calendarID string, timeMin, timeMax time.Time) ([]*calendar.Event, error) {
+					return events, nil
+				},
+			}
+			tool := &ListCalendarEventsTool{logger: zap.NewNop(), google: stub}
+			result, err := tool.ListCalendarEventsHandler(context.Background(), map[string]any{"maxResults": float64(50)})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			var parsed map[string]any
+			if err := json.Unmarshal([]byte(result), &parsed); err != nil {
+				t.Fatalf("failed to unmarshal result: %v", err)
+			}
+			if got := len(parsed["events"].([]any)); got != tc.wantCount {
+				t.Errorf("len(events) = %d, want %d", got, tc.wantCount)
+			}
+			if parsed["count"] != float64(tc.wantCount) {
+				t.Errorf("count = %v, want %d", parsed["count"], tc.wantCount)
+			}
+			if tc.wantOmitted == 0 {
+				if _, ok := parsed["truncated"]; ok {
+					t.Errorf("truncated = %v, want the key omitted", parsed["truncated"])
+				}
+				return
+			}
+			if parsed["truncated"] != true || parsed["omittedCount"] != tc.wantOmitted {
+				t.Errorf("truncated = %v, omittedCount = %v, want true and %v", parsed["truncated"], parsed["omittedCount"], tc.wantOmitted)
+			}
+		})
+	}
+}
@@ -0,0 +1,103 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	zap "go.uber.org/zap"
+	calendar "google.golang.org/api/calendar/v3"
+
+	server "github.com/inference-gateway/adk/server"
+
+	google "github.com/inference-gateway/google-calendar-agent/internal/google"
+)
+
+// ListCalendarsTool struct holds the tool with dependencies
+type ListCalendarsTool struct {
+	logger *zap.Logger
+	google google.CalendarService
+}
+
+// NewListCalendarsTool creates a new list_calendars tool
+func NewListCalendarsTool(logger *zap.Logger, google google.CalendarService) server.Tool {
+	tool := &ListCalendarsTool{
+		logger: logger,
+		google: google,
+	}
+	return server.NewBasicTool(
+		"list_calendars",
+		"List the calendars shared with the agent, with the access role the agent has on each",
+		map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"writableOnly": map[string]any{
+					"description": "Only return calendars the agent can create and change events on. Defaults to false.",
+					"type":        "boolean",
+				},
+			},
+		},
+		tool.ListCalendarsHandler,
+	)
+}
+
+// ListCalendarsHandler handles the list_calendars tool execution
+func (s *ListCalendarsTool) ListCalendarsHandler(ctx context.Context, args map[string]any) (string, error) {
+	span := startToolSpan(ctx, "list_calendars")
+	defer span.End()
+	s.logger.Debug("listing calendars", zap.Any("args", args))
+
+	writableOnly := false
+	if v, exists := args["writableOnly"]; exists && v != nil {
+		b, ok := v.(bool)
+		if !ok {
+			return "", fmt.Errorf("writableOnly must be a boolean, got %T", v)
+		}
+		writableOnly = b
+	}
+
+	entries, err := s.google.ListCalendars(ctx)
+	if err != nil {
+		s.logger.Error("failed to list calendars", zap.Error(err))
+		return "", fmt.Errorf("failed to list calendars: %w", err)
+	}
+
+	calendars := []map[string]any{}
+	for _, entry := range entries {
+		writable := calendarWritable(entry)
+		if writableOnly && !writable {
+			continue
+		}
+		item := map[string]any{
+			"calendarId": entry.Id,
+			"summary":    entry.Summary,
+			"accessRole": entry.AccessRole,
+			"writable":   writable,
+		}
+		if entry.Primary {
+			item["primary"] = true
+		}
+		calendars = append(calendars, item)
+	}
+
+	s.logger.Info("calendars listed successfully", zap.Int("count", len(calendars)))
+
+	result := map[string]any{
+		"success":   true,
+		"calendars": calendars,
+		"count":     len(calendars),
+	}
+
+	resultJSON, err := json.Marshal(result)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal result: %w", err)
+	}
+
+	return string(resultJSON), nil
+}
+
+// calendarWritable reports whether the agent may create and change events
+// on entry. Readers and free/busy readers can only look.
+func calendarWritable(entry *calendar.CalendarListEntry) bool {
+	return entry.AccessRole == "owner" || entry.AccessRole == "writer"
+}
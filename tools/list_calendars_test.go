@@ -0,0 +1,102 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	zap "go.uber.org/zap"
+	calendar "google.golang.org/api/calendar/v3"
+)
+
+func TestListCalendarsHandler(t *testing.T) {
+	entries := []*calendar.CalendarListEntry{
+		{Id: "me@example.com", Summary: "Me", AccessRole: "owner", Primary: true},
+		{Id: "holidays@example.com", Summary: "Holidays", AccessRole: "reader"},
+		{Id: "team@example.com", Summary: "Team", AccessRole: "writer"},
+	}
+
+	type item struct {
+		CalendarID string `json:"calendarId"`
+		AccessRole string `json:"accessRole"`
+		Writable   bool   `json:"writable"`
+		Primary    bool   `json:"primary"`
+	}
+
+	tests := []struct {
+		name       string
+		args       map[string]any
+		listErr    error
+		want       []item
+		wantErrSub string
+	}{
+		{
+			name: "lists every calendar with its access role",
+			args: map[string]any{},
+			want: []item{
+				{CalendarID: "me@example.com", AccessRole: "owner", Writable: true, Primary: true},
+				{CalendarID: "holidays@example.com", AccessRole: "reader"},
+				{CalendarID: "team@example.com", AccessRole: "writer", Writable: true},
+			},
+		},
+		{
+			name: "writableOnly drops read-only calendars",
+			args: map[string]any{"writableOnly": true},
+			want: []item{
+				{CalendarID: "me@example.com", AccessRole: "owner", Writable: true, Primary: true},
+				{CalendarID: "team@example.com", AccessRole: "writer", Writable: true},
+			},
+		},
+		{
+			name:       "non-boolean writableOnly returns error",
+			args:       map[string]any{"writableOnly": "yes"},
+			wantErrSub: "writableOnly must be a boolean",
+		},
+		{
+			name:       "ListCalendars error is wrapped",
+			args:       map[string]any{},
+			listErr:    errors.New("forbidden"),
+			wantErrSub: "failed to list calendars",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			stub := &stubCalendarService{
+				listCalendarsFn: func() ([]*calendar.CalendarListEntry, error) {
+					return entries, tc.listErr
+				},
+			}
+			tool := &ListCalendarsTool{logger: zap.NewNop(), google: stub}
+			result, err := tool.ListCalendarsHandler(context.Background(), tc.args)
+
+			if tc.wantErrSub != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErrSub) {
+					t.Fatalf("error = %v, want substring %q", err, tc.wantErrSub)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			var parsed struct {
+				Calendars []item `json:"calendars"`
+				Count     int    `json:"count"`
+			}
+			if err := json.Unmarshal([]byte(result), &parsed); err != nil {
+				t.Fatalf("failed to unmarshal result: %v", err)
+			}
+			if parsed.Count != len(tc.want) || len(parsed.Calendars) != len(tc.want) {
+				t.Fatalf("got %d calendars (count %d), want %d: %s", len(parsed.Calendars), parsed.Count, len(tc.want), result)
+			}
+			for i, got := range parsed.Calendars {
+				if got != tc.want[i] {
+					t.Errorf("calendars[%d] = %+v, want %+v", i, got, tc.want[i])
+				}
+			}
+		})
+	}
+}
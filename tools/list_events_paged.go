@@ -0,0 +1,163 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	zap "go.uber.org/zap"
+
+	server "github.com/inference-gateway/adk/server"
+
+	google "github.com/inference-gateway/google-calendar-agent/internal/google"
+)
+
+const (
+	// defaultPageSize is how many events list_events_paged returns per page
+	// when pageSize is not given.
+	defaultPageSize = 25
+	// maxPageSize is the largest page Google Calendar serves.
+	maxPageSize = 250
+)
+
+// ListEventsPagedTool struct holds the tool with dependencies
+type ListEventsPagedTool struct {
+	logger *zap.Logger
+	google google.CalendarService
+}
+
+// NewListEventsPagedTool creates a new list_events_paged tool
+func NewListEventsPagedTool(logger *zap.Logger, google google.CalendarService) server.Tool {
+	tool := &ListEventsPagedTool{
+		logger: logger,
+		google: google,
+	}
+	return server.NewBasicTool(
+		"list_events_paged",
+		"List events one page at a time; pass the returned nextPageToken back as pageToken to fetch the following page",
+		map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"pageSize": map[string]any{
+					"description": fmt.Sprintf("Events per page (default: %d, max: %d)", defaultPageSize, maxPageSize),
+					"maximum":     maxPageSize,
+					"minimum":     1,
+					"type":        "integer",
+				},
+				"pageToken": map[string]any{
+					"description": "nextPageToken from the previous page. Omit for the first page, and keep timeMin and timeMax the same across pages.",
+					"type":        "string",
+				},
+				"timeMax": map[string]any{
+					"description": "End time (RFC3339 format, e.g., 2024-01-01T23:59:59Z). Optional.",
+					"type":        "string",
+				},
+				"timeMin": map[string]any{
+					"description": "Start time (RFC3339 format, e.g., 2024-01-01T00:00:00Z). Defaults to now.",
+					"type":        "string",
+				},
+			},
+		},
+		tool.ListEventsPagedHandler,
+	)
+}
+
+// ListEventsPagedHandler handles the list_events_paged tool execution
+func (s *ListEventsPagedTool) ListEventsPagedHandler(ctx context.Context, args map[string]any) (string, error) {
+	span := startToolSpan(ctx, "list_events_paged")
+	defer span.End()
+	s.logger.Debug("listing calendar events page", zap.Any("args", args))
+
+	pageSize := defaultPageSize
+	if ps, exists := args["pageSize"]; exists && ps != nil {
+		psFloat, ok := ps.(float64)
+		if !ok {
+			return "", fmt.Errorf("pageSize must be a number, got %T", ps)
+		}
+		if psFloat < 1 || psFloat > maxPageSize || psFloat != float64(int(psFloat)) {
+			return "", fmt.Errorf("pageSize must be an integer between 1 and %d, got %v", maxPageSize, ps)
+		}
+		pageSize = int(psFloat)
+	}
+
+	pageToken := ""
+	if pt, exists := args["pageToken"]; exists && pt != nil {
+		ptStr, ok := pt.(string)
+		if !ok {
+			return "", fmt.Errorf("pageToken must be a string, got %T", pt)
+		}
+		pageToken = ptStr
+	}
+
+	timeMin := time.Now()
+	if tm, exists := args["timeMin"]; exists && tm != nil {
+		tmStr, ok := tm.(string)
+		if !ok {
+			return "", fmt.Errorf("timeMin must be a string, got %T", tm)
+		}
+		parsedTime, err := time.Parse(time.RFC3339, tmStr)
+		if err != nil {
+			return "", fmt.Errorf("invalid timeMin format (expected RFC3339): %w", err)
+		}
+		timeMin = parsedTime
+	}
+
+	var timeMax time.Time
+	if tm, exists := args["timeMax"]; exists && tm != nil {
+		tmStr, ok := tm.(string)
+		if !ok {
+			return "", fmt.Errorf("timeMax must be a string, got %T", tm)
+		}
+		parsedTime, err := time.Parse(time.RFC3339, tmStr)
+		if err != nil {
+			return "", fmt.Errorf("invalid timeMax format (expected RFC3339): %w", err)
+		}
+		timeMax = parsedTime
+	}
+
+	calendarID := s.google.GetCalendarID()
+	events, nextPageToken, err := s.google.ListEventsPage(ctx, calendarID, timeMin, timeMax, int64(pageSize), pageToken)
+	if err != nil {
+		s.logger.Error("failed to list calendar events page", zap.Error(err))
+		return "", fmt.Errorf("failed to list calendar events: %w", err)
+	}
+
+	eventList := []map[string]any{}
+	for _, event := range events {
+		eventData := map[string]any{
+			"eventId":   event.Id,
+			"summary":   event.Summary,
+			"status":    event.Status,
+			"startTime": eventDateTimeString(event.Start),
+			"endTime":   eventDateTimeString(event.End),
+		}
+		if event.Location != "" {
+			eventData["location"] = event.Location
+		}
+		if event.HtmlLink != "" {
+			eventData["htmlLink"] = event.HtmlLink
+		}
+		eventList = append(eventList, eventData)
+	}
+
+	s.logger.Info("calendar events page retrieved",
+		zap.Int("count", len(eventList)),
+		zap.Bool("hasMore", nextPageToken != ""))
+
+	result := map[string]any{
+		"success": true,
+		"events":  eventList,
+		"count":   len(eventList),
+	}
+	if nextPageToken != "" {
+		result["nextPageToken"] = nextPageToken
+	}
+
+	resultJSON, err := json.Marshal(result)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal result: %w", err)
+	}
+
+	return string(resultJSON), nil
+}
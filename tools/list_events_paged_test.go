@@ -0,0 +1,110 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	zap "go.uber.org/zap"
+	calendar "google.golang.org/api/calendar/v3"
+
+	config "github.com/inference-gateway/google-calendar-agent/config"
+	google "github.com/inference-gateway/google-calendar-agent/internal/google"
+)
+
+func TestListEventsPagedHandler(t *testing.T) {
+	svc := google.NewInMemoryCalendarService(zap.NewNop(), &config.Config{})
+	for _, start := range []string{"2026-05-18T09:00:00Z", "2026-05-18T11:00:00Z", "2026-05-18T13:00:00Z"} {
+		if _, err := svc.CreateEvent(context.Background(), "primary", &calendar.Event{
+			Summary: "Meeting at " + start,
+			Start:   &calendar.EventDateTime{DateTime: start},
+			End:     &calendar.EventDateTime{DateTime: strings.Replace(start, ":00:00Z", ":30:00Z", 1)},
+		}); err != nil {
+			t.Fatalf("CreateEvent: %v", err)
+		}
+	}
+	tool := &ListEventsPagedTool{logger: zap.NewNop(), google: svc}
+
+	type page struct {
+		Count  int `json:"count"`
+		Events []struct {
+			StartTime string `json:"startTime"`
+		} `json:"events"`
+		NextPageToken string `json:"nextPageToken"`
+	}
+	fetch := func(args map[string]any) page {
+		t.Helper()
+		args["timeMin"] = "2026-05-18T00:00:00Z"
+		args["pageSize"] = float64(2)
+		result, err := tool.ListEventsPagedHandler(context.Background(), args)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		var parsed page
+		if err := json.Unmarshal([]byte(result), &parsed); err != nil {
+			t.Fatalf("failed to unmarshal result: %v", err)
+		}
+		return parsed
+	}
+
+	first := fetch(map[string]any{})
+	if first.Count != 2 || first.Events[0].StartTime != "2026-05-18T09:00:00Z" || first.Events[1].StartTime != "2026-05-18T11:00:00Z" {
+		t.Fatalf("first page = %+v, want the 09:00 and 11:00 events", first)
+	}
+	if first.NextPageToken == "" {
+		t.Fatal("first page has no nextPageToken, want one")
+	}
+
+	next := fetch(map[string]any{"pageToken": first.NextPageToken})
+	if next.Count != 1 || next.Events[0].StartTime != "2026-05-18T13:00:00Z" {
+		t.Fatalf("next page = %+v, want the 13:00 event", next)
+	}
+	if next.NextPageToken != "" {
+		t.Errorf("last page nextPageToken = %q, want none", next.NextPageToken)
+	}
+}
+
+func TestListEventsPagedArguments(t *testing.T) {
+	tests := []struct {
+		name       string
+		args       map[string]any
+		wantSize   int64
+		wantToken  string
+		wantErrSub string
+	}{
+		{name: "defaults", args: map[string]any{}, wantSize: defaultPageSize},
+		{name: "size and token are forwarded", args: map[string]any{"pageSize": float64(10), "pageToken": "abc"}, wantSize: 10, wantToken: "abc"},
+		{name: "size above the maximum", args: map[string]any{"pageSize": float64(500)}, wantErrSub: "pageSize must be an integer between 1 and 250"},
+		{name: "non-string token", args: map[string]any{"pageToken": 3.0}, wantErrSub: "pageToken must be a string"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var gotSize int64
+			var gotToken string
+			stub := &stubCalendarService{
+				listPageFn: func(calendarID string, timeMin, timeMax time.Time, pageSize int64, pageToken string) ([]*calendar.Event, string, error) {
+					gotSize, gotToken = pageSize, pageToken
+					return nil, "", nil
+				},
+			}
+			tool := &ListEventsPagedTool{logger: zap.NewNop(), google: stub}
+			_, err := tool.ListEventsPagedHandler(context.Background(), tc.args)
+
+			if tc.wantErrSub != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErrSub) {
+					t.Fatalf("error = %v, want substring %q", err, tc.wantErrSub)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if gotSize != tc.wantSize || gotToken != tc.wantToken {
+				t.Errorf("ListEventsPage(pageSize=%d, pageToken=%q), want (%d, %q)", gotSize, gotToken, tc.wantSize, tc.wantToken)
+			}
+		})
+	}
+}
@@ -0,0 +1,191 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	zap "go.uber.org/zap"
+	calendar "google.golang.org/api/calendar/v3"
+
+	server "github.com/inference-gateway/adk/server"
+
+	google "github.com/inference-gateway/google-calendar-agent/internal/google"
+)
+
+// eventTableColumns are the columns of the list_events_table output, in order.
+var eventTableColumns = []string{"time", "title", "location", "attendees"}
+
+// ListEventsTableTool struct holds the tool with dependencies
+type ListEventsTableTool struct {
+	logger *zap.Logger
+	google google.CalendarService
+}
+
+// NewListEventsTableTool creates a new list_events_table tool
+func NewListEventsTableTool(logger *zap.Logger, google google.CalendarService) server.Tool {
+	tool := &ListEventsTableTool{
+		logger: logger,
+		google: google,
+	}
+	return server.NewBasicTool(
+		"list_events_table",
+		"List events in a time range as a compact table of time, title, location and attendees, with a plaintext rendering",
+		map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"timeMax": map[string]any{
+					"description": "End of the range (RFC3339 format). Defaults to 7 days after timeMin.",
+					"type":        "string",
+				},
+				"timeMin": map[string]any{
+					"description": "Start of the range (RFC3339 format). Defaults to now.",
+					"type":        "string",
+				},
+			},
+		},
+		tool.ListEventsTableHandler,
+	)
+}
+
+// ListEventsTableHandler handles the list_events_table tool execution
+func (s *ListEventsTableTool) ListEventsTableHandler(ctx context.Context, args map[string]any) (string, error) {
+	span := startToolSpan(ctx, "list_events_table")
+	defer span.End()
+	s.logger.Debug("listing events as table", zap.Any("args", args))
+
+	timeMin := time.Now()
+	if tm, exists := args["timeMin"]; exists && tm != nil {
+		tmStr, ok := tm.(string)
+		if !ok {
+			return "", fmt.Errorf("timeMin must be a string, got %T", tm)
+		}
+		parsedTime, err := time.Parse(time.RFC3339, tmStr)
+		if err != nil {
+			return "", fmt.Errorf("invalid timeMin format (expected RFC3339): %w", err)
+		}
+		timeMin = parsedTime
+	}
+
+	timeMax := timeMin.AddDate(0, 0, 7)
+	if tm, exists := args["timeMax"]; exists && tm != nil {
+		tmStr, ok := tm.(string)
+		if !ok {
+			return "", fmt.Errorf("timeMax must be a string, got %T", tm)
+		}
+		parsedTime, err := time.Parse(time.RFC3339, tmStr)
+		if err != nil {
+			return "", fmt.Errorf("invalid timeMax format (expected RFC3339): %w", err)
+		}
+		timeMax = parsedTime
+	}
+	if !timeMax.After(timeMin) {
+		return "", fmt.Errorf("timeMax must be after timeMin")
+	}
+
+	format, err := loadDateFormat()
+	if err != nil {
+		return "", err
+	}
+
+	calendarID := s.google.GetCalendarID()
+	events, err := s.google.ListEvents(ctx, calendarID, timeMin, timeMax)
+	if err != nil {
+		s.logger.Error("failed to list calendar events", zap.Error(err))
+		return "", fmt.Errorf("failed to list calendar events: %w", err)
+	}
+
+	loc, _ := calendarTimezone(ctx, s.google, calendarID)
+	rows := [][]string{}
+	for _, event := range events {
+		if event.Status == "cancelled" {
+			continue
+		}
+		rows = append(rows, []string{
+			eventTableTime(event, loc, format),
+			event.Summary,
+			event.Location,
+			eventTableAttendees(event),
+		})
+	}
+
+	s.logger.Info("event table built successfully", zap.Int("count", len(rows)))
+
+	// dataPart mirrors the A2A DataPart shape so table-aware clients can
+	// render it directly; text is the fallback for everyone else.
+	result := map[string]any{
+		"success": true,
+		"dataPart": map[string]any{
+			"kind": "data",
+			"data": map[string]any{
+				"columns": eventTableColumns,
+				"rows":    rows,
+			},
+		},
+		"text":  renderEventTable(eventTableColumns, rows),
+		"count": len(rows),
+	}
+
+	resultJSON, err := json.Marshal(result)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal result: %w", err)
+	}
+
+	return string(resultJSON), nil
+}
+
+// eventTableTime is the time cell: the event's day followed by its time
+// range, or "All day".
+func eventTableTime(event *calendar.Event, loc *time.Location, format dateFormat) string {
+	if event.Start == nil {
+		return ""
+	}
+	if event.Start.DateTime == "" {
+		day, err := time.ParseInLocation("2006-01-02", event.Start.Date, loc)
+		if err != nil {
+			return event.Start.Date
+		}
+		return format.date(day) + " All day"
+	}
+	start, err := time.Parse(time.RFC3339, event.Start.DateTime)
+	if err != nil {
+		return event.Start.DateTime
+	}
+	return format.date(start.In(loc)) + " " + agendaTimeRange(event, loc, format)
+}
+
+// eventTableAttendees joins attendee emails, leaving out resources such as
+// meeting rooms.
+func eventTableAttendees(event *calendar.Event) string {
+	var emails []string
+	for _, attendee := range event.Attendees {
+		if attendee == nil || attendee.Resource || attendee.Email == "" {
+			continue
+		}
+		emails = append(emails, attendee.Email)
+	}
+	return strings.Join(emails, ", ")
+}
+
+// renderEventTable lays the rows out as aligned plaintext columns under an
+// upper-cased header.
+func renderEventTable(columns []string, rows [][]string) string {
+	if len(rows) == 0 {
+		return "No events scheduled."
+	}
+	var b strings.Builder
+	w := tabwriter.NewWriter(&b, 0, 0, 2, ' ', 0)
+	header := make([]string, len(columns))
+	for i, column := range columns {
+		header[i] = strings.ToUpper(column)
+	}
+	fmt.Fprintln(w, strings.Join(header, "\t"))
+	for _, row := range rows {
+		fmt.Fprintln(w, strings.Join(row, "\t"))
+	}
+	_ = w.Flush()
+	return strings.TrimRight(b.String(), "\n")
+}
@@ -0,0 +1,140 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	zap "go.uber.org/zap"
+	calendar "google.golang.org/api/calendar/v3"
+)
+
+func TestListEventsTableHandler(t *testing.T) {
+	t.Setenv("GOOGLE_CALENDAR_TIMEZONE", "UTC")
+	t.Setenv("TZ", "")
+	t.Setenv("GOOGLE_CALENDAR_LOCALE", "iso")
+
+	review := &calendar.Event{
+		Id:       "review",
+		Summary:  "Design review",
+		Location: "Room 4",
+		Start:    &calendar.EventDateTime{DateTime: "2026-05-20T10:00:00Z"},
+		End:      &calendar.EventDateTime{DateTime: "2026-05-20T11:00:00Z"},
+		Attendees: []*calendar.EventAttendee{
+			{Email: "ada@example.com"},
+			{Email: "room4@resource.example.com", Resource: true},
+			{Email: "grace@example.com"},
+		},
+	}
+	holiday := &calendar.Event{
+		Id:      "holiday",
+		Summary: "Holiday",
+		Start:   &calendar.EventDateTime{Date: "2026-05-21"},
+		End:     &calendar.EventDateTime{Date: "2026-05-22"},
+	}
+	cancelled := &calendar.Event{
+		Id:      "cancelled",
+		Status:  "cancelled",
+		Summary: "Dropped",
+		Start:   &calendar.EventDateTime{DateTime: "2026-05-20T12:00:00Z"},
+		End:     &calendar.EventDateTime{DateTime: "2026-05-20T13:00:00Z"},
+	}
+
+	tests := []struct {
+		name       string
+		events     []*calendar.Event
+		args       map[string]any
+		wantRows   [][]string
+		wantText   []string
+		wantErrSub string
+	}{
+		{
+			name:   "rows follow the column order",
+			events: []*calendar.Event{review, holiday, cancelled},
+			wantRows: [][]string{
+				{"2026-05-20 10:00–11:00", "Design review", "Room 4", "ada@example.com, grace@example.com"},
+				{"2026-05-21 All day", "Holiday", "", ""},
+			},
+			wantText: []string{"TIME", "TITLE", "LOCATION", "ATTENDEES", "Design review", "Holiday"},
+		},
+		{
+			name:     "empty range has a plaintext fallback",
+			wantRows: [][]string{},
+			wantText: []string{"No events scheduled."},
+		},
+		{
+			name:       "inverted range returns error",
+			args:       map[string]any{"timeMin": "2026-05-22T00:00:00Z", "timeMax": "2026-05-20T00:00:00Z"},
+			wantErrSub: "timeMax must be after timeMin",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			stub := &stubCalendarService{
+				listEventsFn: func(calendarID string, timeMin, timeMax time.Time) ([]*calendar.Event, error) {
+					return tc.events, nil
+				},
+			}
+			args := map[string]any{"timeMin": "2026-05-20T00:00:00Z", "timeMax": "2026-05-22T00:00:00Z"}
+			for k, v := range tc.args {
+				args[k] = v
+			}
+			tool := &ListEventsTableTool{logger: zap.NewNop(), google: stub}
+			result, err := tool.ListEventsTableHandler(context.Background(), args)
+
+			if tc.wantErrSub != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErrSub) {
+					t.Fatalf("error = %v, want substring %q", err, tc.wantErrSub)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			var parsed struct {
+				DataPart struct {
+					Kind string `json:"kind"`
+					Data struct {
+						Columns []string   `json:"columns"`
+						Rows    [][]string `json:"rows"`
+					} `json:"data"`
+				} `json:"dataPart"`
+				Text  string `json:"text"`
+				Count int    `json:"count"`
+			}
+			if err := json.Unmarshal([]byte(result), &parsed); err != nil {
+				t.Fatalf("failed to unmarshal result: %v", err)
+			}
+
+			if parsed.DataPart.Kind != "data" {
+				t.Errorf("dataPart.kind = %q, want data", parsed.DataPart.Kind)
+			}
+			if got := strings.Join(parsed.DataPart.Data.Columns, ","); got != "time,title,location,attendees" {
+				t.Errorf("columns = %s, want time,title,location,attendees", got)
+			}
+			if parsed.Count != len(tc.wantRows) || len(parsed.DataPart.Data.Rows) != len(tc.wantRows) {
+				t.Fatalf("got %d rows (count %d), want %d: %s", len(parsed.DataPart.Data.Rows), parsed.Count, len(tc.wantRows), result)
+			}
+			for i, row := range parsed.DataPart.Data.Rows {
+				if len(row) != len(parsed.DataPart.Data.Columns) {
+					t.Errorf("rows[%d] has %d cells, want %d", i, len(row), len(parsed.DataPart.Data.Columns))
+					continue
+				}
+				for j, cell := range row {
+					if cell != tc.wantRows[i][j] {
+						t.Errorf("rows[%d][%d] = %q, want %q", i, j, cell, tc.wantRows[i][j])
+					}
+				}
+			}
+			for _, want := range tc.wantText {
+				if !strings.Contains(parsed.Text, want) {
+					t.Errorf("text missing %q:\n%s", want, parsed.Text)
+				}
+			}
+		})
+	}
+}
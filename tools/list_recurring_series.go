@@ -0,0 +1,126 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	zap "go.uber.org/zap"
+
+	server "github.com/inference-gateway/adk/server"
+
+	google "github.com/inference-gateway/google-calendar-agent/internal/google"
+)
+
+// ListRecurringSeriesTool struct holds the tool with dependencies
+type ListRecurringSeriesTool struct {
+	logger *zap.Logger
+	google google.CalendarService
+}
+
+// NewListRecurringSeriesTool creates a new list_recurring_series tool
+func NewListRecurringSeriesTool(logger *zap.Logger, google google.CalendarService) server.Tool {
+	tool := &ListRecurringSeriesTool{
+		logger: logger,
+		google: google,
+	}
+	return server.NewBasicTool(
+		"list_recurring_series",
+		"List recurring meetings as series rather than individual occurrences, with each series' repeat rule in plain words",
+		map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"timeMax": map[string]any{
+					"description": "Only include series with an occurrence before this time (RFC3339 format). Optional.",
+					"type":        "string",
+				},
+				"timeMin": map[string]any{
+					"description": "Only include series with an occurrence after this time (RFC3339 format). Defaults to now, so ended series are left out.",
+					"type":        "string",
+				},
+			},
+		},
+		tool.ListRecurringSeriesHandler,
+	)
+}
+
+// ListRecurringSeriesHandler handles the list_recurring_series tool execution
+func (s *ListRecurringSeriesTool) ListRecurringSeriesHandler(ctx context.Context, args map[string]any) (string, error) {
+	span := startToolSpan(ctx, "list_recurring_series")
+	defer span.End()
+	s.logger.Debug("listing recurring series", zap.Any("args", args))
+
+	timeMin := time.Now()
+	if tm, exists := args["timeMin"]; exists && tm != nil {
+		tmStr, ok := tm.(string)
+		if !ok {
+			return "", fmt.Errorf("timeMin must be a string, got %T", tm)
+		}
+		parsedTime, err := time.Parse(time.RFC3339, tmStr)
+		if err != nil {
+			return "", fmt.Errorf("invalid timeMin format (expected RFC3339): %w", err)
+		}
+		timeMin = parsedTime
+	}
+
+	var timeMax time.Time
+	if tm, exists := args["timeMax"]; exists && tm != nil {
+		tmStr, ok := tm.(string)
+		if !ok {
+			return "", fmt.Errorf("timeMax must be a string, got %T", tm)
+		}
+		parsedTime, err := time.Parse(time.RFC3339, tmStr)
+		if err != nil {
+			return "", fmt.Errorf("invalid timeMax format (expected RFC3339): %w", err)
+		}
+		if !parsedTime.After(timeMin) {
+			return "", fmt.Errorf("timeMax must be after timeMin")
+		}
+		timeMax = parsedTime
+	}
+
+	calendarID := s.google.GetCalendarID()
+	events, err := s.google.ListRecurringSeries(ctx, calendarID, timeMin, timeMax)
+	if err != nil {
+		s.logger.Error("failed to list recurring series", zap.Error(err))
+		return "", fmt.Errorf("failed to list recurring series: %w", err)
+	}
+
+	series := []map[string]any{}
+	for _, event := range events {
+		if event.Status == "cancelled" || len(event.Recurrence) == 0 {
+			continue
+		}
+		entry := map[string]any{
+			"eventId":    event.Id,
+			"summary":    event.Summary,
+			"rule":       humanizeRecurrence(event.Recurrence),
+			"recurrence": event.Recurrence,
+			"startTime":  eventDateTimeString(event.Start),
+			"endTime":    eventDateTimeString(event.End),
+		}
+		if event.Location != "" {
+			entry["location"] = event.Location
+		}
+		if event.HtmlLink != "" {
+			entry["htmlLink"] = event.HtmlLink
+		}
+		series = append(series, entry)
+	}
+
+	s.logger.Info("recurring series listed", zap.Int("count", len(series)))
+
+	result := map[string]any{
+		"success": true,
+		"series":  series,
+		"count":   len(series),
+	}
+
+	resultJSON, err := json.Marshal(result)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal result: %w", err)
+	}
+
+	return string(resultJSON), nil
+}
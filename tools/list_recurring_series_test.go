@@ -0,0 +1,88 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	zap "go.uber.org/zap"
+	calendar "google.golang.org/api/calendar/v3"
+)
+
+func TestListRecurringSeriesHandler(t *testing.T) {
+	standup := &calendar.Event{
+		Id:         "standup",
+		Summary:    "Standup",
+		Recurrence: []string{"EXDATE:20260511T090000Z", "RRULE:FREQ=WEEKLY;BYDAY=MO"},
+		Start:      &calendar.EventDateTime{DateTime: "2026-05-04T09:00:00Z"},
+		End:        &calendar.EventDateTime{DateTime: "2026-05-04T09:15:00Z"},
+	}
+	oneOff := &calendar.Event{
+		Id:      "review",
+		Summary: "Design review",
+		Start:   &calendar.EventDateTime{DateTime: "2026-05-20T10:00:00Z"},
+		End:     &calendar.EventDateTime{DateTime: "2026-05-20T11:00:00Z"},
+	}
+
+	tests := []struct {
+		name       string
+		args       map[string]any
+		wantIDs    []string
+		wantRules  []string
+		wantErrSub string
+	}{
+		{
+			name:      "only recurring events are listed",
+			args:      map[string]any{"timeMin": "2026-05-18T00:00:00Z"},
+			wantIDs:   []string{"standup"},
+			wantRules: []string{"Weekly on Monday"},
+		},
+		{
+			name:       "timeMax before timeMin",
+			args:       map[string]any{"timeMin": "2026-05-18T00:00:00Z", "timeMax": "2026-05-01T00:00:00Z"},
+			wantErrSub: "timeMax must be after timeMin",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			stub := &stubCalendarService{
+				listSeriesFn: func(calendarID string, timeMin, timeMax time.Time) ([]*calendar.Event, error) {
+					return []*calendar.Event{standup, oneOff}, nil
+				},
+			}
+			tool := &ListRecurringSeriesTool{logger: zap.NewNop(), google: stub}
+			result, err := tool.ListRecurringSeriesHandler(context.Background(), tc.args)
+			if tc.wantErrSub != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErrSub) {
+					t.Fatalf("error = %v, want substring %q", err, tc.wantErrSub)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			var parsed struct {
+				Count  int `json:"count"`
+				Series []struct {
+					EventID string `json:"eventId"`
+					Rule    string `json:"rule"`
+				} `json:"series"`
+			}
+			if err := json.Unmarshal([]byte(result), &parsed); err != nil {
+				t.Fatalf("failed to unmarshal result: %v", err)
+			}
+			if parsed.Count != len(tc.wantIDs) || len(parsed.Series) != len(tc.wantIDs) {
+				t.Fatalf("got %d series, want %d", len(parsed.Series), len(tc.wantIDs))
+			}
+			for i, s := range parsed.Series {
+				if s.EventID != tc.wantIDs[i] || s.Rule != tc.wantRules[i] {
+					t.Errorf("series[%d] = %+v, want %s %q", i, s, tc.wantIDs[i], tc.wantRules[i])
+				}
+			}
+		})
+	}
+}
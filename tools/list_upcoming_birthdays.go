@@ -0,0 +1,196 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	zap "go.uber.org/zap"
+
+	server "github.com/inference-gateway/adk/server"
+
+	google "github.com/inference-gateway/google-calendar-agent/internal/google"
+)
+
+// ListUpcomingBirthdaysTool struct holds the tool with dependencies
+type ListUpcomingBirthdaysTool struct {
+	logger *zap.Logger
+	google google.CalendarService
+	now    func() time.Time
+}
+
+// NewListUpcomingBirthdaysTool creates a new list_upcoming_birthdays tool
+func NewListUpcomingBirthdaysTool(logger *zap.Logger, google google.CalendarService) server.Tool {
+	tool := &ListUpcomingBirthdaysTool{
+		logger: logger,
+		google: google,
+		now:    time.Now,
+	}
+	return server.NewBasicTool(
+		"list_upcoming_birthdays",
+		"List birthdays, anniversaries and other yearly all-day events coming up in the next N days",
+		map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"days": map[string]any{
+					"description": "How many days ahead to look (default: 30, max: 366)",
+					"maximum":     366,
+					"minimum":     1,
+					"type":        "integer",
+				},
+			},
+		},
+		tool.ListUpcomingBirthdaysHandler,
+	)
+}
+
+// upcomingOccasion is one yearly event and its next occurrence
+type upcomingOccasion struct {
+	eventID string
+	summary string
+	date    time.Time
+	days    int
+}
+
+// ListUpcomingBirthdaysHandler handles the list_upcoming_birthdays tool execution
+func (s *ListUpcomingBirthdaysTool) ListUpcomingBirthdaysHandler(ctx context.Context, args map[string]any) (string, error) {
+	span := startToolSpan(ctx, "list_upcoming_birthdays")
+	defer span.End()
+	s.logger.Debug("listing upcoming birthdays", zap.Any("args", args))
+
+	days := 30
+	if d, exists := args["days"]; exists && d != nil {
+		dFloat, ok := d.(float64)
+		if !ok {
+			return "", fmt.Errorf("days must be a number, got %T", d)
+		}
+		days = int(dFloat)
+	}
+	if days < 1 || days > 366 {
+		return "", fmt.Errorf("days must be between 1 and 366, got %d", days)
+	}
+
+	calendarID := s.google.GetCalendarID()
+	loc, _ := calendarTimezone(ctx, s.google, calendarID)
+	now := s.now().In(loc)
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, loc)
+	until := today.AddDate(0, 0, days+1)
+
+	instances, err := s.google.ListEvents(ctx, calendarID, today, until)
+	if err != nil {
+		s.logger.Error("failed to list calendar events", zap.Error(err))
+		return "", fmt.Errorf("failed to list calendar events: %w", err)
+	}
+
+	seen := map[string]bool{}
+	var occasions []upcomingOccasion
+	for _, instance := range instances {
+		if instance.Start == nil || instance.Start.Date == "" {
+			continue
+		}
+
+		masterID := instance.RecurringEventId
+		if masterID == "" {
+			masterID = instance.Id
+		}
+		if seen[masterID] {
+			continue
+		}
+		seen[masterID] = true
+
+		master := instance
+		if instance.RecurringEventId != "" {
+			master, err = s.google.GetEvent(ctx, calendarID, masterID)
+			if err != nil {
+				s.logger.Warn("failed to get recurring event", zap.String("eventId", masterID), zap.Error(err))
+				continue
+			}
+		}
+		if !isYearly(master.Recurrence) || master.Start == nil || master.Start.Date == "" {
+			continue
+		}
+
+		// The listed instance carries the real date, including moves and
+		// rules that skip years.
+		next, err := time.ParseInLocation("2006-01-02", instance.Start.Date, loc)
+		if err != nil {
+			continue
+		}
+		daysUntil := int(math.Round(next.Sub(today).Hours() / 24))
+		if daysUntil < 0 || daysUntil > days {
+			continue
+		}
+		occasions = append(occasions, upcomingOccasion{
+			eventID: masterID,
+			summary: master.Summary,
+			date:    next,
+			days:    daysUntil,
+		})
+	}
+
+	sort.SliceStable(occasions, func(i, j int) bool {
+		return occasions[i].date.Before(occasions[j].date)
+	})
+
+	s.logger.Info("upcoming birthdays retrieved successfully", zap.Int("count", len(occasions)))
+
+	var lines []string
+	var list []map[string]any
+	for _, o := range occasions {
+		line := fmt.Sprintf("%s: %s", relativeDays(o.days), o.summary)
+		lines = append(lines, line)
+		list = append(list, map[string]any{
+			"eventId":   o.eventID,
+			"summary":   o.summary,
+			"date":      o.date.Format("2006-01-02"),
+			"daysUntil": o.days,
+			"text":      line,
+		})
+	}
+
+	result := map[string]any{
+		"success":   true,
+		"occasions": list,
+		"count":     len(list),
+		"summary":   strings.Join(lines, "\n"),
+	}
+
+	resultJSON, err := json.Marshal(result)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal result: %w", err)
+	}
+
+	return string(resultJSON), nil
+}
+
+// isYearly reports whether any RRULE in recurrence repeats yearly.
+func isYearly(recurrence []string) bool {
+	for _, line := range recurrence {
+		parts, err := splitRRULE(line)
+		if err != nil {
+			continue
+		}
+		for _, part := range parts {
+			if part.name == "FREQ" && strings.EqualFold(part.value, "YEARLY") {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// relativeDays phrases a day offset the way people talk about dates.
+func relativeDays(days int) string {
+	switch days {
+	case 0:
+		return "Today"
+	case 1:
+		return "Tomorrow"
+	default:
+		return fmt.Sprintf("In %d days", days)
+	}
+}
@@ -0,0 +1,153 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	zap "go.uber.org/zap"
+	calendar "google.golang.org/api/calendar/v3"
+)
+
+func TestListUpcomingBirthdaysHandler(t *testing.T) {
+	t.Setenv("GOOGLE_CALENDAR_TIMEZONE", "UTC")
+
+	now := func() time.Time { return time.Date(2026, 5, 23, 15, 0, 0, 0, time.UTC) }
+
+	masters := map[string]*calendar.Event{
+		"bday-alice": {
+			Id:         "bday-alice",
+			Summary:    "Alice's birthday",
+			Recurrence: []string{"RRULE:FREQ=YEARLY"},
+			Start:      &calendar.EventDateTime{Date: "1990-05-26"},
+		},
+		"anniv": {
+			Id:         "anniv",
+			Summary:    "Wedding anniversary",
+			Recurrence: []string{"RRULE:FREQ=YEARLY;BYMONTH=5;BYMONTHDAY=23"},
+			Start:      &calendar.EventDateTime{Date: "2015-05-23"},
+		},
+		"biennial": {
+			Id:         "biennial",
+			Summary:    "Company offsite",
+			Recurrence: []string{"RRULE:FREQ=YEARLY;INTERVAL=2"},
+			Start:      &calendar.EventDateTime{Date: "2025-05-23"},
+		},
+		"weekly": {
+			Id:         "weekly",
+			Summary:    "Trash day",
+			Recurrence: []string{"RRULE:FREQ=WEEKLY"},
+			Start:      &calendar.EventDateTime{Date: "2026-01-01"},
+		},
+	}
+	instance := func(masterID, date string) *calendar.Event {
+		return &calendar.Event{
+			Id:               masterID + "_" + date,
+			RecurringEventId: masterID,
+			Summary:          masters[masterID].Summary,
+			Start:            &calendar.EventDateTime{Date: date},
+		}
+	}
+
+	tests := []struct {
+		name       string
+		args       map[string]any
+		events     []*calendar.Event
+		wantErrSub string
+		wantLines  []string
+	}{
+		{
+			name: "yearly all-day events are listed soonest first",
+			args: map[string]any{"days": float64(7)},
+			events: []*calendar.Event{
+				instance("weekly", "2026-05-24"),
+				instance("bday-alice", "2026-05-26"),
+				instance("anniv", "2026-05-23"),
+				instance("weekly", "2026-05-31"),
+				{Id: "meeting", Summary: "Standup", Start: &calendar.EventDateTime{DateTime: "2026-05-24T09:00:00Z"}},
+			},
+			wantLines: []string{
+				"Today: Wedding anniversary",
+				"In 3 days: Alice's birthday",
+			},
+		},
+		{
+			name: "a moved occurrence is listed on its new date",
+			args: map[string]any{"days": float64(7)},
+			events: []*calendar.Event{
+				{
+					Id:                "bday-alice_20260526",
+					RecurringEventId:  "bday-alice",
+					Summary:           "Alice's birthday",
+					OriginalStartTime: &calendar.EventDateTime{Date: "2026-05-26"},
+					Start:             &calendar.EventDateTime{Date: "2026-05-29"},
+				},
+			},
+			wantLines: []string{"In 6 days: Alice's birthday"},
+		},
+		{
+			name: "a series that skips years is only listed in the years it occurs",
+			args: map[string]any{"days": float64(366)},
+			events: []*calendar.Event{
+				instance("biennial", "2027-05-23"),
+			},
+			wantLines: []string{"In 365 days: Company offsite"},
+		},
+		{
+			name:      "nothing upcoming",
+			args:      map[string]any{},
+			events:    []*calendar.Event{instance("weekly", "2026-05-24")},
+			wantLines: nil,
+		},
+		{
+			name:       "days out of range",
+			args:       map[string]any{"days": float64(400)},
+			wantErrSub: "days must be between 1 and 366",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stub := &stubCalendarService{
+				listEventsFn: func(calendarID string, timeMin, timeMax time.Time) ([]*calendar.Event, error) {
+					return tt.events, nil
+				},
+				getEventFn: func(calendarID, eventID string) (*calendar.Event, error) {
+					if m, ok := masters[eventID]; ok {
+						return m, nil
+					}
+					return nil, errors.New("not found")
+				},
+			}
+			tool := &ListUpcomingBirthdaysTool{logger: zap.NewNop(), google: stub, now: now}
+
+			out, err := tool.ListUpcomingBirthdaysHandler(context.Background(), tt.args)
+			if tt.wantErrSub != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErrSub) {
+					t.Fatalf("error = %v, want containing %q", err, tt.wantErrSub)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			var result struct {
+				Count   int    `json:"count"`
+				Summary string `json:"summary"`
+			}
+			if err := json.Unmarshal([]byte(out), &result); err != nil {
+				t.Fatalf("invalid JSON: %v", err)
+			}
+			if result.Count != len(tt.wantLines) {
+				t.Errorf("count = %d, want %d", result.Count, len(tt.wantLines))
+			}
+			if result.Summary != strings.Join(tt.wantLines, "\n") {
+				t.Errorf("summary = %q, want %q", result.Summary, strings.Join(tt.wantLines, "\n"))
+			}
+		})
+	}
+}
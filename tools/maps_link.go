@@ -0,0 +1,50 @@
+package tools
+
+import (
+	"net/url"
+	"strings"
+)
+
+// mapsSearchURL is the Google Maps URL that searches for a place.
+const mapsSearchURL = "https://www.google.com/maps/search/?api=1&query="
+
+// virtualLocations are location values that name a call rather than a
+// place, so a map link would be useless.
+var virtualLocations = []string{
+	"online",
+	"virtual",
+	"remote",
+	"zoom",
+	"google meet",
+	"microsoft teams",
+	"teams",
+	"webex",
+	"phone",
+	"tbd",
+}
+
+// mapsLink returns a Google Maps search link for location, or "" when the
+// location is empty or virtual.
+func mapsLink(location string) string {
+	location = strings.TrimSpace(location)
+	if location == "" || isVirtualLocation(location) {
+		return ""
+	}
+	return mapsSearchURL + url.QueryEscape(location)
+}
+
+// isVirtualLocation reports whether location names a call rather than a
+// place: a URL, a known video-call host, or a placeholder such as "Online".
+func isVirtualLocation(location string) bool {
+	lower := strings.ToLower(strings.TrimSpace(location))
+	if strings.Contains(lower, "://") || strings.Contains(lower, "zoom.us/") ||
+		strings.Contains(lower, "meet.google.com") || strings.Contains(lower, "teams.microsoft.com") {
+		return true
+	}
+	for _, v := range virtualLocations {
+		if lower == v {
+			return true
+		}
+	}
+	return false
+}
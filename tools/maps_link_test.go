@@ -0,0 +1,41 @@
+package tools
+
+import "testing"
+
+func TestMapsLink(t *testing.T) {
+	tests := []struct {
+		name     string
+		location string
+		want     string
+	}{
+		{
+			name:     "street address is encoded",
+			location: "Friedrichstraße 43, 10117 Berlin",
+			want:     "https://www.google.com/maps/search/?api=1&query=Friedrichstra%C3%9Fe+43%2C+10117+Berlin",
+		},
+		{
+			name:     "reserved characters are escaped",
+			location: "Café & Bar #2 ?",
+			want:     "https://www.google.com/maps/search/?api=1&query=Caf%C3%A9+%26+Bar+%232+%3F",
+		},
+		{
+			name:     "surrounding whitespace is trimmed",
+			location: "  HQ-3F ",
+			want:     "https://www.google.com/maps/search/?api=1&query=HQ-3F",
+		},
+		{name: "empty", location: "", want: ""},
+		{name: "blank", location: "   ", want: ""},
+		{name: "video link", location: "https://meet.google.com/abc-defg-hij", want: ""},
+		{name: "zoom link without scheme", location: "us02web.zoom.us/j/123456", want: ""},
+		{name: "virtual placeholder", location: "Online", want: ""},
+		{name: "video call product", location: "Microsoft Teams", want: ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := mapsLink(tt.location); got != tt.want {
+				t.Errorf("mapsLink(%q) = %q, want %q", tt.location, got, tt.want)
+			}
+		})
+	}
+}
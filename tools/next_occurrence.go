@@ -0,0 +1,131 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	zap "go.uber.org/zap"
+
+	server "github.com/inference-gateway/adk/server"
+
+	google "github.com/inference-gateway/google-calendar-agent/internal/google"
+)
+
+// NextOccurrenceTool struct holds the tool with dependencies
+type NextOccurrenceTool struct {
+	logger *zap.Logger
+	google google.CalendarService
+}
+
+// NewNextOccurrenceTool creates a new next_occurrence tool
+func NewNextOccurrenceTool(logger *zap.Logger, google google.CalendarService) server.Tool {
+	tool := &NextOccurrenceTool{
+		logger: logger,
+		google: google,
+	}
+	return server.NewBasicTool(
+		"next_occurrence",
+		"Work out when a recurring event happens next from its repeat rule, or report that the series has ended",
+		map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"eventId": map[string]any{
+					"description": "ID of the recurring series, or of one of its occurrences (required)",
+					"type":        "string",
+				},
+			},
+			"required": []string{"eventId"},
+		},
+		tool.NextOccurrenceHandler,
+	)
+}
+
+// NextOccurrenceHandler handles the next_occurrence tool execution
+func (s *NextOccurrenceTool) NextOccurrenceHandler(ctx context.Context, args map[string]any) (string, error) {
+	span := startToolSpan(ctx, "next_occurrence")
+	defer span.End()
+	s.logger.Debug("finding next occurrence", zap.Any("args", args))
+
+	eventID, ok := args["eventId"].(string)
+	if !ok || eventID == "" {
+		return "", fmt.Errorf("eventId is required")
+	}
+
+	calendarID := s.google.GetCalendarID()
+	event, err := s.google.GetEvent(ctx, calendarID, eventID)
+	if err != nil {
+		s.logger.Error("failed to get calendar event", zap.Error(err), zap.String("eventId", eventID))
+		return "", fmt.Errorf("failed to get calendar event on '%s': %w", calendarLabel(ctx, s.google, calendarID), err)
+	}
+	if len(event.Recurrence) == 0 && event.RecurringEventId != "" {
+		event, err = s.google.GetEvent(ctx, calendarID, event.RecurringEventId)
+		if err != nil {
+			s.logger.Error("failed to get recurring series", zap.Error(err), zap.String("eventId", eventID))
+			return "", fmt.Errorf("failed to get recurring series of event %s: %w", eventID, err)
+		}
+	}
+
+	rule := findRRULE(event.Recurrence)
+	if rule == "" {
+		return "", fmt.Errorf("event %s is not a recurring series", eventID)
+	}
+
+	loc, _ := calendarTimezone(ctx, s.google, calendarID)
+	if event.Start != nil && event.Start.TimeZone != "" {
+		if eventLoc, err := time.LoadLocation(event.Start.TimeZone); err == nil {
+			loc = eventLoc
+		}
+	}
+	start, end, ok := eventTimes(event, loc)
+	if !ok {
+		return "", fmt.Errorf("event %s has no readable start time", event.Id)
+	}
+	parsed, err := parseRRULE(rule, loc)
+	if err != nil {
+		return "", err
+	}
+
+	next, last, found := nextRRULEOccurrence(parsed, start, time.Now())
+	allDay := event.Start.DateTime == ""
+	formatTime := func(t time.Time) string {
+		if allDay {
+			return t.Format("2006-01-02")
+		}
+		return t.Format(time.RFC3339)
+	}
+
+	s.logger.Info("next occurrence computed",
+		zap.String("eventId", event.Id),
+		zap.Bool("found", found))
+
+	result := map[string]any{
+		"success": true,
+		"eventId": event.Id,
+		"summary": event.Summary,
+		"rule":    humanizeRRULE(rule),
+		"found":   found,
+	}
+	if found {
+		result["startTime"] = formatTime(next)
+		if allDay {
+			days := int(end.Sub(start).Round(24*time.Hour) / (24 * time.Hour))
+			result["endTime"] = formatTime(next.AddDate(0, 0, days))
+		} else {
+			result["endTime"] = formatTime(next.Add(end.Sub(start)))
+		}
+	} else {
+		result["message"] = "The series has ended."
+		if !last.IsZero() {
+			result["lastOccurrence"] = formatTime(last)
+		}
+	}
+
+	resultJSON, err := json.Marshal(result)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal result: %w", err)
+	}
+
+	return string(resultJSON), nil
+}
@@ -0,0 +1,133 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	zap "go.uber.org/zap"
+	calendar "google.golang.org/api/calendar/v3"
+)
+
+func TestNextOccurrenceHandler(t *testing.T) {
+	t.Setenv("GOOGLE_CALENDAR_TIMEZONE", "UTC")
+	t.Setenv("TZ", "")
+
+	// A weekly series that started four weeks ago at 09:00 UTC recurs
+	// within the coming week.
+	now := time.Now().UTC()
+	started := time.Date(now.Year(), now.Month(), now.Day()-28, 9, 0, 0, 0, time.UTC)
+	weekly := &calendar.Event{
+		Id:         "standup",
+		Summary:    "Standup",
+		Start:      &calendar.EventDateTime{DateTime: started.Format(time.RFC3339)},
+		End:        &calendar.EventDateTime{DateTime: started.Add(15 * time.Minute).Format(time.RFC3339)},
+		Recurrence: []string{"RRULE:FREQ=WEEKLY"},
+	}
+	ended := &calendar.Event{
+		Id:         "kickoff",
+		Summary:    "Project kickoff",
+		Start:      &calendar.EventDateTime{DateTime: "2025-03-03T10:00:00Z"},
+		End:        &calendar.EventDateTime{DateTime: "2025-03-03T11:00:00Z"},
+		Recurrence: []string{"RRULE:FREQ=WEEKLY;COUNT=4"},
+	}
+	instance := &calendar.Event{
+		Id:               "standup_20260518T090000Z",
+		RecurringEventId: "standup",
+		Summary:          "Standup",
+	}
+	single := &calendar.Event{
+		Id:      "lunch",
+		Summary: "Lunch",
+		Start:   &calendar.EventDateTime{DateTime: "2026-05-18T12:00:00Z"},
+		End:     &calendar.EventDateTime{DateTime: "2026-05-18T13:00:00Z"},
+	}
+	events := map[string]*calendar.Event{}
+	for _, e := range []*calendar.Event{weekly, ended, instance, single} {
+		events[e.Id] = e
+	}
+
+	tests := []struct {
+		name       string
+		eventID    string
+		wantFound  bool
+		wantLast   string
+		wantErrSub string
+	}{
+		{name: "weekly series", eventID: "standup", wantFound: true},
+		{name: "occurrence resolves to its series", eventID: "standup_20260518T090000Z", wantFound: true},
+		{name: "series ended by COUNT", eventID: "kickoff", wantLast: "2025-03-24T10:00:00Z"},
+		{name: "single event", eventID: "lunch", wantErrSub: "is not a recurring series"},
+		{name: "unknown event", eventID: "missing", wantErrSub: "failed to get calendar event"},
+		{name: "missing eventId", wantErrSub: "eventId is required"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			stub := &stubCalendarService{
+				getEventFn: func(calendarID, eventID string) (*calendar.Event, error) {
+					if e, ok := events[eventID]; ok {
+						return e, nil
+					}
+					return nil, errors.New("not found")
+				},
+			}
+			tool := &NextOccurrenceTool{logger: zap.NewNop(), google: stub}
+			args := map[string]any{}
+			if tc.eventID != "" {
+				args["eventId"] = tc.eventID
+			}
+			result, err := tool.NextOccurrenceHandler(context.Background(), args)
+
+			if tc.wantErrSub != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErrSub) {
+					t.Fatalf("error = %v, want substring %q", err, tc.wantErrSub)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			var parsed struct {
+				EventID        string `json:"eventId"`
+				Found          bool   `json:"found"`
+				StartTime      string `json:"startTime"`
+				EndTime        string `json:"endTime"`
+				LastOccurrence string `json:"lastOccurrence"`
+			}
+			if err := json.Unmarshal([]byte(result), &parsed); err != nil {
+				t.Fatalf("failed to unmarshal result: %v", err)
+			}
+			if parsed.Found != tc.wantFound {
+				t.Fatalf("found = %v, want %v", parsed.Found, tc.wantFound)
+			}
+			if !tc.wantFound {
+				if parsed.LastOccurrence != tc.wantLast {
+					t.Errorf("lastOccurrence = %q, want %q", parsed.LastOccurrence, tc.wantLast)
+				}
+				return
+			}
+
+			if parsed.EventID != "standup" {
+				t.Errorf("eventId = %q, want the series ID", parsed.EventID)
+			}
+			start, err := time.Parse(time.RFC3339, parsed.StartTime)
+			if err != nil {
+				t.Fatalf("startTime %q: %v", parsed.StartTime, err)
+			}
+			if !start.After(now) || start.Sub(now) > 7*24*time.Hour {
+				t.Errorf("startTime = %s, want within a week after %s", start, now)
+			}
+			if start.Weekday() != started.Weekday() || start.Hour() != 9 {
+				t.Errorf("startTime = %s, want a %s at 09:00", start, started.Weekday())
+			}
+			if end, _ := time.Parse(time.RFC3339, parsed.EndTime); end.Sub(start) != 15*time.Minute {
+				t.Errorf("endTime = %s, want 15 minutes after startTime", parsed.EndTime)
+			}
+		})
+	}
+}
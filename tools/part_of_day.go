@@ -0,0 +1,77 @@
+package tools
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// partsOfDay are the partOfDay values find_available_time accepts.
+var partsOfDay = []string{"morning", "afternoon", "evening"}
+
+// loadPartOfDay reads the HH:MM-HH:MM range configured for part from
+// GOOGLE_CALENDAR_MORNING_HOURS, _AFTERNOON_HOURS or _EVENING_HOURS.
+func loadPartOfDay(part string) (workingHours, error) {
+	cfg, err := loadCalendarSettings()
+	if err != nil {
+		return workingHours{}, err
+	}
+
+	var value string
+	switch part {
+	case "morning":
+		value = cfg.MorningHours
+	case "afternoon":
+		value = cfg.AfternoonHours
+	case "evening":
+		value = cfg.EveningHours
+	default:
+		return workingHours{}, fmt.Errorf("partOfDay must be one of %s, got %q", strings.Join(partsOfDay, ", "), part)
+	}
+
+	start, end, ok := strings.Cut(value, "-")
+	if !ok {
+		return workingHours{}, fmt.Errorf("invalid %s hours %q (expected HH:MM-HH:MM)", part, value)
+	}
+	return parseWorkingHours(strings.TrimSpace(start), strings.TrimSpace(end))
+}
+
+// dailyWindows returns hours on every day between from and until in loc,
+// clipped to [from, until]. Unlike working hours it includes weekends.
+func (w workingHours) dailyWindows(from, until time.Time, loc *time.Location) []timeSlot {
+	var windows []timeSlot
+	from = from.In(loc)
+	for day := time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, loc); day.Before(until); day = day.AddDate(0, 0, 1) {
+		start, end := day.Add(w.start), day.Add(w.end)
+		if start.Before(from) {
+			start = from
+		}
+		if end.After(until) {
+			end = until
+		}
+		if end.After(start) {
+			windows = append(windows, timeSlot{startTime: start, endTime: end, duration: end.Sub(start)})
+		}
+	}
+	return windows
+}
+
+// clipBusyPeriods returns the parts of the sorted busy periods that fall
+// between start and end.
+func clipBusyPeriods(busy []timeSlot, start, end time.Time) []timeSlot {
+	var clipped []timeSlot
+	for _, b := range busy {
+		if !b.endTime.After(start) || !b.startTime.Before(end) {
+			continue
+		}
+		if b.startTime.Before(start) {
+			b.startTime = start
+		}
+		if b.endTime.After(end) {
+			b.endTime = end
+		}
+		b.duration = b.endTime.Sub(b.startTime)
+		clipped = append(clipped, b)
+	}
+	return clipped
+}
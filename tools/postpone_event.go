@@ -0,0 +1,127 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	zap "go.uber.org/zap"
+	calendar "google.golang.org/api/calendar/v3"
+
+	server "github.com/inference-gateway/adk/server"
+
+	google "github.com/inference-gateway/google-calendar-agent/internal/google"
+)
+
+// PostponeEventTool struct holds the tool with dependencies
+type PostponeEventTool struct {
+	logger *zap.Logger
+	google google.CalendarService
+}
+
+// NewPostponeEventTool creates a new postpone_event tool
+func NewPostponeEventTool(logger *zap.Logger, google google.CalendarService) server.Tool {
+	tool := &PostponeEventTool{
+		logger: logger,
+		google: google,
+	}
+	return server.NewBasicTool(
+		"postpone_event",
+		"Push an event back by a duration, shifting its start and end by the same amount",
+		map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"byDuration": map[string]any{
+					"description": "How far to push the event back, as a Go duration such as \"15m\", \"1h\" or \"1h30m\" (required)",
+					"type":        "string",
+				},
+				"eventId": map[string]any{
+					"description": "ID of the event to postpone (required)",
+					"type":        "string",
+				},
+			},
+			"required": []string{"eventId", "byDuration"},
+		},
+		tool.PostponeEventHandler,
+	)
+}
+
+// PostponeEventHandler handles the postpone_event tool execution
+func (s *PostponeEventTool) PostponeEventHandler(ctx context.Context, args map[string]any) (string, error) {
+	span := startToolSpan(ctx, "postpone_event")
+	defer span.End()
+	s.logger.Debug("postponing calendar event", zap.Any("args", args))
+
+	eventID, ok := args["eventId"].(string)
+	if !ok || eventID == "" {
+		return "", fmt.Errorf("eventId is required")
+	}
+
+	byStr, ok := args["byDuration"].(string)
+	if !ok || byStr == "" {
+		return "", fmt.Errorf("byDuration is required")
+	}
+	by, err := time.ParseDuration(byStr)
+	if err != nil {
+		return "", fmt.Errorf("invalid byDuration %q (expected a duration such as 15m or 1h): %w", byStr, err)
+	}
+	if by <= 0 {
+		return "", fmt.Errorf("byDuration must be positive, got %s", byStr)
+	}
+
+	calendarID := s.google.GetCalendarID()
+	event, err := s.google.GetEvent(ctx, calendarID, eventID)
+	if err != nil {
+		s.logger.Error("failed to get calendar event", zap.Error(err), zap.String("eventId", eventID))
+		return "", fmt.Errorf("failed to get calendar event on '%s': %w", calendarLabel(ctx, s.google, calendarID), err)
+	}
+	if event.Start == nil || event.End == nil || event.Start.DateTime == "" || event.End.DateTime == "" {
+		return "", fmt.Errorf("event %s is an all-day event and cannot be postponed by a duration", eventID)
+	}
+
+	oldStart, err := time.Parse(time.RFC3339, event.Start.DateTime)
+	if err != nil {
+		return "", fmt.Errorf("invalid event start time: %w", err)
+	}
+	oldEnd, err := time.Parse(time.RFC3339, event.End.DateTime)
+	if err != nil {
+		return "", fmt.Errorf("invalid event end time: %w", err)
+	}
+	newStart, newEnd := oldStart.Add(by), oldEnd.Add(by)
+
+	event.Start = &calendar.EventDateTime{DateTime: newStart.Format(time.RFC3339), TimeZone: event.Start.TimeZone}
+	event.End = &calendar.EventDateTime{DateTime: newEnd.Format(time.RFC3339), TimeZone: event.End.TimeZone}
+
+	updatedEvent, err := s.google.UpdateEvent(ctx, calendarID, eventID, event)
+	if err != nil {
+		label := calendarLabel(ctx, s.google, calendarID)
+		s.logger.Error("failed to postpone calendar event", zap.Error(err), zap.String("eventId", eventID), zap.String("calendar", label))
+		return "", fmt.Errorf("failed to postpone calendar event on '%s': %w", label, err)
+	}
+
+	s.logger.Info("calendar event postponed successfully",
+		zap.String("eventId", updatedEvent.Id),
+		zap.Duration("by", by))
+
+	result := map[string]any{
+		"success":      true,
+		"eventId":      updatedEvent.Id,
+		"summary":      updatedEvent.Summary,
+		"startTime":    newStart.Format(time.RFC3339),
+		"endTime":      newEnd.Format(time.RFC3339),
+		"oldStartTime": oldStart.Format(time.RFC3339),
+		"oldEndTime":   oldEnd.Format(time.RFC3339),
+		"htmlLink":     updatedEvent.HtmlLink,
+	}
+	if viewLink := viewLinkFor(calendarID, updatedEvent); viewLink != "" {
+		result["viewLink"] = viewLink
+	}
+
+	resultJSON, err := json.Marshal(result)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal result: %w", err)
+	}
+
+	return string(resultJSON), nil
+}
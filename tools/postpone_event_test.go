@@ -0,0 +1,113 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+
+	zap "go.uber.org/zap"
+	calendar "google.golang.org/api/calendar/v3"
+)
+
+func TestPostponeEventHandler(t *testing.T) {
+	tests := []struct {
+		name       string
+		args       map[string]any
+		event      *calendar.Event
+		wantErrSub string
+		wantStart  string
+		wantEnd    string
+		wantTZ     string
+	}{
+		{
+			name: "shifts start and end keeping the offset",
+			args: map[string]any{"eventId": "evt-1", "byDuration": "15m"},
+			event: &calendar.Event{
+				Id:      "evt-1",
+				Summary: "Standup",
+				Start:   &calendar.EventDateTime{DateTime: "2026-05-20T09:45:00+02:00", TimeZone: "Europe/Berlin"},
+				End:     &calendar.EventDateTime{DateTime: "2026-05-20T10:00:00+02:00", TimeZone: "Europe/Berlin"},
+			},
+			wantStart: "2026-05-20T10:00:00+02:00",
+			wantEnd:   "2026-05-20T10:15:00+02:00",
+			wantTZ:    "Europe/Berlin",
+		},
+		{
+			name: "hours and minutes combined",
+			args: map[string]any{"eventId": "evt-1", "byDuration": "1h30m"},
+			event: &calendar.Event{
+				Id:    "evt-1",
+				Start: &calendar.EventDateTime{DateTime: "2026-05-20T23:00:00Z"},
+				End:   &calendar.EventDateTime{DateTime: "2026-05-21T00:00:00Z"},
+			},
+			wantStart: "2026-05-21T00:30:00Z",
+			wantEnd:   "2026-05-21T01:30:00Z",
+		},
+		{
+			name:       "invalid duration string",
+			args:       map[string]any{"eventId": "evt-1", "byDuration": "15 minutes"},
+			wantErrSub: `invalid byDuration "15 minutes"`,
+		},
+		{
+			name:       "negative duration",
+			args:       map[string]any{"eventId": "evt-1", "byDuration": "-15m"},
+			wantErrSub: "byDuration must be positive",
+		},
+		{
+			name: "all-day event",
+			args: map[string]any{"eventId": "evt-1", "byDuration": "1h"},
+			event: &calendar.Event{
+				Id:    "evt-1",
+				Start: &calendar.EventDateTime{Date: "2026-05-20"},
+				End:   &calendar.EventDateTime{Date: "2026-05-21"},
+			},
+			wantErrSub: "all-day event",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var updated *calendar.Event
+			stub := &stubCalendarService{
+				getEventFn: func(calendarID, eventID string) (*calendar.Event, error) {
+					return tc.event, nil
+				},
+				updateEventFn: func(calendarID, eventID string, event *calendar.Event) (*calendar.Event, error) {
+					updated = event
+					return event, nil
+				},
+			}
+			tool := &PostponeEventTool{logger: zap.NewNop(), google: stub}
+			result, err := tool.PostponeEventHandler(context.Background(), tc.args)
+
+			if tc.wantErrSub != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErrSub) {
+					t.Fatalf("error = %v, want substring %q", err, tc.wantErrSub)
+				}
+				if updated != nil {
+					t.Error("event was updated despite the error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			if updated.Start.DateTime != tc.wantStart || updated.End.DateTime != tc.wantEnd {
+				t.Errorf("updated to %s-%s, want %s-%s", updated.Start.DateTime, updated.End.DateTime, tc.wantStart, tc.wantEnd)
+			}
+			if updated.Start.TimeZone != tc.wantTZ {
+				t.Errorf("TimeZone = %q, want %q", updated.Start.TimeZone, tc.wantTZ)
+			}
+
+			var parsed map[string]any
+			if err := json.Unmarshal([]byte(result), &parsed); err != nil {
+				t.Fatalf("failed to unmarshal result: %v", err)
+			}
+			if parsed["startTime"] != tc.wantStart {
+				t.Errorf("startTime = %v, want %s", parsed["startTime"], tc.wantStart)
+			}
+		})
+	}
+}
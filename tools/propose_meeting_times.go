@@ -0,0 +1,253 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	zap "go.uber.org/zap"
+
+	server "github.com/inference-gateway/adk/server"
+
+	google "github.com/inference-gateway/google-calendar-agent/internal/google"
+)
+
+const (
+	// maxProposals is how many slots propose_meeting_times returns.
+	maxProposals = 3
+	// proposalStep is the spacing of candidate start times.
+	proposalStep = 30 * time.Minute
+)
+
+// ProposeMeetingTimesTool struct holds the tool with dependencies
+type ProposeMeetingTimesTool struct {
+	logger *zap.Logger
+	google google.CalendarService
+}
+
+// NewProposeMeetingTimesTool creates a new propose_meeting_times tool
+func NewProposeMeetingTimesTool(logger *zap.Logger, google google.CalendarService) server.Tool {
+	tool := &ProposeMeetingTimesTool{
+		logger: logger,
+		google: google,
+	}
+	return server.NewBasicTool(
+		"propose_meeting_times",
+		"Propose the top 3 meeting times within working hours where the most attendees are free, ranked by how many can attend; unlike find_available_time it does not require everyone to be free",
+		map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"attendees": map[string]any{
+					"description": "Email addresses of the people to meet with (required). Include the user's own address to count them too.",
+					"items":       map[string]any{"type": "string"},
+					"type":        "array",
+				},
+				"duration": map[string]any{
+					"description": "Meeting length in minutes (default: 30)",
+					"maximum":     480,
+					"minimum":     15,
+					"type":        "integer",
+				},
+				"endDate": map[string]any{
+					"description": "End of the search window (RFC3339 format, required)",
+					"type":        "string",
+				},
+				"startDate": map[string]any{
+					"description": "Start of the search window (RFC3339 format, required)",
+					"type":        "string",
+				},
+			},
+			"required": []string{"attendees", "startDate", "endDate"},
+		},
+		tool.ProposeMeetingTimesHandler,
+	)
+}
+
+// proposal is a candidate slot with the attendees free and busy in it.
+type proposal struct {
+	slot timeSlot
+	free []string
+	busy []string
+}
+
+// ProposeMeetingTimesHandler handles the propose_meeting_times tool execution
+func (s *ProposeMeetingTimesTool) ProposeMeetingTimesHandler(ctx context.Context, args map[string]any) (string, error) {
+	span := startToolSpan(ctx, "propose_meeting_times")
+	defer span.End()
+	s.logger.Debug("proposing meeting times", zap.Any("args", args))
+
+	list, ok := args["attendees"].([]any)
+	if !ok || len(list) == 0 {
+		return "", fmt.Errorf("attendees is required")
+	}
+	attendees, _, err := normalizeAttendees(list, "reject")
+	if err != nil {
+		return "", err
+	}
+	if len(attendees) == 0 {
+		return "", fmt.Errorf("attendees is required")
+	}
+
+	startDateStr, ok := args["startDate"].(string)
+	if !ok || startDateStr == "" {
+		return "", fmt.Errorf("startDate is required")
+	}
+	endDateStr, ok := args["endDate"].(string)
+	if !ok || endDateStr == "" {
+		return "", fmt.Errorf("endDate is required")
+	}
+	startDate, err := time.Parse(time.RFC3339, startDateStr)
+	if err != nil {
+		return "", fmt.Errorf("invalid startDate format: %w", err)
+	}
+	endDate, err := time.Parse(time.RFC3339, endDateStr)
+	if err != nil {
+		return "", fmt.Errorf("invalid endDate format: %w", err)
+	}
+	if !endDate.After(startDate) {
+		return "", fmt.Errorf("endDate must be after startDate")
+	}
+
+	duration := 30
+	if d, exists := args["duration"]; exists && d != nil {
+		dFloat, ok := d.(float64)
+		if !ok || dFloat <= 0 {
+			return "", fmt.Errorf("duration must be a positive number of minutes, got %v", d)
+		}
+		duration = int(dFloat)
+	}
+
+	hours, err := loadWorkingHours()
+	if err != nil {
+		return "", err
+	}
+
+	calendars, err := s.google.QueryFreeBusy(ctx, attendees, startDate, endDate)
+	if err != nil {
+		s.logger.Error("failed to query free/busy", zap.Error(err), zap.Strings("attendees", attendees))
+		return "", fmt.Errorf("failed to query free/busy: %w", err)
+	}
+
+	busyByAttendee := map[string][]timeSlot{}
+	var considered []string
+	inaccessible := []map[string]string{}
+	for _, email := range attendees {
+		busy, err := freeBusyPeriods(calendars, email)
+		if err != nil {
+			inaccessible = append(inaccessible, map[string]string{"email": email, "reason": err.Error()})
+			continue
+		}
+		busyByAttendee[email] = busy
+		considered = append(considered, email)
+	}
+
+	loc, _ := calendarTimezone(ctx, s.google, s.google.GetCalendarID())
+	proposals := rankProposals(
+		candidateSlots(hours, startDate, endDate, time.Duration(duration)*time.Minute, loc),
+		considered, busyByAttendee)
+
+	slots := []map[string]any{}
+	for _, p := range proposals {
+		slots = append(slots, map[string]any{
+			"startTime":      p.slot.startTime.Format(time.RFC3339),
+			"endTime":        p.slot.endTime.Format(time.RFC3339),
+			"availableCount": len(p.free),
+			"freeAttendees":  p.free,
+			"busyAttendees":  p.busy,
+		})
+	}
+
+	s.logger.Info("meeting times proposed",
+		zap.Int("attendees", len(attendees)),
+		zap.Int("inaccessible", len(inaccessible)),
+		zap.Int("proposals", len(slots)))
+
+	result := map[string]any{
+		"success":               true,
+		"proposals":             slots,
+		"count":                 len(slots),
+		"attendeeCount":         len(considered),
+		"inaccessibleAttendees": inaccessible,
+		"requestedDuration":     duration,
+	}
+
+	resultJSON, err := json.Marshal(result)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal result: %w", err)
+	}
+
+	return string(resultJSON), nil
+}
+
+// candidateSlots returns slots of the given duration starting every
+// proposalStep inside weekday working hours between from and until.
+func candidateSlots(hours workingHours, from, until time.Time, duration time.Duration, loc *time.Location) []timeSlot {
+	var candidates []timeSlot
+	for _, window := range hours.dailyWindows(from, until, loc) {
+		if wd := window.startTime.Weekday(); wd == time.Saturday || wd == time.Sunday {
+			continue
+		}
+		start := window.startTime.Truncate(proposalStep)
+		if start.Before(window.startTime) {
+			start = start.Add(proposalStep)
+		}
+		for ; !start.Add(duration).After(window.endTime); start = start.Add(proposalStep) {
+			candidates = append(candidates, timeSlot{startTime: start, endTime: start.Add(duration), duration: duration})
+		}
+	}
+	return candidates
+}
+
+// rankProposals scores each candidate by how many attendees are free in it
+// and returns the best maxProposals slots that do not overlap each other,
+// most attendees first and earliest first among equals. Slots nobody can
+// attend are dropped.
+func rankProposals(candidates []timeSlot, attendees []string, busyByAttendee map[string][]timeSlot) []proposal {
+	var scored []proposal
+	for _, candidate := range candidates {
+		p := proposal{slot: candidate, free: []string{}, busy: []string{}}
+		for _, email := range attendees {
+			if slotBusy(candidate, busyByAttendee[email]) {
+				p.busy = append(p.busy, email)
+			} else {
+				p.free = append(p.free, email)
+			}
+		}
+		if len(p.free) > 0 {
+			scored = append(scored, p)
+		}
+	}
+	sort.SliceStable(scored, func(i, j int) bool {
+		return len(scored[i].free) > len(scored[j].free)
+	})
+
+	var picked []proposal
+	for _, p := range scored {
+		if len(picked) == maxProposals {
+			break
+		}
+		clash := false
+		for _, q := range picked {
+			if p.slot.overlaps(q.slot) {
+				clash = true
+				break
+			}
+		}
+		if !clash {
+			picked = append(picked, p)
+		}
+	}
+	return picked
+}
+
+// slotBusy reports whether slot overlaps any of the busy periods.
+func slotBusy(slot timeSlot, busy []timeSlot) bool {
+	for _, b := range busy {
+		if slot.overlaps(b) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,135 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	zap "go.uber.org/zap"
+	calendar "google.golang.org/api/calendar/v3"
+)
+
+func TestProposeMeetingTimesHandler(t *testing.T) {
+	t.Setenv("GOOGLE_CALENDAR_TIMEZONE", "UTC")
+	t.Setenv("TZ", "")
+
+	busy := func(periods ...string) calendar.FreeBusyCalendar {
+		var fb calendar.FreeBusyCalendar
+		for i := 0; i < len(periods); i += 2 {
+			fb.Busy = append(fb.Busy, &calendar.TimePeriod{
+				Start: "2026-05-20T" + periods[i] + ":00Z",
+				End:   "2026-05-20T" + periods[i+1] + ":00Z",
+			})
+		}
+		return fb
+	}
+	// Nobody is free for the whole morning: alice is busy first thing, bob
+	// most of the time, carol mid-morning.
+	calendars := map[string]calendar.FreeBusyCalendar{
+		"alice@example.com": busy("09:00", "10:00"),
+		"bob@example.com":   busy("09:00", "10:30", "11:00", "12:00"),
+		"carol@example.com": busy("10:00", "11:00"),
+		"dave@example.com":  {Errors: []*calendar.Error{{Reason: "notFound"}}},
+	}
+
+	type want struct {
+		start string
+		free  string
+	}
+	tests := []struct {
+		name             string
+		attendees        []any
+		want             []want
+		wantInaccessible int
+		wantErrSub       string
+	}{
+		{
+			name:      "ranks slots by how many attendees are free",
+			attendees: []any{"alice@example.com", "bob@example.com", "carol@example.com"},
+			want: []want{
+				{"2026-05-20T11:00:00Z", "alice@example.com,carol@example.com"},
+				{"2026-05-20T09:00:00Z", "carol@example.com"},
+				{"2026-05-20T10:00:00Z", "alice@example.com"},
+			},
+		},
+		{
+			name:      "inaccessible calendars are reported, not counted",
+			attendees: []any{"alice@example.com", "carol@example.com", "dave@example.com"},
+			want: []want{
+				{"2026-05-20T11:00:00Z", "alice@example.com,carol@example.com"},
+				{"2026-05-20T09:00:00Z", "carol@example.com"},
+				{"2026-05-20T10:00:00Z", "alice@example.com"},
+			},
+			wantInaccessible: 1,
+		},
+		{
+			name:       "attendees are required",
+			attendees:  []any{},
+			wantErrSub: "attendees is required",
+		},
+		{
+			name:       "malformed attendee returns error",
+			attendees:  []any{"not-an-email"},
+			wantErrSub: "invalid attendee email",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			stub := &stubCalendarService{
+				freeBusyFn: func(calendarIDs []string, timeMin, timeMax time.Time) (map[string]calendar.FreeBusyCalendar, error) {
+					return calendars, nil
+				},
+			}
+			tool := &ProposeMeetingTimesTool{logger: zap.NewNop(), google: stub}
+			result, err := tool.ProposeMeetingTimesHandler(context.Background(), map[string]any{
+				"attendees": tc.attendees,
+				"startDate": "2026-05-20T09:00:00Z",
+				"endDate":   "2026-05-20T12:00:00Z",
+				"duration":  float64(60),
+			})
+
+			if tc.wantErrSub != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErrSub) {
+					t.Fatalf("error = %v, want substring %q", err, tc.wantErrSub)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			var parsed struct {
+				Proposals []struct {
+					StartTime      string   `json:"startTime"`
+					EndTime        string   `json:"endTime"`
+					AvailableCount int      `json:"availableCount"`
+					FreeAttendees  []string `json:"freeAttendees"`
+				} `json:"proposals"`
+				InaccessibleAttendees []map[string]string `json:"inaccessibleAttendees"`
+			}
+			if err := json.Unmarshal([]byte(result), &parsed); err != nil {
+				t.Fatalf("failed to unmarshal result: %v", err)
+			}
+			if len(parsed.Proposals) != len(tc.want) {
+				t.Fatalf("got %d proposals, want %d: %s", len(parsed.Proposals), len(tc.want), result)
+			}
+			for i, p := range parsed.Proposals {
+				if p.StartTime != tc.want[i].start {
+					t.Errorf("proposals[%d].startTime = %s, want %s", i, p.StartTime, tc.want[i].start)
+				}
+				if free := strings.Join(p.FreeAttendees, ","); free != tc.want[i].free {
+					t.Errorf("proposals[%d].freeAttendees = %s, want %s", i, free, tc.want[i].free)
+				}
+				if p.AvailableCount != len(p.FreeAttendees) {
+					t.Errorf("proposals[%d].availableCount = %d, want %d", i, p.AvailableCount, len(p.FreeAttendees))
+				}
+			}
+			if len(parsed.InaccessibleAttendees) != tc.wantInaccessible {
+				t.Errorf("inaccessibleAttendees = %v, want %d entries", parsed.InaccessibleAttendees, tc.wantInaccessible)
+			}
+		})
+	}
+}
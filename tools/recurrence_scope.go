@@ -0,0 +1,236 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	calendar "google.golang.org/api/calendar/v3"
+
+	google "github.com/inference-gateway/google-calendar-agent/internal/google"
+)
+
+// Scopes for changes to recurring events, mirroring Google Calendar's
+// "this event", "this and following events" and "all events" choices.
+const (
+	scopeInstance  = "instance"
+	scopeFollowing = "following"
+	scopeAll       = "all"
+)
+
+// scopeSchema is the JSON schema shared by the update and delete tools.
+var scopeSchema = map[string]any{
+	"description": "For recurring events: 'instance' changes only the given occurrence, 'following' this and later occurrences, 'all' the whole series. Defaults to 'instance'.",
+	"enum":        []string{scopeInstance, scopeFollowing, scopeAll},
+	"type":        "string",
+}
+
+// parseEventScope reads the optional scope argument.
+func parseEventScope(args map[string]any) (string, error) {
+	v, exists := args["scope"]
+	if !exists || v == nil {
+		return scopeInstance, nil
+	}
+	scope, ok := v.(string)
+	if !ok {
+		return "", fmt.Errorf("scope must be a string, got %T", v)
+	}
+	switch scope {
+	case scopeInstance, scopeFollowing, scopeAll:
+		return scope, nil
+	default:
+		return "", fmt.Errorf("scope must be one of %s, %s or %s, got %q", scopeInstance, scopeFollowing, scopeAll, scope)
+	}
+}
+
+// seriesOccurrence is an instance of a recurring event together with the
+// master event that defines the series.
+type seriesOccurrence struct {
+	instance *calendar.Event
+	master   *calendar.Event
+}
+
+// getSeriesOccurrence loads eventID and its recurring master, failing when
+// eventID is not an instance of a recurring event.
+func getSeriesOccurrence(ctx context.Context, svc google.CalendarService, calendarID, eventID string) (*seriesOccurrence, error) {
+	instance, err := svc.GetEvent(ctx, calendarID, eventID)
+	if err != nil {
+		return nil, err
+	}
+	if instance.RecurringEventId == "" {
+		return nil, fmt.Errorf("event %s is not an occurrence of a recurring event", eventID)
+	}
+	master, err := svc.GetEvent(ctx, calendarID, instance.RecurringEventId)
+	if err != nil {
+		return nil, err
+	}
+	return &seriesOccurrence{instance: instance, master: master}, nil
+}
+
+// isFirst reports whether the instance is the first occurrence of the
+// series, in which case "following" covers the whole series.
+func (o *seriesOccurrence) isFirst() bool {
+	original := o.instance.OriginalStartTime
+	if original == nil || o.master.Start == nil {
+		return false
+	}
+	if original.Date != "" {
+		return original.Date == o.master.Start.Date
+	}
+	a, errA := time.Parse(time.RFC3339, original.DateTime)
+	b, errB := time.Parse(time.RFC3339, o.master.Start.DateTime)
+	return errA == nil && errB == nil && a.Equal(b)
+}
+
+// truncateRecurrence ends every RRULE just before the given occurrence
+// start, dropping any existing UNTIL or COUNT. EXDATE and RDATE lines are
+// kept as they are.
+func truncateRecurrence(recurrence []string, before *calendar.EventDateTime) ([]string, error) {
+	if before == nil {
+		return nil, fmt.Errorf("occurrence has no original start time")
+	}
+	var until string
+	if before.Date != "" {
+		day, err := time.Parse("2006-01-02", before.Date)
+		if err != nil {
+			return nil, fmt.Errorf("invalid occurrence date %q: %w", before.Date, err)
+		}
+		until = day.AddDate(0, 0, -1).Format("20060102")
+	} else {
+		start, err := time.Parse(time.RFC3339, before.DateTime)
+		if err != nil {
+			return nil, fmt.Errorf("invalid occurrence start %q: %w", before.DateTime, err)
+		}
+		until = start.Add(-time.Second).UTC().Format("20060102T150405Z")
+	}
+
+	truncated := make([]string, 0, len(recurrence))
+	for _, rule := range recurrence {
+		if !strings.HasPrefix(strings.ToUpper(rule), "RRULE:") {
+			truncated = append(truncated, rule)
+			continue
+		}
+		split, err := splitRRULE(rule)
+		if err != nil {
+			return nil, err
+		}
+		var parts []string
+		for _, part := range split {
+			if part.name == "UNTIL" || part.name == "COUNT" {
+				continue
+			}
+			parts = append(parts, part.name+"="+part.value)
+		}
+		parts = append(parts, "UNTIL="+until)
+		truncated = append(truncated, "RRULE:"+strings.Join(parts, ";"))
+	}
+	return truncated, nil
+}
+
+// continueRecurrence returns the recurrence for a new series that takes
+// over master's from the occurrence starting at from. A COUNT is reduced by
+// the occurrences master keeps before from, so that the two series
+// together occur as often as the original did; other rules are kept as
+// they are. loc is used when master has no timezone of its own.
+func continueRecurrence(master *calendar.Event, from *calendar.EventDateTime, loc *time.Location) ([]string, error) {
+	if from == nil {
+		return nil, fmt.Errorf("occurrence has no original start time")
+	}
+	if master.Start != nil && master.Start.TimeZone != "" {
+		if seriesLoc, err := time.LoadLocation(master.Start.TimeZone); err == nil {
+			loc = seriesLoc
+		}
+	}
+	dtstart, _, ok := eventTimes(master, loc)
+	if !ok {
+		return nil, fmt.Errorf("event %s has no readable start time", master.Id)
+	}
+	var split time.Time
+	var err error
+	if from.Date != "" {
+		split, err = time.ParseInLocation("2006-01-02", from.Date, loc)
+	} else {
+		split, err = time.Parse(time.RFC3339, from.DateTime)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("invalid occurrence start: %w", err)
+	}
+
+	continued := make([]string, 0, len(master.Recurrence))
+	for _, line := range master.Recurrence {
+		parts, err := splitRRULE(line)
+		if err != nil || !hasRRULEPart(parts, "COUNT") {
+			continued = append(continued, line)
+			continue
+		}
+		r, err := parseRRULE(line, loc)
+		if err != nil {
+			return nil, fmt.Errorf("cannot split a series counted by %q: %w", line, err)
+		}
+		remaining := r.count - rruleOccurrencesBefore(r, dtstart, split)
+		if remaining < 1 {
+			return nil, fmt.Errorf("the series has no occurrences left from %s", split.Format(time.RFC3339))
+		}
+		rebuilt := make([]string, 0, len(parts))
+		for _, part := range parts {
+			if part.name == "COUNT" {
+				part.value = strconv.Itoa(remaining)
+			}
+			rebuilt = append(rebuilt, part.name+"="+part.value)
+		}
+		continued = append(continued, "RRULE:"+strings.Join(rebuilt, ";"))
+	}
+	return continued, nil
+}
+
+// continueSeries returns a new series that takes over master's from the
+// occurrence originally starting at from. It is a copy of master, so event
+// type, visibility, availability, guest permissions, conference and
+// attachments carry over, without the fields that identify master. Its
+// first occurrence keeps the series' own time and length even when the
+// occurrence at from was moved on its own.
+func continueSeries(master *calendar.Event, from *calendar.EventDateTime, loc *time.Location) (*calendar.Event, error) {
+	recurrence, err := continueRecurrence(master, from, loc)
+	if err != nil {
+		return nil, err
+	}
+	if master.Start == nil || master.End == nil {
+		return nil, fmt.Errorf("event %s has no start or end time", master.Id)
+	}
+
+	series := *master
+	series.Id = ""
+	series.ICalUID = ""
+	series.Etag = ""
+	series.RecurringEventId = ""
+	series.OriginalStartTime = nil
+	series.HtmlLink = ""
+	series.Created = ""
+	series.Updated = ""
+	series.Recurrence = recurrence
+
+	if from.Date != "" {
+		masterStart, errStart := time.Parse("2006-01-02", master.Start.Date)
+		masterEnd, errEnd := time.Parse("2006-01-02", master.End.Date)
+		start, err := time.Parse("2006-01-02", from.Date)
+		if errStart != nil || errEnd != nil || err != nil {
+			return nil, fmt.Errorf("event %s has no readable all-day dates", master.Id)
+		}
+		days := int(masterEnd.Sub(masterStart).Hours() / 24)
+		series.Start = &calendar.EventDateTime{Date: from.Date}
+		series.End = &calendar.EventDateTime{Date: start.AddDate(0, 0, days).Format("2006-01-02")}
+		return &series, nil
+	}
+
+	masterStart, errStart := time.Parse(time.RFC3339, master.Start.DateTime)
+	masterEnd, errEnd := time.Parse(time.RFC3339, master.End.DateTime)
+	start, err := time.Parse(time.RFC3339, from.DateTime)
+	if errStart != nil || errEnd != nil || err != nil {
+		return nil, fmt.Errorf("event %s has no readable start or end time", master.Id)
+	}
+	series.Start = &calendar.EventDateTime{DateTime: from.DateTime, TimeZone: master.Start.TimeZone}
+	series.End = &calendar.EventDateTime{DateTime: start.Add(masterEnd.Sub(masterStart)).Format(time.RFC3339), TimeZone: master.End.TimeZone}
+	return &series, nil
+}
@@ -0,0 +1,136 @@
+package tools
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	calendar "google.golang.org/api/calendar/v3"
+	googleapi "google.golang.org/api/googleapi"
+)
+
+func TestContinueRecurrence(t *testing.T) {
+	tests := []struct {
+		name       string
+		recurrence []string
+		start      *calendar.EventDateTime
+		from       *calendar.EventDateTime
+		want       []string
+		wantErrSub string
+	}{
+		{
+			name:       "count is reduced by the occurrences kept",
+			recurrence: []string{"RRULE:FREQ=WEEKLY;COUNT=10", "EXDATE:20260511T090000Z"},
+			start:      &calendar.EventDateTime{DateTime: "2026-05-04T09:00:00Z"},
+			from:       &calendar.EventDateTime{DateTime: "2026-05-18T09:00:00Z"},
+			want:       []string{"RRULE:FREQ=WEEKLY;COUNT=8", "EXDATE:20260511T090000Z"},
+		},
+		{
+			name:       "weekday rule counted in the series timezone",
+			recurrence: []string{"RRULE:FREQ=WEEKLY;BYDAY=MO,WE,FR;COUNT=6"},
+			start:      &calendar.EventDateTime{DateTime: "2026-05-04T09:00:00+02:00", TimeZone: "Europe/Berlin"},
+			from:       &calendar.EventDateTime{DateTime: "2026-05-11T07:00:00Z"},
+			want:       []string{"RRULE:FREQ=WEEKLY;BYDAY=MO,WE,FR;COUNT=3"},
+		},
+		{
+			name:       "all-day series",
+			recurrence: []string{"RRULE:FREQ=DAILY;COUNT=5"},
+			start:      &calendar.EventDateTime{Date: "2026-05-04"},
+			from:       &calendar.EventDateTime{Date: "2026-05-07"},
+			want:       []string{"RRULE:FREQ=DAILY;COUNT=2"},
+		},
+		{
+			name:       "rules without a count are kept",
+			recurrence: []string{"RRULE:FREQ=DAILY;UNTIL=20260601T000000Z"},
+			start:      &calendar.EventDateTime{DateTime: "2026-05-04T09:00:00Z"},
+			from:       &calendar.EventDateTime{DateTime: "2026-05-18T09:00:00Z"},
+			want:       []string{"RRULE:FREQ=DAILY;UNTIL=20260601T000000Z"},
+		},
+		{
+			name:       "counted rule the parser does not support",
+			recurrence: []string{"RRULE:FREQ=YEARLY;BYMONTH=5;COUNT=3"},
+			start:      &calendar.EventDateTime{DateTime: "2026-05-04T09:00:00Z"},
+			from:       &calendar.EventDateTime{DateTime: "2027-05-04T09:00:00Z"},
+			wantErrSub: "cannot split a series counted by",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			master := &calendar.Event{Id: "series", Recurrence: tc.recurrence, Start: tc.start, End: tc.start}
+			got, err := continueRecurrence(master, tc.from, time.UTC)
+			if tc.wantErrSub != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErrSub) {
+					t.Fatalf("error = %v, want substring %q", err, tc.wantErrSub)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if strings.Join(got, "\n") != strings.Join(tc.want, "\n") {
+				t.Errorf("continueRecurrence = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestContinueSeries(t *testing.T) {
+	master := &calendar.Event{
+		Id:                      "series-1",
+		ICalUID:                 "series-1@google.com",
+		Etag:                    `"3181161784712000"`,
+		HtmlLink:                "https://www.google.com/calendar/event?eid=series-1",
+		Summary:                 "Design sync",
+		Recurrence:              []string{"RRULE:FREQ=WEEKLY;COUNT=10"},
+		Start:                   &calendar.EventDateTime{DateTime: "2026-05-04T09:00:00+02:00", TimeZone: "Europe/Berlin"},
+		End:                     &calendar.EventDateTime{DateTime: "2026-05-04T09:30:00+02:00", TimeZone: "Europe/Berlin"},
+		Visibility:              "private",
+		Transparency:            "transparent",
+		GuestsCanSeeOtherGuests: googleapi.Bool(false),
+		ConferenceData: &calendar.ConferenceData{
+			ConferenceId: "abc-defg-hij",
+		},
+		Attachments: []*calendar.EventAttachment{{Title: "Agenda", FileUrl: "https://drive.google.com/agenda"}},
+	}
+	// The occurrence was moved to the afternoon on its own; the new series
+	// still starts at the time the series gives it.
+	from := &calendar.EventDateTime{DateTime: "2026-05-18T09:00:00+02:00", TimeZone: "Europe/Berlin"}
+
+	series, err := continueSeries(master, from, time.UTC)
+	if err != nil {
+		t.Fatalf("continueSeries: %v", err)
+	}
+	if series.Id != "" || series.ICalUID != "" || series.Etag != "" || series.HtmlLink != "" {
+		t.Errorf("identity = %q %q %q %q, want cleared", series.Id, series.ICalUID, series.Etag, series.HtmlLink)
+	}
+	if series.Start.DateTime != "2026-05-18T09:00:00+02:00" || series.End.DateTime != "2026-05-18T09:30:00+02:00" || series.Start.TimeZone != "Europe/Berlin" {
+		t.Errorf("times = %+v - %+v, want 09:00-09:30 Europe/Berlin on 2026-05-18", series.Start, series.End)
+	}
+	if got := strings.Join(series.Recurrence, "\n"); got != "RRULE:FREQ=WEEKLY;COUNT=8" {
+		t.Errorf("recurrence = %q, want the remaining 8 occurrences", got)
+	}
+	if series.Visibility != "private" || series.Transparency != "transparent" || series.GuestsCanSeeOtherGuests == nil || *series.GuestsCanSeeOtherGuests {
+		t.Errorf("visibility %q, transparency %q, guestsCanSeeOtherGuests %v, want the master's", series.Visibility, series.Transparency, series.GuestsCanSeeOtherGuests)
+	}
+	if series.ConferenceData == nil || series.ConferenceData.ConferenceId != "abc-defg-hij" || len(series.Attachments) != 1 {
+		t.Errorf("conference %+v, attachments %+v, want the master's", series.ConferenceData, series.Attachments)
+	}
+	if master.Id != "series-1" || len(master.Recurrence) != 1 || master.Recurrence[0] != "RRULE:FREQ=WEEKLY;COUNT=10" {
+		t.Errorf("master changed to %+v", master)
+	}
+
+	allDay := &calendar.Event{
+		Id:         "birthday",
+		Recurrence: []string{"RRULE:FREQ=WEEKLY"},
+		Start:      &calendar.EventDateTime{Date: "2026-05-04"},
+		End:        &calendar.EventDateTime{Date: "2026-05-06"},
+	}
+	series, err = continueSeries(allDay, &calendar.EventDateTime{Date: "2026-05-18"}, time.UTC)
+	if err != nil {
+		t.Fatalf("continueSeries all-day: %v", err)
+	}
+	if series.Start.Date != "2026-05-18" || series.End.Date != "2026-05-20" {
+		t.Errorf("all-day dates = %s - %s, want 2026-05-18 - 2026-05-20", series.Start.Date, series.End.Date)
+	}
+}
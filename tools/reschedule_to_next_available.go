@@ -0,0 +1,187 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	zap "go.uber.org/zap"
+	calendar "google.golang.org/api/calendar/v3"
+
+	server "github.com/inference-gateway/adk/server"
+
+	google "github.com/inference-gateway/google-calendar-agent/internal/google"
+)
+
+// defaultRescheduleWindow is how far ahead the tool searches when no
+// searchEnd is given.
+const defaultRescheduleWindow = 7 * 24 * time.Hour
+
+// RescheduleToNextAvailableTool struct holds the tool with dependencies
+type RescheduleToNextAvailableTool struct {
+	logger *zap.Logger
+	google google.CalendarService
+}
+
+// NewRescheduleToNextAvailableTool creates a new reschedule_to_next_available tool
+func NewRescheduleToNextAvailableTool(logger *zap.Logger, google google.CalendarService) server.Tool {
+	tool := &RescheduleToNextAvailableTool{
+		logger: logger,
+		google: google,
+	}
+	return server.NewBasicTool(
+		"reschedule_to_next_available",
+		"Move an event to the next free slot of the same duration within working hours",
+		map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"eventId": map[string]any{
+					"description": "ID of the event to reschedule",
+					"type":        "string",
+				},
+				"searchEnd": map[string]any{
+					"description": "Latest time the rescheduled event may end (RFC3339 format). Defaults to 7 days after searchStart.",
+					"type":        "string",
+				},
+				"searchStart": map[string]any{
+					"description": "Earliest time the rescheduled event may start (RFC3339 format). Defaults to now.",
+					"type":        "string",
+				},
+			},
+			"required": []string{"eventId"},
+		},
+		tool.RescheduleToNextAvailableHandler,
+	)
+}
+
+// RescheduleToNextAvailableHandler handles the reschedule_to_next_available tool execution
+func (s *RescheduleToNextAvailableTool) RescheduleToNextAvailableHandler(ctx context.Context, args map[string]any) (string, error) {
+	span := startToolSpan(ctx, "reschedule_to_next_available")
+	defer span.End()
+	s.logger.Debug("rescheduling calendar event", zap.Any("args", args))
+
+	eventID, ok := args["eventId"].(string)
+	if !ok || eventID == "" {
+		return "", fmt.Errorf("eventId is required")
+	}
+
+	searchStart := time.Now()
+	if v, exists := args["searchStart"]; exists && v != nil {
+		str, ok := v.(string)
+		if !ok {
+			return "", fmt.Errorf("searchStart must be a string, got %T", v)
+		}
+		parsed, err := time.Parse(time.RFC3339, str)
+		if err != nil {
+			return "", fmt.Errorf("invalid searchStart format (expected RFC3339): %w", err)
+		}
+		searchStart = parsed
+	}
+
+	searchEnd := searchStart.Add(defaultRescheduleWindow)
+	if v, exists := args["searchEnd"]; exists && v != nil {
+		str, ok := v.(string)
+		if !ok {
+			return "", fmt.Errorf("searchEnd must be a string, got %T", v)
+		}
+		parsed, err := time.Parse(time.RFC3339, str)
+		if err != nil {
+			return "", fmt.Errorf("invalid searchEnd format (expected RFC3339): %w", err)
+		}
+		searchEnd = parsed
+	}
+	if !searchEnd.After(searchStart) {
+		return "", fmt.Errorf("searchEnd must be after searchStart")
+	}
+
+	hours, err := loadWorkingHours()
+	if err != nil {
+		return "", err
+	}
+
+	calendarID := s.google.GetCalendarID()
+	event, err := s.google.GetEvent(ctx, calendarID, eventID)
+	if err != nil {
+		s.logger.Error("failed to get calendar event", zap.Error(err), zap.String("eventId", eventID))
+		return "", fmt.Errorf("failed to get calendar event: %w", err)
+	}
+	if event.Start == nil || event.End == nil || event.Start.DateTime == "" || event.End.DateTime == "" {
+		return "", fmt.Errorf("event %s is an all-day event and cannot be moved to a time slot", eventID)
+	}
+
+	oldStart, err := time.Parse(time.RFC3339, event.Start.DateTime)
+	if err != nil {
+		return "", fmt.Errorf("invalid event start time: %w", err)
+	}
+	oldEnd, err := time.Parse(time.RFC3339, event.End.DateTime)
+	if err != nil {
+		return "", fmt.Errorf("invalid event end time: %w", err)
+	}
+	duration := oldEnd.Sub(oldStart)
+
+	existingEvents, err := s.google.ListEvents(ctx, calendarID, searchStart, searchEnd)
+	if err != nil {
+		s.logger.Error("failed to list events for availability check", zap.Error(err))
+		return "", fmt.Errorf("failed to list events for availability check: %w", err)
+	}
+
+	var others []*calendar.Event
+	for _, e := range existingEvents {
+		if e.Id != eventID {
+			others = append(others, e)
+		}
+	}
+
+	loc, _ := calendarTimezone(ctx, s.google, calendarID)
+	newStart, found := hours.nextFreeSlot(eventBusyPeriods(others, loc), searchStart, searchEnd, duration, loc)
+	if !found {
+		s.logger.Info("no free slot found for reschedule", zap.String("eventId", eventID))
+		result := map[string]any{
+			"success": false,
+			"eventId": eventID,
+			"message": fmt.Sprintf("No free %d-minute slot within working hours between %s and %s. The event was not moved.",
+				int(duration.Minutes()), searchStart.Format(time.RFC3339), searchEnd.Format(time.RFC3339)),
+		}
+		resultJSON, err := json.Marshal(result)
+		if err != nil {
+			return "", fmt.Errorf("failed to marshal result: %w", err)
+		}
+		return string(resultJSON), nil
+	}
+	newEnd := newStart.Add(duration)
+
+	event.Start = &calendar.EventDateTime{DateTime: newStart.Format(time.RFC3339), TimeZone: event.Start.TimeZone}
+	event.End = &calendar.EventDateTime{DateTime: newEnd.Format(time.RFC3339), TimeZone: event.End.TimeZone}
+
+	updatedEvent, err := s.google.UpdateEvent(ctx, calendarID, eventID, event)
+	if err != nil {
+		s.logger.Error("failed to reschedule calendar event", zap.Error(err), zap.String("eventId", eventID))
+		return "", fmt.Errorf("failed to reschedule calendar event: %w", err)
+	}
+
+	s.logger.Info("calendar event rescheduled successfully",
+		zap.String("eventId", updatedEvent.Id),
+		zap.Time("newStart", newStart))
+
+	result := map[string]any{
+		"success":      true,
+		"eventId":      updatedEvent.Id,
+		"summary":      updatedEvent.Summary,
+		"oldStartTime": oldStart.Format(time.RFC3339),
+		"oldEndTime":   oldEnd.Format(time.RFC3339),
+		"newStartTime": newStart.Format(time.RFC3339),
+		"newEndTime":   newEnd.Format(time.RFC3339),
+		"htmlLink":     updatedEvent.HtmlLink,
+	}
+	if viewLink := viewLinkFor(calendarID, updatedEvent); viewLink != "" {
+		result["viewLink"] = viewLink
+	}
+
+	resultJSON, err := json.Marshal(result)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal result: %w", err)
+	}
+
+	return string(resultJSON), nil
+}
@@ -0,0 +1,178 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	zap "go.uber.org/zap"
+	calendar "google.golang.org/api/calendar/v3"
+)
+
+func TestRescheduleToNextAvailableHandler(t *testing.T) {
+	t.Setenv("GOOGLE_CALENDAR_TIMEZONE", "UTC")
+	t.Setenv("GOOGLE_CALENDAR_WORKING_HOURS_START", "09:00")
+	t.Setenv("GOOGLE_CALENDAR_WORKING_HOURS_END", "17:00")
+
+	timed := func(id, startRFC, endRFC string) *calendar.Event {
+		return &calendar.Event{
+			Id:      id,
+			Summary: id,
+			Start:   &calendar.EventDateTime{DateTime: startRFC},
+			End:     &calendar.EventDateTime{DateTime: endRFC},
+		}
+	}
+
+	// 2026-05-25 is a Monday.
+	tests := []struct {
+		name         string
+		args         map[string]any
+		event        *calendar.Event
+		existing     []*calendar.Event
+		getErr       error
+		wantErrSub   string
+		wantSuccess  bool
+		wantNewStart string
+		wantNewEnd   string
+	}{
+		{
+			name: "moves to the first gap after busy periods",
+			args: map[string]any{
+				"eventId":     "evt-1",
+				"searchStart": "2026-05-25T09:00:00Z",
+				"searchEnd":   "2026-05-25T17:00:00Z",
+			},
+			event: timed("evt-1", "2026-05-25T09:00:00Z", "2026-05-25T10:00:00Z"),
+			existing: []*calendar.Event{
+				timed("evt-1", "2026-05-25T09:00:00Z", "2026-05-25T10:00:00Z"),
+				timed("busy-1", "2026-05-25T08:30:00Z", "2026-05-25T10:30:00Z"),
+				timed("busy-2", "2026-05-25T10:30:00Z", "2026-05-25T11:00:00Z"),
+				timed("busy-3", "2026-05-25T11:30:00Z", "2026-05-25T12:00:00Z"),
+			},
+			wantSuccess:  true,
+			wantNewStart: "2026-05-25T12:00:00Z",
+			wantNewEnd:   "2026-05-25T13:00:00Z",
+		},
+		{
+			name: "skips the weekend and starts at working hours",
+			args: map[string]any{
+				"eventId":     "evt-1",
+				"searchStart": "2026-05-22T16:45:00Z",
+				"searchEnd":   "2026-05-26T00:00:00Z",
+			},
+			event:        timed("evt-1", "2026-05-22T16:00:00Z", "2026-05-22T16:30:00Z"),
+			wantSuccess:  true,
+			wantNewStart: "2026-05-25T09:00:00Z",
+			wantNewEnd:   "2026-05-25T09:30:00Z",
+		},
+		{
+			name: "no availability leaves the event untouched",
+			args: map[string]any{
+				"eventId":     "evt-1",
+				"searchStart": "2026-05-25T09:00:00Z",
+				"searchEnd":   "2026-05-25T17:00:00Z",
+			},
+			event: timed("evt-1", "2026-05-25T09:00:00Z", "2026-05-25T11:00:00Z"),
+			existing: []*calendar.Event{
+				timed("busy-1", "2026-05-25T09:00:00Z", "2026-05-25T12:00:00Z"),
+				timed("busy-2", "2026-05-25T13:00:00Z", "2026-05-25T16:00:00Z"),
+			},
+			wantSuccess: false,
+		},
+		{
+			name:       "missing eventId",
+			args:       map[string]any{},
+			wantErrSub: "eventId is required",
+		},
+		{
+			name: "all-day event is rejected",
+			args: map[string]any{"eventId": "evt-1"},
+			event: &calendar.Event{
+				Id:    "evt-1",
+				Start: &calendar.EventDateTime{Date: "2026-05-25"},
+				End:   &calendar.EventDateTime{Date: "2026-05-26"},
+			},
+			wantErrSub: "all-day event",
+		},
+		{
+			name:       "get error is wrapped",
+			args:       map[string]any{"eventId": "evt-1"},
+			getErr:     errors.New("not found"),
+			wantErrSub: "failed to get calendar event",
+		},
+		{
+			name: "searchEnd before searchStart",
+			args: map[string]any{
+				"eventId":     "evt-1",
+				"searchStart": "2026-05-25T17:00:00Z",
+				"searchEnd":   "2026-05-25T09:00:00Z",
+			},
+			wantErrSub: "searchEnd must be after searchStart",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var updated *calendar.Event
+			oldStart := ""
+			if tt.event != nil && tt.event.Start != nil {
+				oldStart = tt.event.Start.DateTime
+			}
+			stub := &stubCalendarService{
+				getEventFn: func(calendarID, eventID string) (*calendar.Event, error) {
+					return tt.event, tt.getErr
+				},
+				listEventsFn: func(calendarID string, timeMin, timeMax time.Time) ([]*calendar.Event, error) {
+					return tt.existing, nil
+				},
+				updateEventFn: func(calendarID, eventID string, event *calendar.Event) (*calendar.Event, error) {
+					updated = event
+					return event, nil
+				},
+			}
+			tool := &RescheduleToNextAvailableTool{logger: zap.NewNop(), google: stub}
+
+			out, err := tool.RescheduleToNextAvailableHandler(context.Background(), tt.args)
+			if tt.wantErrSub != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErrSub) {
+					t.Fatalf("error = %v, want containing %q", err, tt.wantErrSub)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			var result map[string]any
+			if err := json.Unmarshal([]byte(out), &result); err != nil {
+				t.Fatalf("invalid JSON: %v", err)
+			}
+			if result["success"] != tt.wantSuccess {
+				t.Fatalf("success = %v, want %v (result %s)", result["success"], tt.wantSuccess, out)
+			}
+
+			if !tt.wantSuccess {
+				if updated != nil {
+					t.Error("UpdateEvent called despite no availability")
+				}
+				if msg, _ := result["message"].(string); !strings.Contains(msg, "No free 120-minute slot") {
+					t.Errorf("message = %q", msg)
+				}
+				return
+			}
+
+			if result["oldStartTime"] != oldStart {
+				t.Errorf("oldStartTime = %v", result["oldStartTime"])
+			}
+			if result["newStartTime"] != tt.wantNewStart || result["newEndTime"] != tt.wantNewEnd {
+				t.Errorf("new slot = %v-%v, want %s-%s", result["newStartTime"], result["newEndTime"], tt.wantNewStart, tt.wantNewEnd)
+			}
+			if updated == nil || updated.Start.DateTime != tt.wantNewStart || updated.End.DateTime != tt.wantNewEnd {
+				t.Errorf("UpdateEvent not called with the new slot: %+v", updated)
+			}
+		})
+	}
+}
@@ -0,0 +1,198 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	zap "go.uber.org/zap"
+	calendar "google.golang.org/api/calendar/v3"
+
+	server "github.com/inference-gateway/adk/server"
+
+	google "github.com/inference-gateway/google-calendar-agent/internal/google"
+)
+
+// inviteResponses are the response statuses respond_to_invites can set.
+var inviteResponses = []string{"accepted", "declined", "tentative"}
+
+// RespondToInvitesTool struct holds the tool with dependencies
+type RespondToInvitesTool struct {
+	logger *zap.Logger
+	google google.CalendarService
+}
+
+// NewRespondToInvitesTool creates a new respond_to_invites tool
+func NewRespondToInvitesTool(logger *zap.Logger, google google.CalendarService) server.Tool {
+	tool := &RespondToInvitesTool{
+		logger: logger,
+		google: google,
+	}
+	return server.NewBasicTool(
+		"respond_to_invites",
+		"Accept, decline, or tentatively accept every invitation the user has not yet answered within a time range",
+		map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"response": map[string]any{
+					"description": "Response to send for each pending invitation (required)",
+					"enum":        inviteResponses,
+					"type":        "string",
+				},
+				"timeMax": map[string]any{
+					"description": "End of the range to search (RFC3339 format) (required)",
+					"type":        "string",
+				},
+				"timeMin": map[string]any{
+					"description": "Start of the range to search (RFC3339 format) (required)",
+					"type":        "string",
+				},
+			},
+			"required": []string{"response", "timeMin", "timeMax"},
+		},
+		tool.RespondToInvitesHandler,
+	)
+}
+
+// RespondToInvitesHandler handles the respond_to_invites tool execution
+func (s *RespondToInvitesTool) RespondToInvitesHandler(ctx context.Context, args map[string]any) (string, error) {
+	span := startToolSpan(ctx, "respond_to_invites")
+	defer span.End()
+	s.logger.Debug("responding to invitations", zap.Any("args", args))
+
+	response, ok := args["response"].(string)
+	if !ok || response == "" {
+		return "", fmt.Errorf("response is required")
+	}
+	valid := false
+	for _, r := range inviteResponses {
+		if response == r {
+			valid = true
+			break
+		}
+	}
+	if !valid {
+		return "", fmt.Errorf("response must be one of accepted, declined or tentative, got %q", response)
+	}
+
+	timeMinStr, ok := args["timeMin"].(string)
+	if !ok || timeMinStr == "" {
+		return "", fmt.Errorf("timeMin is required")
+	}
+	timeMin, err := time.Parse(time.RFC3339, timeMinStr)
+	if err != nil {
+		return "", fmt.Errorf("invalid timeMin format (expected RFC3339): %w", err)
+	}
+	timeMaxStr, ok := args["timeMax"].(string)
+	if !ok || timeMaxStr == "" {
+		return "", fmt.Errorf("timeMax is required")
+	}
+	timeMax, err := time.Parse(time.RFC3339, timeMaxStr)
+	if err != nil {
+		return "", fmt.Errorf("invalid timeMax format (expected RFC3339): %w", err)
+	}
+	if !timeMax.After(timeMin) {
+		return "", fmt.Errorf("timeMax must be after timeMin")
+	}
+
+	calendarID := s.google.GetCalendarID()
+	events, err := s.google.ListEvents(ctx, calendarID, timeMin, timeMax)
+	if err != nil {
+		s.logger.Error("failed to list calendar events", zap.Error(err))
+		return "", fmt.Errorf("failed to list calendar events: %w", err)
+	}
+
+	var pending []*calendar.Event
+	for _, event := range events {
+		if event.Status == "cancelled" {
+			continue
+		}
+		if self := selfAttendee(event); self != nil && self.ResponseStatus == "needsAction" {
+			pending = append(pending, event)
+		}
+	}
+	if len(pending) > maxBatchEvents {
+		return "", fmt.Errorf("%d invitations are pending, more than the limit of %d; narrow the time range", len(pending), maxBatchEvents)
+	}
+
+	concurrency, err := loadBatchConcurrency()
+	if err != nil {
+		return "", err
+	}
+
+	results := runBatch(len(pending), concurrency, func(i int) map[string]any {
+		return s.respondOne(ctx, calendarID, pending[i], response)
+	})
+	responded, failed := 0, 0
+	for _, result := range results {
+		if result["status"] == "responded" {
+			responded++
+		} else {
+			failed++
+		}
+	}
+
+	s.logger.Info("invitations answered",
+		zap.String("response", response),
+		zap.Int("responded", responded),
+		zap.Int("failed", failed))
+
+	result := map[string]any{
+		"success":   failed == 0,
+		"response":  response,
+		"pending":   len(pending),
+		"results":   results,
+		"responded": responded,
+		"failed":    failed,
+	}
+
+	resultJSON, err := json.Marshal(result)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal result: %w", err)
+	}
+
+	return string(resultJSON), nil
+}
+
+// respondOne sets the user's response on a single event. Failures are
+// reported in the returned result rather than aborting the remaining events.
+func (s *RespondToInvitesTool) respondOne(ctx context.Context, calendarID string, event *calendar.Event, response string) map[string]any {
+	result := map[string]any{
+		"eventId":   event.Id,
+		"summary":   event.Summary,
+		"startTime": eventDateTimeString(event.Start),
+	}
+
+	// Copy the attendee list so only the user's own entry changes.
+	attendees := make([]*calendar.EventAttendee, len(event.Attendees))
+	for i, attendee := range event.Attendees {
+		if attendee != nil && attendee.Self {
+			updated := *attendee
+			updated.ResponseStatus = response
+			attendee = &updated
+		}
+		attendees[i] = attendee
+	}
+	event.Attendees = attendees
+
+	if _, err := s.google.UpdateEvent(ctx, calendarID, event.Id, event); err != nil {
+		s.logger.Warn("failed to respond to invitation", zap.Error(err), zap.String("eventId", event.Id))
+		result["status"] = "failed"
+		result["error"] = err.Error()
+		return result
+	}
+	result["status"] = "responded"
+	return result
+}
+
+// selfAttendee returns the attendee entry for the calendar's owner, or nil
+// when they are not on the guest list.
+func selfAttendee(event *calendar.Event) *calendar.EventAttendee {
+	for _, attendee := range event.Attendees {
+		if attendee != nil && attendee.Self {
+			return attendee
+		}
+	}
+	return nil
+}
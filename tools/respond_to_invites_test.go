@@ -0,0 +1,141 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	zap "go.uber.org/zap"
+	calendar "google.golang.org/api/calendar/v3"
+)
+
+func TestRespondToInvitesHandler(t *testing.T) {
+	// One worker keeps the stub calls in batch order.
+	t.Setenv("GOOGLE_CALENDAR_BATCH_CONCURRENCY", "1")
+
+	newEvents := func() []*calendar.Event {
+		invite := func(id, status string) *calendar.Event {
+			return &calendar.Event{
+				Id:      id,
+				Summary: "Invite " + id,
+				Start:   &calendar.EventDateTime{DateTime: "2026-05-18T09:00:00Z"},
+				End:     &calendar.EventDateTime{DateTime: "2026-05-18T10:00:00Z"},
+				Attendees: []*calendar.EventAttendee{
+					{Email: "organizer@example.com", Organizer: true, ResponseStatus: "accepted"},
+					{Email: "me@example.com", Self: true, ResponseStatus: status},
+				},
+			}
+		}
+		own := &calendar.Event{
+			Id:      "own",
+			Summary: "Focus",
+			Start:   &calendar.EventDateTime{DateTime: "2026-05-18T13:00:00Z"},
+			End:     &calendar.EventDateTime{DateTime: "2026-05-18T14:00:00Z"},
+		}
+		return []*calendar.Event{invite("pending-1", "needsAction"), invite("answered", "declined"), own, invite("pending-2", "needsAction")}
+	}
+	baseArgs := func(extra map[string]any) map[string]any {
+		args := map[string]any{
+			"response": "accepted",
+			"timeMin":  "2026-05-18T00:00:00Z",
+			"timeMax":  "2026-05-25T00:00:00Z",
+		}
+		for k, v := range extra {
+			args[k] = v
+		}
+		return args
+	}
+
+	tests := []struct {
+		name          string
+		args          map[string]any
+		updateErr     map[string]error
+		wantUpdated   []string
+		wantResponded int
+		wantFailed    int
+		wantSuccess   bool
+		wantErrSub    string
+	}{
+		{
+			name:          "accepts every pending invitation",
+			args:          baseArgs(nil),
+			wantUpdated:   []string{"pending-1", "pending-2"},
+			wantResponded: 2,
+			wantSuccess:   true,
+		},
+		{
+			name:          "a failed update does not stop the rest",
+			args:          baseArgs(map[string]any{"response": "declined"}),
+			updateErr:     map[string]error{"pending-1": errors.New("backend down")},
+			wantUpdated:   []string{"pending-2"},
+			wantResponded: 1,
+			wantFailed:    1,
+		},
+		{
+			name:       "unknown response",
+			args:       baseArgs(map[string]any{"response": "maybe"}),
+			wantErrSub: "response must be one of",
+		},
+		{
+			name:       "missing window",
+			args:       map[string]any{"response": "accepted"},
+			wantErrSub: "timeMin is required",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var updated []string
+			stub := &stubCalendarService{
+				listEventsFn: func(calendarID string, timeMin, timeMax time.Time) ([]*calendar.Event, error) {
+					return newEvents(), nil
+				},
+				updateEventFn: func(calendarID, eventID string, event *calendar.Event) (*calendar.Event, error) {
+					if err := tc.updateErr[eventID]; err != nil {
+						return nil, err
+					}
+					for _, attendee := range event.Attendees {
+						if attendee.Self && attendee.ResponseStatus != tc.args["response"] {
+							t.Errorf("%s: self response = %q, want %q", eventID, attendee.ResponseStatus, tc.args["response"])
+						}
+						if attendee.Organizer && attendee.ResponseStatus != "accepted" {
+							t.Errorf("%s: organizer response changed to %q", eventID, attendee.ResponseStatus)
+						}
+					}
+					updated = append(updated, eventID)
+					return event, nil
+				},
+			}
+			tool := &RespondToInvitesTool{logger: zap.NewNop(), google: stub}
+			result, err := tool.RespondToInvitesHandler(context.Background(), tc.args)
+			if tc.wantErrSub != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErrSub) {
+					t.Fatalf("error = %v, want substring %q", err, tc.wantErrSub)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			var parsed struct {
+				Success   bool `json:"success"`
+				Pending   int  `json:"pending"`
+				Responded int  `json:"responded"`
+				Failed    int  `json:"failed"`
+			}
+			if err := json.Unmarshal([]byte(result), &parsed); err != nil {
+				t.Fatalf("failed to unmarshal result: %v", err)
+			}
+			if parsed.Success != tc.wantSuccess || parsed.Responded != tc.wantResponded || parsed.Failed != tc.wantFailed || parsed.Pending != 2 {
+				t.Errorf("result = %+v, want success=%v responded=%d failed=%d pending=2", parsed, tc.wantSuccess, tc.wantResponded, tc.wantFailed)
+			}
+			if strings.Join(updated, ",") != strings.Join(tc.wantUpdated, ",") {
+				t.Errorf("updated = %v, want %v", updated, tc.wantUpdated)
+			}
+		})
+	}
+}
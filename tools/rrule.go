@@ -0,0 +1,293 @@
+package tools
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// rruleDays maps RRULE weekday codes to their names, in week order.
+var rruleDays = []struct{ code, name string }{
+	{"MO", "Monday"}, {"TU", "Tuesday"}, {"WE", "Wednesday"}, {"TH", "Thursday"},
+	{"FR", "Friday"}, {"SA", "Saturday"}, {"SU", "Sunday"},
+}
+
+// rruleUnits are the singular and plural units of each FREQ value.
+var rruleUnits = map[string][2]string{
+	"DAILY":   {"Daily", "days"},
+	"WEEKLY":  {"Weekly", "weeks"},
+	"MONTHLY": {"Monthly", "months"},
+	"YEARLY":  {"Yearly", "years"},
+}
+
+// rruleOrdinals names the BYDAY positions used by monthly rules.
+var rruleOrdinals = map[int]string{
+	1: "first", 2: "second", 3: "third", 4: "fourth", -1: "last",
+}
+
+// rruleWeekday is one BYDAY entry: a weekday and, for monthly rules, its
+// position in the month (1 for the first, -1 for the last, 0 for every).
+type rruleWeekday struct {
+	day time.Weekday
+	pos int
+}
+
+// rrule is a parsed recurrence rule. Only the parts Google Calendar writes
+// for its own repeat options are supported.
+type rrule struct {
+	freq       string
+	interval   int
+	byDay      []rruleWeekday
+	byMonthDay int
+	weekStart  time.Weekday
+	count      int
+	until      time.Time
+}
+
+// rrulePart is one NAME=value pair of a recurrence rule.
+type rrulePart struct {
+	name, value string
+}
+
+// splitRRULE splits an "RRULE:" line into its NAME=value parts, in order,
+// with the names upper-cased. Every reader of recurrence rules starts here.
+func splitRRULE(line string) ([]rrulePart, error) {
+	if !strings.HasPrefix(strings.ToUpper(line), "RRULE:") {
+		return nil, fmt.Errorf("recurrence rule %q must start with RRULE:", line)
+	}
+	var parts []rrulePart
+	for _, part := range strings.Split(line[len("RRULE:"):], ";") {
+		if part == "" {
+			continue
+		}
+		name, value, ok := strings.Cut(part, "=")
+		if !ok {
+			return nil, fmt.Errorf("invalid recurrence rule %q", line)
+		}
+		parts = append(parts, rrulePart{name: strings.ToUpper(name), value: value})
+	}
+	return parts, nil
+}
+
+// hasRRULEPart reports whether a split rule sets the named part.
+func hasRRULEPart(parts []rrulePart, name string) bool {
+	for _, part := range parts {
+		if part.name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// findRRULE returns the first RRULE among an event's recurrence lines,
+// skipping EXDATE and RDATE entries, or "" when there is none.
+func findRRULE(recurrence []string) string {
+	for _, line := range recurrence {
+		if strings.HasPrefix(strings.ToUpper(line), "RRULE:") {
+			return line
+		}
+	}
+	return ""
+}
+
+// parseRRULE parses an "RRULE:" line. Floating and date-only UNTIL values
+// are read in loc; a date-only UNTIL includes that whole day.
+func parseRRULE(line string, loc *time.Location) (rrule, error) {
+	parts, err := splitRRULE(line)
+	if err != nil {
+		return rrule{}, err
+	}
+	r := rrule{interval: 1, weekStart: time.Monday}
+	for _, part := range parts {
+		var err error
+		switch part.name {
+		case "FREQ":
+			if _, known := rruleUnits[part.value]; !known {
+				return rrule{}, fmt.Errorf("recurrence frequency %q is not supported", part.value)
+			}
+			r.freq = part.value
+		case "INTERVAL":
+			r.interval, err = strconv.Atoi(part.value)
+			if err == nil && r.interval < 1 {
+				err = fmt.Errorf("must be positive")
+			}
+		case "COUNT":
+			r.count, err = strconv.Atoi(part.value)
+			if err == nil && r.count < 1 {
+				err = fmt.Errorf("must be positive")
+			}
+		case "UNTIL":
+			r.until, err = parseRRULEUntil(part.value, loc)
+		case "BYDAY":
+			r.byDay, err = parseByDay(part.value)
+		case "BYMONTHDAY":
+			r.byMonthDay, err = strconv.Atoi(part.value)
+			if err == nil && (r.byMonthDay == 0 || r.byMonthDay < -31 || r.byMonthDay > 31) {
+				err = fmt.Errorf("must be a single day between -31 and 31")
+			}
+		case "WKST":
+			day, found := rruleWeekdayCode(part.value)
+			if !found {
+				err = fmt.Errorf("unknown weekday")
+			}
+			r.weekStart = day
+		default:
+			return rrule{}, fmt.Errorf("recurrence rule part %s is not supported", part.name)
+		}
+		if err != nil {
+			return rrule{}, fmt.Errorf("invalid %s in recurrence rule %q: %v", part.name, line, err)
+		}
+	}
+	if r.freq == "" {
+		return rrule{}, fmt.Errorf("recurrence rule %q has no FREQ", line)
+	}
+	if r.freq == "YEARLY" && (len(r.byDay) > 0 || r.byMonthDay != 0) {
+		return rrule{}, fmt.Errorf("recurrence rule %q is not supported", line)
+	}
+	return r, nil
+}
+
+// parseRRULEUntil parses an UNTIL value in the forms RFC 5545 allows: a UTC
+// timestamp, a floating timestamp or a bare date, reading the latter two
+// in loc. A bare date stands for the end of that day.
+func parseRRULEUntil(value string, loc *time.Location) (time.Time, error) {
+	if t, err := time.Parse("20060102T150405Z", value); err == nil {
+		return t, nil
+	}
+	if t, err := time.ParseInLocation("20060102T150405", value, loc); err == nil {
+		return t, nil
+	}
+	t, err := time.ParseInLocation("20060102", value, loc)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("unrecognized date %q", value)
+	}
+	return t.AddDate(0, 0, 1).Add(-time.Nanosecond), nil
+}
+
+// parseByDay parses a BYDAY list such as "MO,WE" or "1MO,-1FR".
+func parseByDay(value string) ([]rruleWeekday, error) {
+	var days []rruleWeekday
+	for _, code := range strings.Split(value, ",") {
+		pos := strings.TrimRight(code, "ABCDEFGHIJKLMNOPQRSTUVWXYZ")
+		day, ok := rruleWeekdayCode(strings.TrimPrefix(code, pos))
+		if !ok {
+			return nil, fmt.Errorf("unknown weekday %q", code)
+		}
+		n := 0
+		if pos != "" {
+			var err error
+			n, err = strconv.Atoi(pos)
+			if err != nil || n == 0 || n < -5 || n > 5 {
+				return nil, fmt.Errorf("invalid position %q", code)
+			}
+		}
+		days = append(days, rruleWeekday{day: day, pos: n})
+	}
+	return days, nil
+}
+
+// rruleWeekdayCode returns the weekday for an RRULE code such as "MO".
+func rruleWeekdayCode(code string) (time.Weekday, bool) {
+	for i, day := range rruleDays {
+		if day.code == code {
+			return time.Weekday((i + 1) % 7), true
+		}
+	}
+	return 0, false
+}
+
+// humanizeRRULE phrases a recurrence rule such as
+// "RRULE:FREQ=WEEKLY;INTERVAL=2;BYDAY=TU,TH" as "Every 2 weeks on Tue,
+// Thu", and "RRULE:FREQ=DAILY;UNTIL=20270101" as "Daily until Jan 1".
+// Rules it cannot read are returned unchanged.
+func humanizeRRULE(rule string) string {
+	split, err := splitRRULE(rule)
+	if err != nil {
+		return rule
+	}
+	parts := map[string]string{}
+	for _, part := range split {
+		parts[part.name] = part.value
+	}
+
+	unit, ok := rruleUnits[parts["FREQ"]]
+	if !ok {
+		return rule
+	}
+	phrase := unit[0]
+	if interval := parts["INTERVAL"]; interval != "" && interval != "1" {
+		n, err := strconv.Atoi(interval)
+		if err != nil || n < 1 {
+			return rule
+		}
+		phrase = "Every " + interval + " " + unit[1]
+	}
+
+	if byDay := parts["BYDAY"]; byDay != "" {
+		days, err := parseByDay(byDay)
+		if err != nil {
+			return rule
+		}
+		names, ok := humanizeByDay(days)
+		if !ok {
+			return rule
+		}
+		phrase += " on " + names
+	}
+
+	if until := parts["UNTIL"]; until != "" {
+		t, err := parseRRULEUntil(until, time.UTC)
+		if err != nil {
+			return rule
+		}
+		phrase += " until " + t.Format("Jan 2")
+	} else if count := parts["COUNT"]; count != "" {
+		n, err := strconv.Atoi(count)
+		if err != nil || n < 1 {
+			return rule
+		}
+		phrase += fmt.Sprintf(", %d %s", n, plural(n, "time", "times"))
+	}
+	return phrase
+}
+
+// humanizeByDay phrases a BYDAY list: one day in full, "weekdays" for
+// Monday to Friday, abbreviations for other sets, and positions such as
+// "1MO" as "the first Monday".
+func humanizeByDay(days []rruleWeekday) (string, bool) {
+	weekdays := len(days) == 5
+	for i, wd := range days {
+		if wd.pos != 0 || wd.day != time.Weekday(i+1) {
+			weekdays = false
+		}
+	}
+	if weekdays {
+		return "weekdays", true
+	}
+
+	var names []string
+	for _, wd := range days {
+		name := rruleDays[(int(wd.day)+6)%7].name
+		if wd.pos != 0 {
+			ordinal, ok := rruleOrdinals[wd.pos]
+			if !ok {
+				return "", false
+			}
+			name = "the " + ordinal + " " + name
+		} else if len(days) > 1 {
+			name = name[:3]
+		}
+		names = append(names, name)
+	}
+	return strings.Join(names, ", "), true
+}
+
+// humanizeRecurrence phrases the first RRULE of an event's recurrence
+// lines, ignoring EXDATE and RDATE entries.
+func humanizeRecurrence(recurrence []string) string {
+	if rule := findRRULE(recurrence); rule != "" {
+		return humanizeRRULE(rule)
+	}
+	return ""
+}
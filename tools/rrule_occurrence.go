@@ -0,0 +1,138 @@
+package tools
+
+import (
+	"sort"
+	"time"
+)
+
+// maxRRULEPeriods bounds how many periods (days, weeks, months or years)
+// nextRRULEOccurrence walks before giving up on finding an occurrence.
+const maxRRULEPeriods = 100000
+
+// walkRRULE calls fn with each occurrence of the series starting at
+// dtstart, in order, until fn returns false or the series ends through
+// COUNT or UNTIL. EXDATE and moved or cancelled instances are not
+// considered.
+func walkRRULE(r rrule, dtstart time.Time, fn func(occurrence time.Time) bool) {
+	count := 0
+	for period := 0; period < maxRRULEPeriods; period++ {
+		for _, candidate := range r.candidates(dtstart, period) {
+			if candidate.Before(dtstart) {
+				continue
+			}
+			if !r.until.IsZero() && candidate.After(r.until) {
+				return
+			}
+			count++
+			if r.count > 0 && count > r.count {
+				return
+			}
+			if !fn(candidate) {
+				return
+			}
+		}
+	}
+}
+
+// nextRRULEOccurrence returns the first occurrence of the series starting
+// at dtstart that begins after after. When the series ends first, found is
+// false and last is its final occurrence, zero if it never occurred.
+func nextRRULEOccurrence(r rrule, dtstart, after time.Time) (next, last time.Time, found bool) {
+	walkRRULE(r, dtstart, func(occurrence time.Time) bool {
+		if occurrence.After(after) {
+			next, found = occurrence, true
+			return false
+		}
+		last = occurrence
+		return true
+	})
+	return next, last, found
+}
+
+// rruleOccurrencesBefore counts the occurrences of the series starting at
+// dtstart that begin before before.
+func rruleOccurrencesBefore(r rrule, dtstart, before time.Time) int {
+	n := 0
+	walkRRULE(r, dtstart, func(occurrence time.Time) bool {
+		if !occurrence.Before(before) {
+			return false
+		}
+		n++
+		return true
+	})
+	return n
+}
+
+// candidates returns the occurrences the rule allows in the given period
+// after dtstart's, in order, at dtstart's wall-clock time.
+func (r rrule) candidates(dtstart time.Time, period int) []time.Time {
+	at := func(year int, month time.Month, day int) time.Time {
+		return time.Date(year, month, day, dtstart.Hour(), dtstart.Minute(), dtstart.Second(), 0, dtstart.Location())
+	}
+	step := period * r.interval
+	var days []time.Time
+
+	switch r.freq {
+	case "DAILY":
+		day := at(dtstart.Year(), dtstart.Month(), dtstart.Day()+step)
+		if len(r.byDay) == 0 || r.hasWeekday(day.Weekday()) {
+			days = append(days, day)
+		}
+	case "WEEKLY":
+		offset := (int(dtstart.Weekday()) - int(r.weekStart) + 7) % 7
+		first := at(dtstart.Year(), dtstart.Month(), dtstart.Day()-offset+7*step)
+		for i := 0; i < 7; i++ {
+			day := at(first.Year(), first.Month(), first.Day()+i)
+			if len(r.byDay) == 0 && day.Weekday() == dtstart.Weekday() || r.hasWeekday(day.Weekday()) {
+				days = append(days, day)
+			}
+		}
+	case "MONTHLY":
+		month := at(dtstart.Year(), dtstart.Month()+time.Month(step), 1)
+		length := at(month.Year(), month.Month()+1, 0).Day()
+		switch {
+		case len(r.byDay) > 0:
+			for _, wd := range r.byDay {
+				for d := 1; d <= length; d++ {
+					day := at(month.Year(), month.Month(), d)
+					if day.Weekday() != wd.day {
+						continue
+					}
+					nth, fromEnd := (d-1)/7+1, -((length-d)/7 + 1)
+					if wd.pos == 0 || wd.pos == nth || wd.pos == fromEnd {
+						days = append(days, day)
+					}
+				}
+			}
+		default:
+			d := dtstart.Day()
+			if r.byMonthDay > 0 {
+				d = r.byMonthDay
+			} else if r.byMonthDay < 0 {
+				d = length + r.byMonthDay + 1
+			}
+			if d >= 1 && d <= length {
+				days = append(days, at(month.Year(), month.Month(), d))
+			}
+		}
+	case "YEARLY":
+		day := at(dtstart.Year()+step, dtstart.Month(), dtstart.Day())
+		// Feb 29 only recurs in leap years.
+		if day.Day() == dtstart.Day() {
+			days = append(days, day)
+		}
+	}
+
+	sort.Slice(days, func(i, j int) bool { return days[i].Before(days[j]) })
+	return days
+}
+
+// hasWeekday reports whether the rule's BYDAY list names day.
+func (r rrule) hasWeekday(day time.Weekday) bool {
+	for _, wd := range r.byDay {
+		if wd.day == day {
+			return true
+		}
+	}
+	return false
+}
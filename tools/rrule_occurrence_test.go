@@ -0,0 +1,172 @@
+package tools
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestNextRRULEOccurrence(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Fatalf("LoadLocation: %v", err)
+	}
+	// Monday 2026-05-04 09:30 in Berlin.
+	dtstart := time.Date(2026, 5, 4, 9, 30, 0, 0, berlin)
+
+	tests := []struct {
+		name      string
+		rule      string
+		dtstart   time.Time
+		after     time.Time
+		wantNext  string
+		wantLast  string
+		wantFound bool
+	}{
+		{
+			name:      "weekly on the start's weekday",
+			rule:      "RRULE:FREQ=WEEKLY",
+			after:     time.Date(2026, 5, 20, 12, 0, 0, 0, berlin),
+			wantNext:  "2026-05-25T09:30:00+02:00",
+			wantLast:  "2026-05-18T09:30:00+02:00",
+			wantFound: true,
+		},
+		{
+			name:      "weekly on several days",
+			rule:      "RRULE:FREQ=WEEKLY;BYDAY=MO,WE,FR",
+			after:     time.Date(2026, 5, 20, 12, 0, 0, 0, berlin),
+			wantNext:  "2026-05-22T09:30:00+02:00",
+			wantLast:  "2026-05-20T09:30:00+02:00",
+			wantFound: true,
+		},
+		{
+			name:      "every other week",
+			rule:      "RRULE:FREQ=WEEKLY;INTERVAL=2;BYDAY=MO",
+			after:     time.Date(2026, 5, 5, 0, 0, 0, 0, berlin),
+			wantNext:  "2026-05-18T09:30:00+02:00",
+			wantLast:  "2026-05-04T09:30:00+02:00",
+			wantFound: true,
+		},
+		{
+			name:      "the start itself is the next occurrence",
+			rule:      "RRULE:FREQ=DAILY",
+			after:     time.Date(2026, 5, 1, 0, 0, 0, 0, berlin),
+			wantNext:  "2026-05-04T09:30:00+02:00",
+			wantFound: true,
+		},
+		{
+			name:      "weekdays skip the weekend",
+			rule:      "RRULE:FREQ=DAILY;BYDAY=MO,TU,WE,TH,FR",
+			after:     time.Date(2026, 5, 8, 10, 0, 0, 0, berlin),
+			wantNext:  "2026-05-11T09:30:00+02:00",
+			wantLast:  "2026-05-08T09:30:00+02:00",
+			wantFound: true,
+		},
+		{
+			name:      "monthly on the last Friday",
+			rule:      "RRULE:FREQ=MONTHLY;BYDAY=-1FR",
+			after:     time.Date(2026, 5, 30, 0, 0, 0, 0, berlin),
+			wantNext:  "2026-06-26T09:30:00+02:00",
+			wantLast:  "2026-05-29T09:30:00+02:00",
+			wantFound: true,
+		},
+		{
+			name:      "monthly on the 31st skips short months",
+			rule:      "RRULE:FREQ=MONTHLY",
+			dtstart:   time.Date(2026, 1, 31, 9, 0, 0, 0, time.UTC),
+			after:     time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC),
+			wantNext:  "2026-03-31T09:00:00Z",
+			wantLast:  "2026-01-31T09:00:00Z",
+			wantFound: true,
+		},
+		{
+			name:      "wall-clock time is kept across daylight saving",
+			rule:      "RRULE:FREQ=WEEKLY",
+			dtstart:   time.Date(2026, 10, 19, 9, 30, 0, 0, berlin),
+			after:     time.Date(2026, 10, 27, 0, 0, 0, 0, berlin),
+			wantNext:  "2026-11-02T09:30:00+01:00",
+			wantLast:  "2026-10-26T09:30:00+01:00",
+			wantFound: true,
+		},
+		{
+			name:     "COUNT ends the series",
+			rule:     "RRULE:FREQ=WEEKLY;COUNT=3",
+			after:    time.Date(2026, 6, 1, 0, 0, 0, 0, berlin),
+			wantLast: "2026-05-18T09:30:00+02:00",
+		},
+		{
+			name:      "occurrence within COUNT",
+			rule:      "RRULE:FREQ=WEEKLY;COUNT=3",
+			after:     time.Date(2026, 5, 12, 0, 0, 0, 0, berlin),
+			wantNext:  "2026-05-18T09:30:00+02:00",
+			wantLast:  "2026-05-11T09:30:00+02:00",
+			wantFound: true,
+		},
+		{
+			name:     "UNTIL ends the series",
+			rule:     "RRULE:FREQ=DAILY;UNTIL=20260506T215959Z",
+			after:    time.Date(2026, 5, 10, 0, 0, 0, 0, berlin),
+			wantLast: "2026-05-06T09:30:00+02:00",
+		},
+		{
+			name:      "date-only UNTIL includes that day",
+			rule:      "RRULE:FREQ=DAILY;UNTIL=20260506",
+			after:     time.Date(2026, 5, 6, 0, 0, 0, 0, berlin),
+			wantNext:  "2026-05-06T09:30:00+02:00",
+			wantLast:  "2026-05-05T09:30:00+02:00",
+			wantFound: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			start := dtstart
+			if !tc.dtstart.IsZero() {
+				start = tc.dtstart
+			}
+			r, err := parseRRULE(tc.rule, start.Location())
+			if err != nil {
+				t.Fatalf("parseRRULE(%q): %v", tc.rule, err)
+			}
+			next, last, found := nextRRULEOccurrence(r, start, tc.after)
+			if found != tc.wantFound {
+				t.Fatalf("found = %v, want %v", found, tc.wantFound)
+			}
+			if found {
+				if got := next.Format(time.RFC3339); got != tc.wantNext {
+					t.Errorf("next = %s, want %s", got, tc.wantNext)
+				}
+			}
+			gotLast := ""
+			if !last.IsZero() {
+				gotLast = last.Format(time.RFC3339)
+			}
+			if gotLast != tc.wantLast {
+				t.Errorf("last = %q, want %q", gotLast, tc.wantLast)
+			}
+		})
+	}
+}
+
+func TestParseRRULEErrors(t *testing.T) {
+	tests := []struct {
+		rule       string
+		wantErrSub string
+	}{
+		{rule: "FREQ=DAILY", wantErrSub: "must start with RRULE:"},
+		{rule: "RRULE:INTERVAL=2", wantErrSub: "has no FREQ"},
+		{rule: "RRULE:FREQ=HOURLY", wantErrSub: "frequency \"HOURLY\" is not supported"},
+		{rule: "RRULE:FREQ=WEEKLY;COUNT=0", wantErrSub: "invalid COUNT"},
+		{rule: "RRULE:FREQ=WEEKLY;BYDAY=XX", wantErrSub: "invalid BYDAY"},
+		{rule: "RRULE:FREQ=YEARLY;BYMONTH=3", wantErrSub: "BYMONTH is not supported"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.rule, func(t *testing.T) {
+			_, err := parseRRULE(tc.rule, time.UTC)
+			if err == nil || !strings.Contains(err.Error(), tc.wantErrSub) {
+				t.Fatalf("parseRRULE(%q) error = %v, want substring %q", tc.rule, err, tc.wantErrSub)
+			}
+		})
+	}
+}
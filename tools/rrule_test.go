@@ -0,0 +1,81 @@
+package tools
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestHumanizeRRULE(t *testing.T) {
+	tests := []struct {
+		rule string
+		want string
+	}{
+		{"RRULE:FREQ=WEEKLY;BYDAY=MO", "Weekly on Monday"},
+		{"RRULE:FREQ=DAILY;UNTIL=20270101T000000Z", "Daily until Jan 1"},
+		{"RRULE:FREQ=DAILY;UNTIL=20270101", "Daily until Jan 1"},
+		{"RRULE:FREQ=WEEKLY;INTERVAL=2;BYDAY=TU,TH", "Every 2 weeks on Tue, Thu"},
+		{"RRULE:FREQ=WEEKLY;BYDAY=MO,TU,WE,TH,FR", "Weekly on weekdays"},
+		{"RRULE:FREQ=MONTHLY;BYDAY=1MO", "Monthly on the first Monday"},
+		{"RRULE:FREQ=MONTHLY;BYDAY=-1FR;COUNT=6", "Monthly on the last Friday, 6 times"},
+		{"RRULE:FREQ=YEARLY;COUNT=1", "Yearly, 1 time"},
+		{"RRULE:FREQ=DAILY;INTERVAL=1", "Daily"},
+		{"RRULE:FREQ=HOURLY", "RRULE:FREQ=HOURLY"},
+		{"RRULE:FREQ=DAILY;COUNT=zero", "RRULE:FREQ=DAILY;COUNT=zero"},
+		{"RRULE:FREQ=DAILY;UNTIL=tomorrow", "RRULE:FREQ=DAILY;UNTIL=tomorrow"},
+		{"RRULE:FREQ=WEEKLY;BYDAY=XX", "RRULE:FREQ=WEEKLY;BYDAY=XX"},
+		{"EXDATE:20260511T090000Z", "EXDATE:20260511T090000Z"},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.rule, func(t *testing.T) {
+			if got := humanizeRRULE(tc.rule); got != tc.want {
+				t.Errorf("humanizeRRULE(%q) = %q, want %q", tc.rule, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestHumanizeRecurrence(t *testing.T) {
+	got := humanizeRecurrence([]string{"EXDATE:20260511T090000Z", "RRULE:FREQ=DAILY;COUNT=3"})
+	if want := "Daily, 3 times"; got != want {
+		t.Errorf("humanizeRecurrence = %q, want %q", got, want)
+	}
+	if got := humanizeRecurrence(nil); got != "" {
+		t.Errorf("humanizeRecurrence(nil) = %q, want empty", got)
+	}
+}
+
+func TestSplitRRULE(t *testing.T) {
+	tests := []struct {
+		line    string
+		want    string
+		wantErr bool
+	}{
+		{line: "RRULE:FREQ=WEEKLY;BYDAY=MO,WE", want: "FREQ=WEEKLY BYDAY=MO,WE"},
+		{line: "rrule:freq=DAILY;count=3;", want: "FREQ=DAILY COUNT=3"},
+		{line: "EXDATE:20260511T090000Z", wantErr: true},
+		{line: "RRULE:FREQ", wantErr: true},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.line, func(t *testing.T) {
+			parts, err := splitRRULE(tc.line)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatalf("splitRRULE(%q) = %v, want an error", tc.line, parts)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			var got []string
+			for _, part := range parts {
+				got = append(got, part.name+"="+part.value)
+			}
+			if strings.Join(got, " ") != tc.want {
+				t.Errorf("splitRRULE(%q) = %v, want %s", tc.line, got, tc.want)
+			}
+		})
+	}
+}
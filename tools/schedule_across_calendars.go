@@ -0,0 +1,169 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	zap "go.uber.org/zap"
+
+	server "github.com/inference-gateway/adk/server"
+
+	google "github.com/inference-gateway/google-calendar-agent/internal/google"
+)
+
+// noCommonSlotMessage is returned when no slot is free on every calendar.
+const noCommonSlotMessage = "no common slot found"
+
+// ScheduleAcrossCalendarsTool struct holds the tool with dependencies
+type ScheduleAcrossCalendarsTool struct {
+	logger *zap.Logger
+	google google.CalendarService
+}
+
+// NewScheduleAcrossCalendarsTool creates a new schedule_across_calendars tool
+func NewScheduleAcrossCalendarsTool(logger *zap.Logger, google google.CalendarService) server.Tool {
+	tool := &ScheduleAcrossCalendarsTool{
+		logger: logger,
+		google: google,
+	}
+	return server.NewBasicTool(
+		"schedule_across_calendars",
+		"Find the earliest slot this week, within working hours, that is free on every one of several calendars",
+		map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"calendarIds": map[string]any{
+					"description": "Calendar IDs or email addresses that must all be free (required)",
+					"items":       map[string]any{"type": "string"},
+					"minItems":    1,
+					"type":        "array",
+				},
+				"date": map[string]any{
+					"description": "Search from this time to the end of its week (RFC3339 format). Defaults to now.",
+					"type":        "string",
+				},
+				"duration": map[string]any{
+					"description": "Slot length in minutes (required)",
+					"maximum":     480,
+					"minimum":     15,
+					"type":        "integer",
+				},
+			},
+			"required": []string{"calendarIds", "duration"},
+		},
+		tool.ScheduleAcrossCalendarsHandler,
+	)
+}
+
+// ScheduleAcrossCalendarsHandler handles the schedule_across_calendars tool execution
+func (s *ScheduleAcrossCalendarsTool) ScheduleAcrossCalendarsHandler(ctx context.Context, args map[string]any) (string, error) {
+	span := startToolSpan(ctx, "schedule_across_calendars")
+	defer span.End()
+	s.logger.Debug("scheduling across calendars", zap.Any("args", args))
+
+	list, ok := args["calendarIds"].([]any)
+	if !ok || len(list) == 0 {
+		return "", fmt.Errorf("calendarIds is required")
+	}
+	var calendarIDs []string
+	seen := map[string]bool{}
+	for _, item := range list {
+		id, ok := item.(string)
+		if !ok || id == "" {
+			return "", fmt.Errorf("calendarIds must contain non-empty strings, got %v", item)
+		}
+		if !seen[id] {
+			seen[id] = true
+			calendarIDs = append(calendarIDs, id)
+		}
+	}
+
+	d, exists := args["duration"]
+	if !exists || d == nil {
+		return "", fmt.Errorf("duration is required")
+	}
+	dFloat, ok := d.(float64)
+	if !ok || dFloat <= 0 {
+		return "", fmt.Errorf("duration must be a positive number of minutes, got %v", d)
+	}
+	duration := int(dFloat)
+
+	from := time.Now()
+	if v, exists := args["date"]; exists && v != nil {
+		str, ok := v.(string)
+		if !ok {
+			return "", fmt.Errorf("date must be a string, got %T", v)
+		}
+		parsed, err := time.Parse(time.RFC3339, str)
+		if err != nil {
+			return "", fmt.Errorf("invalid date format (expected RFC3339): %w", err)
+		}
+		from = parsed
+	}
+
+	hours, err := loadWorkingHours()
+	if err != nil {
+		return "", err
+	}
+	firstDay, err := loadWeekStart()
+	if err != nil {
+		return "", err
+	}
+
+	loc, _ := calendarTimezone(ctx, s.google, s.google.GetCalendarID())
+	_, weekEnd := weekBounds(from, loc, firstDay)
+
+	calendars, err := s.google.QueryFreeBusy(ctx, calendarIDs, from, weekEnd)
+	if err != nil {
+		s.logger.Error("failed to query free/busy", zap.Error(err), zap.Strings("calendarIds", calendarIDs))
+		return "", fmt.Errorf("failed to query free/busy: %w", err)
+	}
+
+	// A calendar that cannot be read might be busy at any time, so no slot
+	// can be promised while one is missing.
+	var busy []timeSlot
+	for _, id := range calendarIDs {
+		periods, err := freeBusyPeriods(calendars, id)
+		if err != nil {
+			return "", fmt.Errorf("cannot read free/busy for calendar '%s': %w", id, err)
+		}
+		busy = append(busy, periods...)
+	}
+	sort.Slice(busy, func(i, j int) bool {
+		return busy[i].startTime.Before(busy[j].startTime)
+	})
+
+	slotDuration := time.Duration(duration) * time.Minute
+	start, found := hours.nextFreeSlot(busy, from, weekEnd, slotDuration, loc)
+
+	s.logger.Info("common slot search finished",
+		zap.Strings("calendarIds", calendarIDs),
+		zap.Bool("found", found))
+
+	result := map[string]any{
+		"success":           true,
+		"found":             found,
+		"calendarIds":       calendarIDs,
+		"requestedDuration": duration,
+		"searchRange": map[string]string{
+			"startDate": from.Format(time.RFC3339),
+			"endDate":   weekEnd.Format(time.RFC3339),
+		},
+	}
+	if found {
+		result["startTime"] = start.Format(time.RFC3339)
+		result["endTime"] = start.Add(slotDuration).Format(time.RFC3339)
+	} else {
+		result["message"] = noCommonSlotMessage
+	}
+
+	resultJSON, err := json.Marshal(result)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal result: %w", err)
+	}
+
+	return string(resultJSON), nil
+}
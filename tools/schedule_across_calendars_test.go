@@ -0,0 +1,123 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	zap "go.uber.org/zap"
+	calendar "google.golang.org/api/calendar/v3"
+)
+
+func TestScheduleAcrossCalendarsHandler(t *testing.T) {
+	t.Setenv("GOOGLE_CALENDAR_TIMEZONE", "UTC")
+	t.Setenv("TZ", "")
+	t.Setenv("GOOGLE_CALENDAR_WORKING_HOURS_START", "09:00")
+	t.Setenv("GOOGLE_CALENDAR_WORKING_HOURS_END", "17:00")
+	t.Setenv("GOOGLE_CALENDAR_WEEK_START", "monday")
+
+	busy := func(start, end string) *calendar.TimePeriod {
+		return &calendar.TimePeriod{Start: start, End: end}
+	}
+	// Each calendar has gaps of its own, but the only time both are free
+	// inside working hours is Thursday from 14:00.
+	calendars := map[string]calendar.FreeBusyCalendar{
+		"alice@example.com": {Busy: []*calendar.TimePeriod{
+			busy("2026-05-18T09:00:00Z", "2026-05-18T17:00:00Z"),
+			busy("2026-05-19T09:00:00Z", "2026-05-19T17:00:00Z"),
+			busy("2026-05-20T09:00:00Z", "2026-05-20T13:00:00Z"),
+			busy("2026-05-21T09:00:00Z", "2026-05-21T13:00:00Z"),
+			busy("2026-05-22T09:00:00Z", "2026-05-22T17:00:00Z"),
+		}},
+		"team@group.calendar.google.com": {Busy: []*calendar.TimePeriod{
+			busy("2026-05-20T13:00:00Z", "2026-05-20T17:00:00Z"),
+			busy("2026-05-21T13:00:00Z", "2026-05-21T14:00:00Z"),
+		}},
+		"private@example.com": {Errors: []*calendar.Error{{Domain: "global", Reason: "notFound"}}},
+	}
+	both := []any{"alice@example.com", "team@group.calendar.google.com"}
+
+	tests := []struct {
+		name        string
+		args        map[string]any
+		wantStart   string
+		wantEnd     string
+		wantMessage string
+		wantErrSub  string
+	}{
+		{
+			name:      "common gap on thursday",
+			args:      map[string]any{"calendarIds": both, "duration": float64(60), "date": "2026-05-18T08:00:00Z"},
+			wantStart: "2026-05-21T14:00:00Z",
+			wantEnd:   "2026-05-21T15:00:00Z",
+		},
+		{
+			name:        "slot longer than the common gap",
+			args:        map[string]any{"calendarIds": both, "duration": float64(240), "date": "2026-05-18T08:00:00Z"},
+			wantMessage: noCommonSlotMessage,
+		},
+		{
+			name:        "search starting after the gap",
+			args:        map[string]any{"calendarIds": both, "duration": float64(60), "date": "2026-05-21T16:30:00Z"},
+			wantMessage: noCommonSlotMessage,
+		},
+		{
+			name:       "unreadable calendar",
+			args:       map[string]any{"calendarIds": []any{"alice@example.com", "private@example.com"}, "duration": float64(30), "date": "2026-05-18T08:00:00Z"},
+			wantErrSub: "cannot read free/busy for calendar 'private@example.com'",
+		},
+		{
+			name:       "missing calendarIds",
+			args:       map[string]any{"duration": float64(30)},
+			wantErrSub: "calendarIds is required",
+		},
+		{
+			name:       "missing duration",
+			args:       map[string]any{"calendarIds": both},
+			wantErrSub: "duration is required",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			stub := &stubCalendarService{
+				freeBusyFn: func(calendarIDs []string, timeMin, timeMax time.Time) (map[string]calendar.FreeBusyCalendar, error) {
+					return calendars, nil
+				},
+			}
+			tool := &ScheduleAcrossCalendarsTool{logger: zap.NewNop(), google: stub}
+			result, err := tool.ScheduleAcrossCalendarsHandler(context.Background(), tc.args)
+
+			if tc.wantErrSub != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErrSub) {
+					t.Fatalf("error = %v, want substring %q", err, tc.wantErrSub)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			var parsed struct {
+				Found     bool   `json:"found"`
+				StartTime string `json:"startTime"`
+				EndTime   string `json:"endTime"`
+				Message   string `json:"message"`
+			}
+			if err := json.Unmarshal([]byte(result), &parsed); err != nil {
+				t.Fatalf("failed to unmarshal result: %v", err)
+			}
+			if parsed.Found != (tc.wantStart != "") {
+				t.Errorf("found = %v, want %v", parsed.Found, tc.wantStart != "")
+			}
+			if parsed.StartTime != tc.wantStart || parsed.EndTime != tc.wantEnd {
+				t.Errorf("slot = %s–%s, want %s–%s", parsed.StartTime, parsed.EndTime, tc.wantStart, tc.wantEnd)
+			}
+			if parsed.Message != tc.wantMessage {
+				t.Errorf("message = %q, want %q", parsed.Message, tc.wantMessage)
+			}
+		})
+	}
+}
@@ -0,0 +1,63 @@
+package tools
+
+import (
+	"context"
+
+	zap "go.uber.org/zap"
+
+	server "github.com/inference-gateway/adk/server"
+
+	google "github.com/inference-gateway/google-calendar-agent/internal/google"
+)
+
+const (
+	// unavailableMessage is shown when Google Calendar cannot be reached.
+	unavailableMessage = "I couldn't reach Google Calendar right now, please try again shortly"
+	// authFailedMessage is shown when Google rejects the agent's credentials.
+	authFailedMessage = "Google Calendar rejected the agent's credentials; ask the administrator to check the service account key and that the calendar is shared with it"
+)
+
+// ServiceError is a tool error with a message meant for the user. The
+// technical cause stays available through Unwrap.
+type ServiceError struct {
+	Message string
+	Err     error
+}
+
+func (e *ServiceError) Error() string {
+	return e.Message
+}
+
+func (e *ServiceError) Unwrap() error {
+	return e.Err
+}
+
+// ServiceErrorToolBox replaces Google outage and authentication errors with
+// actionable messages, so the task does not fail with a raw wrapped error.
+// The original error is logged. Other errors pass through unchanged, since
+// they describe the request and help the model correct it.
+type ServiceErrorToolBox struct {
+	server.ToolBox
+	logger *zap.Logger
+}
+
+// NewServiceErrorToolBox wraps inner so that Google service errors reach the
+// model as ServiceError.
+func NewServiceErrorToolBox(inner server.ToolBox, logger *zap.Logger) server.ToolBox {
+	return &ServiceErrorToolBox{ToolBox: inner, logger: logger}
+}
+
+// ExecuteTool executes a tool by name, translating Google service errors
+func (s *ServiceErrorToolBox) ExecuteTool(ctx context.Context, toolName string, arguments map[string]any) (string, error) {
+	result, err := s.ToolBox.ExecuteTool(ctx, toolName, arguments)
+	switch {
+	case google.IsAuthError(err):
+		s.logger.Error("google calendar rejected credentials", zap.String("tool", toolName), zap.Error(err))
+		return result, &ServiceError{Message: authFailedMessage, Err: err}
+	case google.IsUnavailable(err):
+		s.logger.Warn("google calendar unavailable", zap.String("tool", toolName), zap.Error(err))
+		return result, &ServiceError{Message: unavailableMessage, Err: err}
+	default:
+		return result, err
+	}
+}
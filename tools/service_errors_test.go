@@ -0,0 +1,78 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"net"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	zap "go.uber.org/zap"
+	observer "go.uber.org/zap/zaptest/observer"
+	calendar "google.golang.org/api/calendar/v3"
+	googleapi "google.golang.org/api/googleapi"
+
+	server "github.com/inference-gateway/adk/server"
+)
+
+func TestServiceErrorToolBox(t *testing.T) {
+	networkErr := &url.Error{Op: "Get", URL: "https://www.googleapis.com/calendar/v3", Err: &net.OpError{Op: "dial", Net: "tcp", Err: errors.New("no route to host")}}
+	authErr := &googleapi.Error{Code: http.StatusUnauthorized, Message: "Invalid Credentials"}
+	notFoundErr := &googleapi.Error{Code: http.StatusNotFound, Message: "Not Found"}
+
+	tests := []struct {
+		name        string
+		serviceErr  error
+		wantMessage string
+		wantLog     string
+	}{
+		{name: "network error", serviceErr: networkErr, wantMessage: unavailableMessage, wantLog: "google calendar unavailable"},
+		{name: "auth error", serviceErr: authErr, wantMessage: authFailedMessage, wantLog: "google calendar rejected credentials"},
+		{name: "request error passes through", serviceErr: notFoundErr},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			stub := &stubCalendarService{
+				listEventsFn: func(calendarID string, timeMin, timeMax time.Time) ([]*calendar.Event, error) {
+					return nil, tc.serviceErr
+				},
+			}
+			inner := server.NewDefaultToolBox(nil)
+			inner.AddTool(NewListCalendarEventsTool(zap.NewNop(), stub))
+			core, logs := observer.New(zap.WarnLevel)
+			tb := NewServiceErrorToolBox(inner, zap.New(core))
+
+			_, err := tb.ExecuteTool(context.Background(), "list_calendar_events", map[string]any{})
+			if err == nil {
+				t.Fatal("expected error")
+			}
+			if !errors.Is(err, tc.serviceErr) {
+				t.Errorf("error %v does not wrap the service error", err)
+			}
+
+			var serviceErr *ServiceError
+			if tc.wantMessage == "" {
+				if errors.As(err, &serviceErr) {
+					t.Errorf("error = %q, want it passed through unchanged", err)
+				}
+				if logs.Len() != 0 {
+					t.Errorf("logged %d entries, want none", logs.Len())
+				}
+				return
+			}
+			if !errors.As(err, &serviceErr) || err.Error() != tc.wantMessage {
+				t.Fatalf("error = %q, want %q", err, tc.wantMessage)
+			}
+			entries := logs.FilterMessage(tc.wantLog).All()
+			if len(entries) != 1 {
+				t.Fatalf("got %d %q log entries, want 1", len(entries), tc.wantLog)
+			}
+			if detail, _ := entries[0].ContextMap()["error"].(string); detail == "" || detail == tc.wantMessage {
+				t.Errorf("logged error = %q, want the technical detail", detail)
+			}
+		})
+	}
+}
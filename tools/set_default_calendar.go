@@ -0,0 +1,83 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	zap "go.uber.org/zap"
+
+	server "github.com/inference-gateway/adk/server"
+
+	google "github.com/inference-gateway/google-calendar-agent/internal/google"
+)
+
+// SetDefaultCalendarTool struct holds the tool with dependencies
+type SetDefaultCalendarTool struct {
+	logger *zap.Logger
+	google google.CalendarService
+}
+
+// NewSetDefaultCalendarTool creates a new set_default_calendar tool
+func NewSetDefaultCalendarTool(logger *zap.Logger, google google.CalendarService) server.Tool {
+	tool := &SetDefaultCalendarTool{
+		logger: logger,
+		google: google,
+	}
+	return server.NewBasicTool(
+		"set_default_calendar",
+		"Switch the calendar the other tools operate on, until the agent restarts",
+		map[string]any{
+			"type": "object",
+			"properties": map[string]any{
+				"calendarId": map[string]any{
+					"description": "ID of the calendar to use from now on, e.g. team@group.calendar.google.com or primary (required)",
+					"type":        "string",
+				},
+			},
+			"required": []string{"calendarId"},
+		},
+		tool.SetDefaultCalendarHandler,
+	)
+}
+
+// SetDefaultCalendarHandler handles the set_default_calendar tool execution
+func (s *SetDefaultCalendarTool) SetDefaultCalendarHandler(ctx context.Context, args map[string]any) (string, error) {
+	span := startToolSpan(ctx, "set_default_calendar")
+	defer span.End()
+	s.logger.Debug("setting default calendar", zap.Any("args", args))
+
+	calendarID, ok := args["calendarId"].(string)
+	if !ok || calendarID == "" {
+		return "", fmt.Errorf("calendarId is required")
+	}
+
+	cal, err := s.google.GetCalendar(ctx, calendarID)
+	if err != nil {
+		s.logger.Warn("cannot switch to calendar", zap.Error(err), zap.String("calendarId", calendarID))
+		return "", fmt.Errorf("calendar %q not found or not shared with the agent: %w", calendarID, err)
+	}
+
+	previous := s.google.GetCalendarID()
+	s.google.SetCalendarID(calendarID)
+
+	s.logger.Info("default calendar changed",
+		zap.String("previous", previous),
+		zap.String("calendarId", calendarID))
+
+	result := map[string]any{
+		"success":            true,
+		"calendarId":         calendarID,
+		"previousCalendarId": previous,
+	}
+	if cal != nil && cal.Summary != "" {
+		result["summary"] = cal.Summary
+	}
+
+	resultJSON, err := json.Marshal(result)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal result: %w", err)
+	}
+
+	return string(resultJSON), nil
+}
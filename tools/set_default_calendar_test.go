@@ -0,0 +1,101 @@
+package tools
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	zap "go.uber.org/zap"
+	calendar "google.golang.org/api/calendar/v3"
+
+	config "github.com/inference-gateway/google-calendar-agent/config"
+	google "github.com/inference-gateway/google-calendar-agent/internal/google"
+)
+
+func TestSetDefaultCalendarHandler(t *testing.T) {
+	calendars := map[string]*calendar.Calendar{
+		"primary":                        {Id: "alice@example.com", Summary: "Alice"},
+		"team@group.calendar.google.com": {Id: "team@group.calendar.google.com", Summary: "Team"},
+	}
+	getCalendar := func(calendarID string) (*calendar.Calendar, error) {
+		if cal, ok := calendars[calendarID]; ok {
+			return cal, nil
+		}
+		return nil, errors.New("googleapi: Error 404: Not Found")
+	}
+
+	tests := []struct {
+		name       string
+		args       map[string]any
+		wantErrSub string
+		wantID     string
+	}{
+		{
+			name:   "valid calendar becomes the default",
+			args:   map[string]any{"calendarId": "team@group.calendar.google.com"},
+			wantID: "team@group.calendar.google.com",
+		},
+		{
+			name:       "unknown calendar keeps the previous default",
+			args:       map[string]any{"calendarId": "missing@example.com"},
+			wantErrSub: `calendar "missing@example.com" not found`,
+			wantID:     "primary",
+		},
+		{
+			name:       "missing calendarId returns error",
+			args:       map[string]any{},
+			wantErrSub: "calendarId is required",
+			wantID:     "primary",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			stub := &stubCalendarService{getCalendarFn: getCalendar}
+			set := &SetDefaultCalendarTool{logger: zap.NewNop(), google: stub}
+			_, err := set.SetDefaultCalendarHandler(context.Background(), tc.args)
+
+			if tc.wantErrSub != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErrSub) {
+					t.Fatalf("error = %v, want substring %q", err, tc.wantErrSub)
+				}
+			} else if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			get := &GetDefaultCalendarTool{logger: zap.NewNop(), google: stub}
+			result, err := get.GetDefaultCalendarHandler(context.Background(), map[string]any{})
+			if err != nil {
+				t.Fatalf("get_default_calendar: unexpected error: %v", err)
+			}
+			var parsed map[string]any
+			if err := json.Unmarshal([]byte(result), &parsed); err != nil {
+				t.Fatalf("failed to unmarshal result: %v", err)
+			}
+			if parsed["calendarId"] != tc.wantID {
+				t.Errorf("calendarId = %v, want %s", parsed["calendarId"], tc.wantID)
+			}
+		})
+	}
+}
+
+func TestSetDefaultCalendarInMockMode(t *testing.T) {
+	svc := google.NewInMemoryCalendarService(zap.NewNop(), &config.Config{})
+	set := &SetDefaultCalendarTool{logger: zap.NewNop(), google: svc}
+
+	if _, err := set.SetDefaultCalendarHandler(context.Background(), map[string]any{"calendarId": "missing@example.com"}); err == nil || !strings.Contains(err.Error(), "not found") {
+		t.Fatalf("switching to an unknown calendar: error = %v, want not found", err)
+	}
+	if got := svc.GetCalendarID(); got != "primary" {
+		t.Errorf("default after the failed switch = %q, want primary", got)
+	}
+
+	if _, err := set.SetDefaultCalendarHandler(context.Background(), map[string]any{"calendarId": "mock@example.com"}); err != nil {
+		t.Fatalf("switching to the mock calendar: %v", err)
+	}
+	if got := svc.GetCalendarID(); got != "mock@example.com" {
+		t.Errorf("default = %q, want mock@example.com", got)
+	}
+}
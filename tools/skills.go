@@ -0,0 +1,74 @@
+package tools
+
+import (
+	"sort"
+
+	server "github.com/inference-gateway/adk/server"
+
+	config "github.com/inference-gateway/google-calendar-agent/config"
+)
+
+// skillTools groups the tools that change the calendar by the skill whose
+// SKILL_<NAME>_ENABLED flag switches them off. Tools that only read stay
+// available whatever the flags say.
+var skillTools = map[string][]string{
+	"create": {
+		"batch_create_calendar_events",
+		"copy_event_to_calendar",
+		"create_calendar_event",
+	},
+	"delete": {
+		"cleanup_calendar",
+		"delete_calendar_event",
+		// Its merge mode deletes the extra copies it finds.
+		"find_duplicate_events",
+	},
+	"update": {
+		"bulk_reschedule",
+		"postpone_event",
+		"reschedule_to_next_available",
+		"respond_to_invites",
+		"update_calendar_event",
+	},
+}
+
+// DisabledSkills returns the sorted names of the skills cfg switches off.
+func DisabledSkills(cfg config.SkillConfig) []string {
+	enabled := map[string]bool{
+		"create": cfg.CreateEnabled,
+		"delete": cfg.DeleteEnabled,
+		"update": cfg.UpdateEnabled,
+	}
+	var disabled []string
+	for skill, on := range enabled {
+		if !on {
+			disabled = append(disabled, skill)
+		}
+	}
+	sort.Strings(disabled)
+	return disabled
+}
+
+// NewSkillToolBox wraps inner so that the tools of the skills cfg switches
+// off are neither offered to the LLM nor executable. With every skill
+// enabled it returns inner unchanged.
+func NewSkillToolBox(inner server.ToolBox, cfg config.SkillConfig) server.ToolBox {
+	disabled := DisabledSkills(cfg)
+	if len(disabled) == 0 {
+		return inner
+	}
+
+	hidden := make(map[string]bool)
+	for _, skill := range disabled {
+		for _, name := range skillTools[skill] {
+			hidden[name] = true
+		}
+	}
+	set := make(map[string]bool)
+	for _, name := range inner.GetToolNames() {
+		if !hidden[name] {
+			set[name] = true
+		}
+	}
+	return &FilteredToolBox{inner: inner, enabled: set}
+}
@@ -0,0 +1,73 @@
+package tools
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"testing"
+
+	config "github.com/inference-gateway/google-calendar-agent/config"
+)
+
+func TestNewSkillToolBox(t *testing.T) {
+	allEnabled := config.SkillConfig{CreateEnabled: true, DeleteEnabled: true, UpdateEnabled: true}
+
+	tests := []struct {
+		name         string
+		cfg          config.SkillConfig
+		wantDisabled []string
+		wantTools    []string
+	}{
+		{
+			name:      "every skill enabled",
+			cfg:       allEnabled,
+			wantTools: []string{"Read", "create_calendar_event", "delete_calendar_event", "input_required", "list_calendar_events"},
+		},
+		{
+			name:         "delete disabled",
+			cfg:          config.SkillConfig{CreateEnabled: true, UpdateEnabled: true},
+			wantDisabled: []string{"delete"},
+			wantTools:    []string{"Read", "create_calendar_event", "input_required", "list_calendar_events"},
+		},
+		{
+			name:         "every skill disabled keeps the read-only tools",
+			cfg:          config.SkillConfig{},
+			wantDisabled: []string{"create", "delete", "update"},
+			wantTools:    []string{"Read", "input_required", "list_calendar_events"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := DisabledSkills(tt.cfg); strings.Join(got, ",") != strings.Join(tt.wantDisabled, ",") {
+				t.Errorf("DisabledSkills() = %v, want %v", got, tt.wantDisabled)
+			}
+
+			tb := NewSkillToolBox(newTestToolBox(), tt.cfg)
+			var got []string
+			for _, tool := range tb.GetTools() {
+				got = append(got, tool.Function.Name)
+			}
+			sort.Strings(got)
+			if strings.Join(got, ",") != strings.Join(tt.wantTools, ",") {
+				t.Errorf("GetTools() = %v, want %v", got, tt.wantTools)
+			}
+		})
+	}
+}
+
+func TestSkillToolBoxRefusesDisabledTools(t *testing.T) {
+	tb := NewSkillToolBox(newTestToolBox(), config.SkillConfig{CreateEnabled: true, UpdateEnabled: true})
+
+	if tb.HasTool("delete_calendar_event") {
+		t.Error("HasTool(delete_calendar_event) = true, want false")
+	}
+	if _, err := tb.ExecuteTool(context.Background(), "delete_calendar_event", nil); err == nil {
+		t.Error("ExecuteTool(delete_calendar_event) succeeded for a disabled skill")
+	}
+
+	// LLM_ENABLED_TOOLS cannot bring a disabled skill's tool back.
+	if _, err := NewFilteredToolBox(tb, []string{"delete_calendar_event"}); err == nil {
+		t.Error("NewFilteredToolBox accepted a tool of a disabled skill")
+	}
+}
@@ -0,0 +1,61 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	server "github.com/inference-gateway/adk/server"
+)
+
+// ErrOperationTimeout is returned when a tool does not finish within the
+// configured operation timeout.
+var ErrOperationTimeout = errors.New("operation timed out")
+
+// TimeoutToolBox bounds every tool execution with a deadline, so one slow
+// Google call cannot hold the A2A task open indefinitely. The handler
+// receives the deadline on its context and passes it to every
+// CalendarService call, so a request still in flight when the timeout is
+// reported is cancelled rather than completing behind the caller's back.
+type TimeoutToolBox struct {
+	server.ToolBox
+	timeout time.Duration
+}
+
+// NewTimeoutToolBox wraps inner so that each tool call fails with
+// ErrOperationTimeout after timeout. A timeout of zero or less returns inner
+// unchanged.
+func NewTimeoutToolBox(inner server.ToolBox, timeout time.Duration) server.ToolBox {
+	if timeout <= 0 {
+		return inner
+	}
+	return &TimeoutToolBox{ToolBox: inner, timeout: timeout}
+}
+
+type toolOutcome struct {
+	result string
+	err    error
+}
+
+// ExecuteTool executes a tool by name, giving up after the timeout
+func (t *TimeoutToolBox) ExecuteTool(ctx context.Context, toolName string, arguments map[string]any) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, t.timeout)
+	defer cancel()
+
+	done := make(chan toolOutcome, 1)
+	go func() {
+		result, err := t.ToolBox.ExecuteTool(ctx, toolName, arguments)
+		done <- toolOutcome{result: result, err: err}
+	}()
+
+	select {
+	case outcome := <-done:
+		return outcome.result, outcome.err
+	case <-ctx.Done():
+		if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+			return "", fmt.Errorf("%s did not finish within %s: %w", toolName, t.timeout, ErrOperationTimeout)
+		}
+		return "", ctx.Err()
+	}
+}
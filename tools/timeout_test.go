@@ -0,0 +1,111 @@
+package tools
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	zap "go.uber.org/zap"
+	calendar "google.golang.org/api/calendar/v3"
+
+	server "github.com/inference-gateway/adk/server"
+)
+
+func TestTimeoutToolBox(t *testing.T) {
+	tests := []struct {
+		name    string
+		delay   time.Duration
+		wantErr error
+	}{
+		{name: "fast call returns its result", delay: 0},
+		{name: "slow service call times out", delay: time.Second, wantErr: ErrOperationTimeout},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			stub := &stubCalendarService{
+				listEventsFn: func(calendarID string, timeMin, timeMax time.Time) ([]*calendar.Event, error) {
+					time.Sleep(tc.delay)
+					return nil, nil
+				},
+			}
+			inner := server.NewDefaultToolBox(nil)
+			inner.AddTool(NewListCalendarEventsTool(zap.NewNop(), stub))
+			tb := NewTimeoutToolBox(inner, 50*time.Millisecond)
+
+			start := time.Now()
+			result, err := tb.ExecuteTool(context.Background(), "list_calendar_events", map[string]any{})
+			if tc.wantErr != nil {
+				if !errors.Is(err, tc.wantErr) {
+					t.Fatalf("error = %v, want %v", err, tc.wantErr)
+				}
+				if elapsed := time.Since(start); elapsed > 500*time.Millisecond {
+					t.Errorf("returned after %v, want close to the 50ms timeout", elapsed)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if result == "" {
+				t.Error("result is empty")
+			}
+		})
+	}
+}
+
+// slowWriteService answers CreateEvent after delay the way the Google
+// client does: a context that ends first aborts the request, so the event
+// is never written.
+type slowWriteService struct {
+	*stubCalendarService
+	delay  time.Duration
+	writes atomic.Int32
+}
+
+func (s *slowWriteService) CreateEvent(ctx context.Context, calendarID string, event *calendar.Event) (*calendar.Event, error) {
+	select {
+	case <-time.After(s.delay):
+		s.writes.Add(1)
+		event.Id = "evt-created"
+		return event, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+func TestTimeoutToolBoxCancelsWrites(t *testing.T) {
+	t.Setenv("GOOGLE_CALENDAR_TIMEZONE", "UTC")
+	t.Setenv("TZ", "")
+	disableConflictCheck(t)
+
+	svc := &slowWriteService{stubCalendarService: &stubCalendarService{}, delay: 200 * time.Millisecond}
+	inner := server.NewDefaultToolBox(nil)
+	inner.AddTool(NewCreateCalendarEventTool(zap.NewNop(), svc))
+	tb := NewTimeoutToolBox(inner, 50*time.Millisecond)
+
+	_, err := tb.ExecuteTool(context.Background(), "create_calendar_event", map[string]any{
+		"summary":   "Planning",
+		"startTime": "2026-05-23T10:00:00Z",
+		"endTime":   "2026-05-23T11:00:00Z",
+	})
+	if !errors.Is(err, ErrOperationTimeout) {
+		t.Fatalf("error = %v, want %v", err, ErrOperationTimeout)
+	}
+
+	// Give the abandoned handler time to finish the write if the deadline
+	// did not reach it.
+	time.Sleep(2 * svc.delay)
+	if n := svc.writes.Load(); n != 0 {
+		t.Errorf("event written %d times after the timeout, want 0", n)
+	}
+}
+
+func TestNewTimeoutToolBoxDisabled(t *testing.T) {
+	inner := newTestToolBox()
+	if tb := NewTimeoutToolBox(inner, 0); tb != server.ToolBox(inner) {
+		t.Errorf("NewTimeoutToolBox with zero timeout = %T, want inner unchanged", tb)
+	}
+}
@@ -0,0 +1,121 @@
+package tools
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	sdk "github.com/inference-gateway/sdk"
+
+	server "github.com/inference-gateway/adk/server"
+)
+
+// alwaysEnabledTools are built-ins the agent relies on regardless of which
+// calendar tools are exposed: input_required drives the A2A input-required
+// state and Read loads skill playbooks.
+var alwaysEnabledTools = map[string]bool{
+	"input_required": true,
+	"Read":           true,
+}
+
+// FilteredToolBox exposes only an enabled subset of another toolbox, so
+// read-only deployments can hide mutating tools and smaller prompts can
+// drop tools they never use.
+type FilteredToolBox struct {
+	inner   server.ToolBox
+	enabled map[string]bool
+}
+
+// NewFilteredToolBox wraps inner so that only the named tools (plus the
+// always-enabled built-ins) are offered to the LLM. An empty list exposes
+// every tool. Naming a tool that is not registered is an error.
+func NewFilteredToolBox(inner server.ToolBox, enabled []string) (server.ToolBox, error) {
+	if len(enabled) == 0 {
+		return inner, nil
+	}
+
+	set := make(map[string]bool, len(enabled)+len(alwaysEnabledTools))
+	var unknown []string
+	for _, name := range enabled {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+		if !inner.HasTool(name) {
+			unknown = append(unknown, name)
+			continue
+		}
+		set[name] = true
+	}
+	if len(unknown) > 0 {
+		available := inner.GetToolNames()
+		sort.Strings(available)
+		return nil, fmt.Errorf("unknown tools %s (available: %s)", strings.Join(unknown, ", "), strings.Join(available, ", "))
+	}
+
+	for name := range alwaysEnabledTools {
+		if inner.HasTool(name) {
+			set[name] = true
+		}
+	}
+
+	return &FilteredToolBox{inner: inner, enabled: set}, nil
+}
+
+// GetTools returns the enabled tools in OpenAI function call format
+func (f *FilteredToolBox) GetTools() []sdk.ChatCompletionTool {
+	all := f.inner.GetTools()
+	tools := make([]sdk.ChatCompletionTool, 0, len(f.enabled))
+	for _, tool := range all {
+		if f.enabled[tool.Function.Name] {
+			tools = append(tools, tool)
+		}
+	}
+	return tools
+}
+
+// ExecuteTool executes an enabled tool by name
+func (f *FilteredToolBox) ExecuteTool(ctx context.Context, toolName string, arguments map[string]any) (string, error) {
+	if !f.enabled[toolName] {
+		return "", &server.ToolNotFoundError{ToolName: toolName}
+	}
+	return f.inner.ExecuteTool(ctx, toolName, arguments)
+}
+
+// GetToolNames returns the names of the enabled tools
+func (f *FilteredToolBox) GetToolNames() []string {
+	names := make([]string, 0, len(f.enabled))
+	for _, name := range f.inner.GetToolNames() {
+		if f.enabled[name] {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// HasTool reports whether toolName is registered and enabled
+func (f *FilteredToolBox) HasTool(toolName string) bool {
+	return f.enabled[toolName] && f.inner.HasTool(toolName)
+}
+
+// GetTool returns an enabled tool by name
+func (f *FilteredToolBox) GetTool(toolName string) (server.Tool, bool) {
+	if !f.enabled[toolName] {
+		return nil, false
+	}
+	return f.inner.GetTool(toolName)
+}
+
+// CalendarToolNames returns the sorted names of the calendar tools tb
+// offers, leaving out the always-enabled built-ins users never ask for.
+func CalendarToolNames(tb server.ToolBox) []string {
+	var names []string
+	for _, name := range tb.GetToolNames() {
+		if !alwaysEnabledTools[name] {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
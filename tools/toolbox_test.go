@@ -0,0 +1,125 @@
+package tools
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"testing"
+
+	server "github.com/inference-gateway/adk/server"
+)
+
+func newTestToolBox() *server.DefaultToolBox {
+	tb := server.NewDefaultToolBox(nil)
+	for _, name := range []string{"Read", "list_calendar_events", "create_calendar_event", "delete_calendar_event"} {
+		tb.AddTool(server.NewBasicTool(name, name, map[string]any{"type": "object"}, func(ctx context.Context, args map[string]any) (string, error) {
+			return "ok", nil
+		}))
+	}
+	return tb
+}
+
+func TestNewFilteredToolBox(t *testing.T) {
+	tests := []struct {
+		name      string
+		enabled   []string
+		wantTools []string
+		wantErr   string
+	}{
+		{
+			name:      "empty list exposes everything",
+			enabled:   nil,
+			wantTools: []string{"Read", "create_calendar_event", "delete_calendar_event", "input_required", "list_calendar_events"},
+		},
+		{
+			name:      "subset plus built-ins",
+			enabled:   []string{"list_calendar_events"},
+			wantTools: []string{"Read", "input_required", "list_calendar_events"},
+		},
+		{
+			name:      "whitespace is trimmed",
+			enabled:   []string{" list_calendar_events", "create_calendar_event "},
+			wantTools: []string{"Read", "create_calendar_event", "input_required", "list_calendar_events"},
+		},
+		{
+			name:    "unknown tool",
+			enabled: []string{"list_calendar_events", "send_email"},
+			wantErr: "unknown tools send_email",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tb, err := NewFilteredToolBox(newTestToolBox(), tt.enabled)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("error = %v, want containing %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			var got []string
+			for _, tool := range tb.GetTools() {
+				got = append(got, tool.Function.Name)
+			}
+			sort.Strings(got)
+			if strings.Join(got, ",") != strings.Join(tt.wantTools, ",") {
+				t.Errorf("GetTools() = %v, want %v", got, tt.wantTools)
+			}
+
+			names := tb.GetToolNames()
+			sort.Strings(names)
+			if strings.Join(names, ",") != strings.Join(tt.wantTools, ",") {
+				t.Errorf("GetToolNames() = %v, want %v", names, tt.wantTools)
+			}
+		})
+	}
+}
+
+func TestFilteredToolBoxHidesDisabledTools(t *testing.T) {
+	tb, err := NewFilteredToolBox(newTestToolBox(), []string{"list_calendar_events"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	if tb.HasTool("delete_calendar_event") {
+		t.Error("HasTool(delete_calendar_event) = true, want false")
+	}
+	if _, ok := tb.GetTool("delete_calendar_event"); ok {
+		t.Error("GetTool(delete_calendar_event) found a disabled tool")
+	}
+	if _, err := tb.ExecuteTool(context.Background(), "delete_calendar_event", nil); err == nil {
+		t.Error("ExecuteTool(delete_calendar_event) succeeded for a disabled tool")
+	}
+
+	out, err := tb.ExecuteTool(context.Background(), "list_calendar_events", nil)
+	if err != nil || out != "ok" {
+		t.Errorf("ExecuteTool(list_calendar_events) = %q, %v", out, err)
+	}
+}
+
+func TestCalendarToolNames(t *testing.T) {
+	tests := []struct {
+		name    string
+		enabled []string
+		want    []string
+	}{
+		{name: "all tools without built-ins", want: []string{"create_calendar_event", "delete_calendar_event", "list_calendar_events"}},
+		{name: "follows the enabled subset", enabled: []string{"list_calendar_events"}, want: []string{"list_calendar_events"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tb, err := NewFilteredToolBox(newTestToolBox(), tt.enabled)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got := CalendarToolNames(tb); strings.Join(got, ",") != strings.Join(tt.want, ",") {
+				t.Errorf("CalendarToolNames() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"strings"
+	"time"
 
 	zap "go.uber.org/zap"
 	calendar "google.golang.org/api/calendar/v3"
@@ -31,12 +33,17 @@ func NewUpdateCalendarEventTool(logger *zap.Logger, google google.CalendarServic
 		map[string]any{
 			"type": "object",
 			"properties": map[string]any{
+				"clearFields": map[string]any{
+					"description": "Fields to remove from the event, e.g. [\"location\", \"description\"]. Optional.",
+					"items":       map[string]any{"enum": clearableFieldNames, "type": "string"},
+					"type":        "array",
+				},
 				"description": map[string]any{
 					"description": "Event description. Optional.",
 					"type":        "string",
 				},
 				"endTime": map[string]any{
-					"description": "End time in RFC3339 format. Optional.",
+					"description": "End time in RFC3339 format, or without an offset to use the calendar's timezone. Optional.",
 					"type":        "string",
 				},
 				"eventId": map[string]any{
@@ -47,8 +54,12 @@ func NewUpdateCalendarEventTool(logger *zap.Logger, google google.CalendarServic
 					"description": "Event location. Optional.",
 					"type":        "string",
 				},
+				"maxAttendees":      guestLimitSchema,
+				"privateProperties": privatePropertiesSchema,
+				"scope":             scopeSchema,
+				"sharedProperties":  sharedPropertiesSchema,
 				"startTime": map[string]any{
-					"description": "Start time in RFC3339 format. Optional.",
+					"description": "Start time in RFC3339 format, or without an offset to use the calendar's timezone. Optional.",
 					"type":        "string",
 				},
 				"summary": map[string]any{
@@ -73,83 +84,277 @@ func (s *UpdateCalendarEventTool) UpdateCalendarEventHandler(ctx context.Context
 		return "", fmt.Errorf("eventId is required")
 	}
 
+	scope, err := parseEventScope(args)
+	if err != nil {
+		return "", err
+	}
+
+	clear, err := parseClearFields(args)
+	if err != nil {
+		return "", err
+	}
+
 	calendarID := s.google.GetCalendarID()
-	existingEvent, err := s.google.GetEvent(calendarID, eventID)
+	if scope == scopeFollowing {
+		return s.updateFollowing(ctx, calendarID, eventID, args, clear)
+	}
+	loc, tzName := calendarTimezone(ctx, s.google, calendarID)
+
+	existingEvent, err := s.google.GetEvent(ctx, calendarID, eventID)
 	if err != nil {
 		s.logger.Error("failed to get existing calendar event", zap.Error(err), zap.String("eventId", eventID))
-		return "", fmt.Errorf("failed to get existing calendar event: %w", err)
+		return "", fmt.Errorf("failed to get existing calendar event on '%s': %w", calendarLabel(ctx, s.google, calendarID), err)
+	}
+
+	if scope == scopeAll && existingEvent.RecurringEventId != "" {
+		eventID = existingEvent.RecurringEventId
+		existingEvent, err = s.google.GetEvent(ctx, calendarID, eventID)
+		if err != nil {
+			s.logger.Error("failed to get recurring series", zap.Error(err), zap.String("eventId", eventID))
+			return "", fmt.Errorf("failed to get existing calendar event on '%s': %w", calendarLabel(ctx, s.google, calendarID), err)
+		}
+	}
+
+	if err := applyEventUpdates(existingEvent, args, loc, tzName); err != nil {
+		return "", err
+	}
+
+	updatedEvent, err := s.saveEvent(ctx, calendarID, eventID, existingEvent, clear)
+	if err != nil {
+		label := calendarLabel(ctx, s.google, calendarID)
+		s.logger.Error("failed to update calendar event", zap.Error(err), zap.String("eventId", eventID), zap.String("calendar", label))
+		return "", fmt.Errorf("failed to update calendar event on '%s': %w", label, err)
+	}
+
+	return s.updatedEventResult(calendarID, scope, updatedEvent)
+}
+
+// updatedEventResult logs and marshals the response for an updated event.
+func (s *UpdateCalendarEventTool) updatedEventResult(calendarID, scope string, updatedEvent *calendar.Event) (string, error) {
+	s.logger.Info("calendar event updated successfully",
+		zap.String("eventId", updatedEvent.Id),
+		zap.String("summary", updatedEvent.Summary))
+
+	result := map[string]any{
+		"success":   true,
+		"eventId":   updatedEvent.Id,
+		"summary":   updatedEvent.Summary,
+		"startTime": updatedEvent.Start.DateTime,
+		"endTime":   updatedEvent.End.DateTime,
+		"htmlLink":  updatedEvent.HtmlLink,
+		"scope":     scope,
+	}
+
+	if viewLink := viewLinkFor(calendarID, updatedEvent); viewLink != "" {
+		result["viewLink"] = viewLink
+	}
+	if updatedEvent.Description != "" {
+		result["description"] = updatedEvent.Description
+	}
+	if updatedEvent.Location != "" {
+		result["location"] = updatedEvent.Location
+	}
+	if warning := guestLimitWarning(updatedEvent); warning != "" {
+		s.logger.Warn("event exceeds its guest limit", zap.String("eventId", updatedEvent.Id), zap.String("warning", warning))
+		result["warning"] = warning
+	}
+	addExtendedProperties(result, updatedEvent)
+
+	resultJSON, err := json.Marshal(result)
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal result: %w", err)
+	}
+
+	return string(resultJSON), nil
+}
+
+// clearableFields maps the clearFields argument to calendar.Event fields.
+var clearableFields = map[string]string{
+	"attendees":   "Attendees",
+	"description": "Description",
+	"location":    "Location",
+}
+
+var clearableFieldNames = []string{"attendees", "description", "location"}
+
+// parseClearFields reads the optional clearFields argument and returns the
+// calendar.Event field names to null out. A field cannot be both set and
+// cleared in the same call.
+func parseClearFields(args map[string]any) ([]string, error) {
+	v, exists := args["clearFields"]
+	if !exists || v == nil {
+		return nil, nil
+	}
+	list, ok := v.([]any)
+	if !ok {
+		return nil, fmt.Errorf("clearFields must be an array, got %T", v)
+	}
+	var fields []string
+	for _, item := range list {
+		name, ok := item.(string)
+		if !ok {
+			return nil, fmt.Errorf("clearFields must contain strings, got %T", item)
+		}
+		field, ok := clearableFields[name]
+		if !ok {
+			return nil, fmt.Errorf("clearFields entries must be one of %s, got %q", strings.Join(clearableFieldNames, ", "), name)
+		}
+		if value, set := args[name]; set && value != nil {
+			return nil, fmt.Errorf("%s cannot be both set and cleared", name)
+		}
+		fields = append(fields, field)
+	}
+	return fields, nil
+}
+
+// clearEventFields empties the given calendar.Event fields.
+func clearEventFields(event *calendar.Event, fields []string) {
+	for _, field := range fields {
+		switch field {
+		case "Attendees":
+			event.Attendees = nil
+		case "Description":
+			event.Description = ""
+		case "Location":
+			event.Location = ""
+		}
 	}
+}
+
+// saveEvent writes event back to Google. Clearing fields goes through
+// Events.Patch with NullFields, since empty values are omitted from the
+// request body and would not reliably remove the stored value.
+func (s *UpdateCalendarEventTool) saveEvent(ctx context.Context, calendarID, eventID string, event *calendar.Event, clear []string) (*calendar.Event, error) {
+	if len(clear) == 0 {
+		return s.google.UpdateEvent(ctx, calendarID, eventID, event)
+	}
+	clearEventFields(event, clear)
+	event.NullFields = append(event.NullFields, clear...)
+	return s.google.PatchEvent(ctx, calendarID, eventID, event)
+}
 
+// applyEventUpdates copies the optional update arguments onto event. Naive
+// start and end times are read in loc, the calendar's timezone.
+func applyEventUpdates(event *calendar.Event, args map[string]any, loc *time.Location, tzName string) error {
 	if v, exists := args["summary"]; exists && v != nil {
 		s, ok := v.(string)
 		if !ok {
-			return "", fmt.Errorf("summary must be a string, got %T", v)
+			return fmt.Errorf("summary must be a string, got %T", v)
 		}
-		existingEvent.Summary = s
+		summary, err := decorateTitle(s)
+		if err != nil {
+			return err
+		}
+		event.Summary = summary
 	}
 
 	if v, exists := args["description"]; exists && v != nil {
 		s, ok := v.(string)
 		if !ok {
-			return "", fmt.Errorf("description must be a string, got %T", v)
+			return fmt.Errorf("description must be a string, got %T", v)
 		}
-		existingEvent.Description = s
+		event.Description = s
 	}
 
 	if v, exists := args["location"]; exists && v != nil {
 		s, ok := v.(string)
 		if !ok {
-			return "", fmt.Errorf("location must be a string, got %T", v)
+			return fmt.Errorf("location must be a string, got %T", v)
 		}
-		existingEvent.Location = s
+		event.Location = s
 	}
 
 	if v, exists := args["startTime"]; exists && v != nil {
 		s, ok := v.(string)
 		if !ok {
-			return "", fmt.Errorf("startTime must be a string, got %T", v)
+			return fmt.Errorf("startTime must be a string, got %T", v)
+		}
+		start, err := eventDateTime(s, loc, tzName)
+		if err != nil {
+			return fmt.Errorf("invalid startTime format (expected RFC3339): %w", err)
 		}
-		existingEvent.Start = &calendar.EventDateTime{DateTime: s}
+		event.Start = start
 	}
 
 	if v, exists := args["endTime"]; exists && v != nil {
 		s, ok := v.(string)
 		if !ok {
-			return "", fmt.Errorf("endTime must be a string, got %T", v)
+			return fmt.Errorf("endTime must be a string, got %T", v)
+		}
+		end, err := eventDateTime(s, loc, tzName)
+		if err != nil {
+			return fmt.Errorf("invalid endTime format (expected RFC3339): %w", err)
 		}
-		existingEvent.End = &calendar.EventDateTime{DateTime: s}
+		event.End = end
 	}
 
-	updatedEvent, err := s.google.UpdateEvent(calendarID, eventID, existingEvent)
-	if err != nil {
-		s.logger.Error("failed to update calendar event", zap.Error(err), zap.String("eventId", eventID))
-		return "", fmt.Errorf("failed to update calendar event: %w", err)
+	if err := applyExtendedProperties(event, args); err != nil {
+		return err
 	}
 
-	s.logger.Info("calendar event updated successfully",
-		zap.String("eventId", updatedEvent.Id),
-		zap.String("summary", updatedEvent.Summary))
+	if err := applyGuestLimit(event, args); err != nil {
+		return err
+	}
 
-	result := map[string]any{
-		"success":   true,
-		"eventId":   updatedEvent.Id,
-		"summary":   updatedEvent.Summary,
-		"startTime": updatedEvent.Start.DateTime,
-		"endTime":   updatedEvent.End.DateTime,
-		"htmlLink":  updatedEvent.HtmlLink,
+	_, hasStart := args["startTime"]
+	_, hasEnd := args["endTime"]
+	if hasStart || hasEnd {
+		return validateEventRange(event.Start, event.End)
 	}
+	return nil
+}
 
-	if updatedEvent.Description != "" {
-		result["description"] = updatedEvent.Description
+// updateFollowing splits a recurring series at eventID: the original series
+// is ended just before the occurrence and a new series carrying the changes
+// starts at it. Updating from the first occurrence updates the whole series.
+func (s *UpdateCalendarEventTool) updateFollowing(ctx context.Context, calendarID, eventID string, args map[string]any, clear []string) (string, error) {
+	loc, tzName := calendarTimezone(ctx, s.google, calendarID)
+	occurrence, err := getSeriesOccurrence(ctx, s.google, calendarID, eventID)
+	if err != nil {
+		s.logger.Error("failed to get recurring series", zap.Error(err), zap.String("eventId", eventID))
+		return "", fmt.Errorf("failed to get existing calendar event on '%s': %w", calendarLabel(ctx, s.google, calendarID), err)
 	}
-	if updatedEvent.Location != "" {
-		result["location"] = updatedEvent.Location
+	master := occurrence.master
+
+	if occurrence.isFirst() {
+		if err := applyEventUpdates(master, args, loc, tzName); err != nil {
+			return "", err
+		}
+		updatedEvent, err := s.saveEvent(ctx, calendarID, master.Id, master, clear)
+		if err != nil {
+			label := calendarLabel(ctx, s.google, calendarID)
+			s.logger.Error("failed to update calendar event", zap.Error(err), zap.String("eventId", master.Id), zap.String("calendar", label))
+			return "", fmt.Errorf("failed to update calendar event on '%s': %w", label, err)
+		}
+		return s.updatedEventResult(calendarID, scopeFollowing, updatedEvent)
 	}
 
-	resultJSON, err := json.Marshal(result)
+	truncated, err := truncateRecurrence(master.Recurrence, occurrence.instance.OriginalStartTime)
 	if err != nil {
-		return "", fmt.Errorf("failed to marshal result: %w", err)
+		return "", err
+	}
+	series, err := continueSeries(master, occurrence.instance.OriginalStartTime, loc)
+	if err != nil {
+		return "", err
+	}
+	if err := applyEventUpdates(series, args, loc, tzName); err != nil {
+		return "", err
 	}
+	clearEventFields(series, clear)
 
-	return string(resultJSON), nil
+	createdEvent, err := s.google.CreateEvent(ctx, calendarID, series)
+	if err != nil {
+		label := calendarLabel(ctx, s.google, calendarID)
+		s.logger.Error("failed to create following series", zap.Error(err), zap.String("eventId", eventID), zap.String("calendar", label))
+		return "", fmt.Errorf("failed to update calendar event on '%s': %w", label, err)
+	}
+
+	master.Recurrence = truncated
+	if _, err := s.google.UpdateEvent(ctx, calendarID, master.Id, master); err != nil {
+		label := calendarLabel(ctx, s.google, calendarID)
+		s.logger.Error("failed to end original series", zap.Error(err), zap.String("eventId", master.Id), zap.String("newEventId", createdEvent.Id), zap.String("calendar", label))
+		return "", fmt.Errorf("failed to end original series on '%s' after creating %s: %w", label, createdEvent.Id, err)
+	}
+
+	return s.updatedEventResult(calendarID, scopeFollowing, createdEvent)
 }
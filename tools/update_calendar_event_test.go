@@ -19,65 +19,137 @@ import (
 type stubCalendarService struct {
 	getEventFn       func(calendarID, eventID string) (*calendar.Event, error)
 	updateEventFn    func(calendarID, eventID string, event *calendar.Event) (*calendar.Event, error)
+	patchEventFn     func(calendarID, eventID string, event *calendar.Event) (*calendar.Event, error)
 	createEventFn    func(calendarID string, event *calendar.Event) (*calendar.Event, error)
 	deleteEventFn    func(calendarID, eventID string) error
 	listEventsFn     func(calendarID string, timeMin, timeMax time.Time) ([]*calendar.Event, error)
+	listByPropertyFn func(calendarID, property string, shared bool, timeMin, timeMax time.Time) ([]*calendar.Event, error)
+	listByTypeFn     func(calendarID string, eventTypes []string, timeMin, timeMax time.Time) ([]*calendar.Event, error)
+	listPageFn       func(calendarID string, timeMin, timeMax time.Time, pageSize int64, pageToken string) ([]*calendar.Event, string, error)
+	listSeriesFn     func(calendarID string, timeMin, timeMax time.Time) ([]*calendar.Event, error)
 	checkConflictsFn func(calendarID string, startTime, endTime time.Time) ([]*calendar.Event, error)
+	freeBusyFn       func(calendarIDs []string, timeMin, timeMax time.Time) (map[string]calendar.FreeBusyCalendar, error)
 	listCalendarsFn  func() ([]*calendar.CalendarListEntry, error)
+	getColorsFn      func() (*calendar.Colors, error)
+	getCalendarFn    func(calendarID string) (*calendar.Calendar, error)
+	getSettingsFn    func() (string, string, error)
 	calendarID       string
 }
 
 var _ google.CalendarService = (*stubCalendarService)(nil)
 
-func (s *stubCalendarService) ListEvents(calendarID string, timeMin, timeMax time.Time) ([]*calendar.Event, error) {
+func (s *stubCalendarService) ListEvents(ctx context.Context, calendarID string, timeMin, timeMax time.Time) ([]*calendar.Event, error) {
 	if s.listEventsFn == nil {
 		return nil, errors.New("ListEvents unexpectedly called")
 	}
 	return s.listEventsFn(calendarID, timeMin, timeMax)
 }
 
-func (s *stubCalendarService) CreateEvent(calendarID string, event *calendar.Event) (*calendar.Event, error) {
+func (s *stubCalendarService) ListEventsByProperty(ctx context.Context, calendarID, property string, shared bool, timeMin, timeMax time.Time) ([]*calendar.Event, error) {
+	if s.listByPropertyFn == nil {
+		return nil, errors.New("ListEventsByProperty unexpectedly called")
+	}
+	return s.listByPropertyFn(calendarID, property, shared, timeMin, timeMax)
+}
+
+func (s *stubCalendarService) ListEventsPage(ctx context.Context, calendarID string, timeMin, timeMax time.Time, pageSize int64, pageToken string) ([]*calendar.Event, string, error) {
+	if s.listPageFn == nil {
+		return nil, "", errors.New("ListEventsPage unexpectedly called")
+	}
+	return s.listPageFn(calendarID, timeMin, timeMax, pageSize, pageToken)
+}
+
+func (s *stubCalendarService) ListEventsByType(ctx context.Context, calendarID string, eventTypes []string, timeMin, timeMax time.Time) ([]*calendar.Event, error) {
+	if s.listByTypeFn == nil {
+		return nil, errors.New("ListEventsByType unexpectedly called")
+	}
+	return s.listByTypeFn(calendarID, eventTypes, timeMin, timeMax)
+}
+
+func (s *stubCalendarService) ListRecurringSeries(ctx context.Context, calendarID string, timeMin, timeMax time.Time) ([]*calendar.Event, error) {
+	if s.listSeriesFn == nil {
+		return nil, errors.New("ListRecurringSeries unexpectedly called")
+	}
+	return s.listSeriesFn(calendarID, timeMin, timeMax)
+}
+
+func (s *stubCalendarService) CreateEvent(ctx context.Context, calendarID string, event *calendar.Event) (*calendar.Event, error) {
 	if s.createEventFn == nil {
 		return nil, errors.New("CreateEvent unexpectedly called")
 	}
 	return s.createEventFn(calendarID, event)
 }
 
-func (s *stubCalendarService) UpdateEvent(calendarID, eventID string, event *calendar.Event) (*calendar.Event, error) {
+func (s *stubCalendarService) UpdateEvent(ctx context.Context, calendarID, eventID string, event *calendar.Event) (*calendar.Event, error) {
 	if s.updateEventFn == nil {
 		return nil, errors.New("UpdateEvent unexpectedly called")
 	}
 	return s.updateEventFn(calendarID, eventID, event)
 }
 
-func (s *stubCalendarService) DeleteEvent(calendarID, eventID string) error {
+func (s *stubCalendarService) PatchEvent(ctx context.Context, calendarID, eventID string, event *calendar.Event) (*calendar.Event, error) {
+	if s.patchEventFn == nil {
+		return nil, errors.New("PatchEvent unexpectedly called")
+	}
+	return s.patchEventFn(calendarID, eventID, event)
+}
+
+func (s *stubCalendarService) DeleteEvent(ctx context.Context, calendarID, eventID string) error {
 	if s.deleteEventFn == nil {
 		return errors.New("DeleteEvent unexpectedly called")
 	}
 	return s.deleteEventFn(calendarID, eventID)
 }
 
-func (s *stubCalendarService) GetEvent(calendarID, eventID string) (*calendar.Event, error) {
+func (s *stubCalendarService) GetEvent(ctx context.Context, calendarID, eventID string) (*calendar.Event, error) {
 	if s.getEventFn == nil {
 		return nil, errors.New("GetEvent unexpectedly called")
 	}
 	return s.getEventFn(calendarID, eventID)
 }
 
-func (s *stubCalendarService) ListCalendars() ([]*calendar.CalendarListEntry, error) {
+func (s *stubCalendarService) ListCalendars(ctx context.Context) ([]*calendar.CalendarListEntry, error) {
 	if s.listCalendarsFn == nil {
 		return nil, errors.New("ListCalendars unexpectedly called")
 	}
 	return s.listCalendarsFn()
 }
 
-func (s *stubCalendarService) CheckConflicts(calendarID string, startTime, endTime time.Time) ([]*calendar.Event, error) {
+func (s *stubCalendarService) CheckConflicts(ctx context.Context, calendarID string, startTime, endTime time.Time) ([]*calendar.Event, error) {
 	if s.checkConflictsFn == nil {
 		return nil, errors.New("CheckConflicts unexpectedly called")
 	}
 	return s.checkConflictsFn(calendarID, startTime, endTime)
 }
 
+func (s *stubCalendarService) QueryFreeBusy(ctx context.Context, calendarIDs []string, timeMin, timeMax time.Time) (map[string]calendar.FreeBusyCalendar, error) {
+	if s.freeBusyFn == nil {
+		return nil, errors.New("QueryFreeBusy unexpectedly called")
+	}
+	return s.freeBusyFn(calendarIDs, timeMin, timeMax)
+}
+
+func (s *stubCalendarService) GetColors(ctx context.Context) (*calendar.Colors, error) {
+	if s.getColorsFn == nil {
+		return nil, errors.New("GetColors unexpectedly called")
+	}
+	return s.getColorsFn()
+}
+
+func (s *stubCalendarService) GetCalendar(ctx context.Context, calendarID string) (*calendar.Calendar, error) {
+	if s.getCalendarFn == nil {
+		return nil, errors.New("GetCalendar unexpectedly called")
+	}
+	return s.getCalendarFn(calendarID)
+}
+
+func (s *stubCalendarService) GetCalendarSettings(ctx context.Context) (string, string, error) {
+	if s.getSettingsFn == nil {
+		return "", "", errors.New("GetCalendarSettings unexpectedly called")
+	}
+	return s.getSettingsFn()
+}
+
 func (s *stubCalendarService) GetCalendarID() string {
 	if s.calendarID == "" {
 		return "primary"
@@ -85,6 +157,10 @@ func (s *stubCalendarService) GetCalendarID() string {
 	return s.calendarID
 }
 
+func (s *stubCalendarService) SetCalendarID(calendarID string) {
+	s.calendarID = calendarID
+}
+
 func TestUpdateCalendarEventHandler(t *testing.T) {
 	baseEvent := func() *calendar.Event {
 		return &calendar.Event{
@@ -199,6 +275,31 @@ func TestUpdateCalendarEventHandler(t *testing.T) {
 			wantErr:    true,
 			wantErrSub: "failed to get existing calendar event",
 		},
+		{
+			name: "endTime equal to startTime returns error",
+			args: map[string]any{
+				"eventId":   "evt-1",
+				"startTime": "2026-05-23T12:00:00Z",
+				"endTime":   "2026-05-23T12:00:00Z",
+			},
+			getEventFn: func(calendarID, eventID string) (*calendar.Event, error) {
+				return baseEvent(), nil
+			},
+			wantErr:    true,
+			wantErrSub: "must be after startTime",
+		},
+		{
+			name: "moving startTime past the existing end returns error",
+			args: map[string]any{
+				"eventId":   "evt-1",
+				"startTime": "2026-05-23T12:00:00Z",
+			},
+			getEventFn: func(calendarID, eventID string) (*calendar.Event, error) {
+				return baseEvent(), nil
+			},
+			wantErr:    true,
+			wantErrSub: "must be after startTime",
+		},
 		{
 			name: "UpdateEvent error is wrapped and returned",
 			args: map[string]any{
@@ -257,3 +358,200 @@ func TestUpdateCalendarEventHandler(t *testing.T) {
 		})
 	}
 }
+
+func TestUpdateCalendarEventScope(t *testing.T) {
+	tests := []struct {
+		name           string
+		scope          string
+		wantUpdated    string
+		wantCreated    bool
+		wantRecurrence []string
+		wantEventID    string
+	}{
+		{
+			name:        "instance updates the occurrence",
+			scope:       "instance",
+			wantUpdated: "standup_20260518T090000Z",
+			wantEventID: "standup_20260518T090000Z",
+		},
+		{
+			name:        "all updates the recurring master",
+			scope:       "all",
+			wantUpdated: "standup",
+			wantEventID: "standup",
+		},
+		{
+			name:           "following splits the series",
+			scope:          "following",
+			wantUpdated:    "standup",
+			wantCreated:    true,
+			wantRecurrence: []string{"RRULE:FREQ=WEEKLY;UNTIL=20260518T085959Z", "EXDATE:20260511T090000Z"},
+			wantEventID:    "standup-following",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			master, instance := weeklySeries()
+			var updatedID string
+			var updated, created *calendar.Event
+			stub := &stubCalendarService{
+				getEventFn: func(calendarID, eventID string) (*calendar.Event, error) {
+					if eventID == master.Id {
+						return master, nil
+					}
+					return instance, nil
+				},
+				updateEventFn: func(calendarID, eventID string, event *calendar.Event) (*calendar.Event, error) {
+					updatedID = eventID
+					updated = event
+					return event, nil
+				},
+				createEventFn: func(calendarID string, event *calendar.Event) (*calendar.Event, error) {
+					created = event
+					copied := *event
+					copied.Id = "standup-following"
+					return &copied, nil
+				},
+			}
+			tool := &UpdateCalendarEventTool{logger: zap.NewNop(), google: stub}
+			result, err := tool.UpdateCalendarEventHandler(context.Background(), map[string]any{
+				"eventId": instance.Id,
+				"scope":   tc.scope,
+				"summary": "Team sync",
+			})
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+
+			var parsed map[string]any
+			if err := json.Unmarshal([]byte(result), &parsed); err != nil {
+				t.Fatalf("failed to unmarshal result: %v", err)
+			}
+			if parsed["eventId"] != tc.wantEventID {
+				t.Errorf("eventId = %v, want %v", parsed["eventId"], tc.wantEventID)
+			}
+			if parsed["summary"] != "Team sync" {
+				t.Errorf("summary = %v, want Team sync", parsed["summary"])
+			}
+			if updatedID != tc.wantUpdated {
+				t.Errorf("updated event = %q, want %q", updatedID, tc.wantUpdated)
+			}
+			if (created != nil) != tc.wantCreated {
+				t.Fatalf("created new series = %v, want %v", created != nil, tc.wantCreated)
+			}
+			if !tc.wantCreated {
+				return
+			}
+			if created.Start.DateTime != instance.Start.DateTime {
+				t.Errorf("new series starts %s, want %s", created.Start.DateTime, instance.Start.DateTime)
+			}
+			// The original keeps May 4 and the excluded May 11 of its ten.
+			if strings.Join(created.Recurrence, "\n") != "RRULE:FREQ=WEEKLY;COUNT=8\nEXDATE:20260511T090000Z" {
+				t.Errorf("new series recurrence = %v, want the remaining 8 of the original 10", created.Recurrence)
+			}
+			if updated.Summary != "Standup" {
+				t.Errorf("original series summary = %q, want it unchanged", updated.Summary)
+			}
+			if strings.Join(updated.Recurrence, "\n") != strings.Join(tc.wantRecurrence, "\n") {
+				t.Errorf("original series recurrence = %v, want %v", updated.Recurrence, tc.wantRecurrence)
+			}
+		})
+	}
+}
+
+func TestUpdateCalendarEventClearFields(t *testing.T) {
+	existing := func() *calendar.Event {
+		return &calendar.Event{
+			Id:          "evt-1",
+			Summary:     "Review",
+			Description: "Agenda",
+			Location:    "Room 1",
+			Start:       &calendar.EventDateTime{DateTime: "2026-05-23T10:00:00Z"},
+			End:         &calendar.EventDateTime{DateTime: "2026-05-23T11:00:00Z"},
+		}
+	}
+
+	tests := []struct {
+		name           string
+		args           map[string]any
+		wantErrSub     string
+		wantNullFields []string
+		wantLocation   string
+		wantDesc       string
+	}{
+		{
+			name:           "clearing location patches it to null",
+			args:           map[string]any{"eventId": "evt-1", "clearFields": []any{"location"}},
+			wantNullFields: []string{"Location"},
+			wantDesc:       "Agenda",
+		},
+		{
+			name:           "clearing several fields alongside an update",
+			args:           map[string]any{"eventId": "evt-1", "summary": "Retro", "clearFields": []any{"location", "description"}},
+			wantNullFields: []string{"Location", "Description"},
+		},
+		{
+			name:       "unknown field is rejected",
+			args:       map[string]any{"eventId": "evt-1", "clearFields": []any{"summary"}},
+			wantErrSub: "clearFields entries must be one of",
+		},
+		{
+			name:       "setting and clearing the same field is rejected",
+			args:       map[string]any{"eventId": "evt-1", "location": "Room 2", "clearFields": []any{"location"}},
+			wantErrSub: "location cannot be both set and cleared",
+		},
+		{
+			name:       "wrong-typed clearFields is rejected",
+			args:       map[string]any{"eventId": "evt-1", "clearFields": "location"},
+			wantErrSub: "clearFields must be an array",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var patched *calendar.Event
+			stub := &stubCalendarService{
+				getEventFn: func(calendarID, eventID string) (*calendar.Event, error) {
+					return existing(), nil
+				},
+				patchEventFn: func(calendarID, eventID string, event *calendar.Event) (*calendar.Event, error) {
+					patched = event
+					return event, nil
+				},
+			}
+			tool := &UpdateCalendarEventTool{logger: zap.NewNop(), google: stub}
+			result, err := tool.UpdateCalendarEventHandler(context.Background(), tc.args)
+
+			if tc.wantErrSub != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErrSub) {
+					t.Fatalf("error = %v, want substring %q", err, tc.wantErrSub)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if patched == nil {
+				t.Fatal("PatchEvent was not called")
+			}
+			if strings.Join(patched.NullFields, ",") != strings.Join(tc.wantNullFields, ",") {
+				t.Errorf("NullFields = %v, want %v", patched.NullFields, tc.wantNullFields)
+			}
+			if patched.Location != tc.wantLocation {
+				t.Errorf("location = %q, want %q", patched.Location, tc.wantLocation)
+			}
+			if patched.Description != tc.wantDesc {
+				t.Errorf("description = %q, want %q", patched.Description, tc.wantDesc)
+			}
+
+			var parsed map[string]any
+			if err := json.Unmarshal([]byte(result), &parsed); err != nil {
+				t.Fatalf("failed to unmarshal result: %v", err)
+			}
+			if _, ok := parsed["location"]; ok {
+				t.Errorf("result still reports location %v", parsed["location"])
+			}
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package tools
+
+import (
+	"context"
+	"time"
+
+	calendar "google.golang.org/api/calendar/v3"
+
+	google "github.com/inference-gateway/google-calendar-agent/internal/google"
+)
+
+// verifyWriteAttempts is how many times a created event is looked for in
+// listings before GOOGLE_CALENDAR_VERIFY_WRITES gives up on it.
+const verifyWriteAttempts = 4
+
+// verifyWriteBackoff is the wait before the first re-read; it doubles on
+// each further attempt. Tests shorten it.
+var verifyWriteBackoff = 250 * time.Millisecond
+
+// awaitEventListed lists the created event's time range until the event
+// shows up, re-reading with backoff because Google's listings can lag
+// behind a write. Failed reads count as misses. It reports whether the
+// event was seen before the attempts ran out or ctx was done.
+func awaitEventListed(ctx context.Context, svc google.CalendarService, calendarID string, event *calendar.Event, loc *time.Location) bool {
+	start, end, ok := eventTimes(event, loc)
+	if !ok {
+		return false
+	}
+	wait := verifyWriteBackoff
+	for attempt := 1; ; attempt++ {
+		events, err := svc.ListEvents(ctx, calendarID, start, end)
+		if err == nil {
+			for _, listed := range events {
+				if listed.Id == event.Id {
+					return true
+				}
+			}
+		}
+		if attempt == verifyWriteAttempts {
+			return false
+		}
+		select {
+		case <-ctx.Done():
+			return false
+		case <-time.After(wait):
+		}
+		wait *= 2
+	}
+}
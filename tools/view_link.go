@@ -0,0 +1,36 @@
+package tools
+
+import (
+	"encoding/base64"
+
+	calendar "google.golang.org/api/calendar/v3"
+)
+
+// calendarEventURL is the Google Calendar page that opens a single event.
+const calendarEventURL = "https://calendar.google.com/calendar/event?eid="
+
+// eventViewLink builds a short deep link to an event. Google identifies
+// the event by eid, the unpadded base64 of "<eventId> <calendarId>".
+func eventViewLink(calendarID, eventID string) string {
+	if calendarID == "" || eventID == "" {
+		return ""
+	}
+	eid := base64.RawURLEncoding.EncodeToString([]byte(eventID + " " + calendarID))
+	return calendarEventURL + eid
+}
+
+// viewLinkFor returns the deep link for event on calendarID. The "primary"
+// alias is not valid inside an eid, so it is replaced by the organizer's
+// address when the organizer is the calendar owner.
+func viewLinkFor(calendarID string, event *calendar.Event) string {
+	if event == nil {
+		return ""
+	}
+	if calendarID == "primary" {
+		if event.Organizer == nil || !event.Organizer.Self || event.Organizer.Email == "" {
+			return ""
+		}
+		calendarID = event.Organizer.Email
+	}
+	return eventViewLink(calendarID, event.Id)
+}
@@ -0,0 +1,81 @@
+package tools
+
+import (
+	"testing"
+
+	calendar "google.golang.org/api/calendar/v3"
+)
+
+func TestEventViewLink(t *testing.T) {
+	tests := []struct {
+		name       string
+		calendarID string
+		eventID    string
+		want       string
+	}{
+		{
+			name:       "user calendar",
+			calendarID: "alice@example.com",
+			eventID:    "abc123",
+			want:       "https://calendar.google.com/calendar/event?eid=YWJjMTIzIGFsaWNlQGV4YW1wbGUuY29t",
+		},
+		{
+			name:       "recurring instance on a group calendar",
+			calendarID: "team@group.calendar.google.com",
+			eventID:    "7kq1r0t9h3u2b5c6d8e9f0g1h2_20260523T100000Z",
+			want:       "https://calendar.google.com/calendar/event?eid=N2txMXIwdDloM3UyYjVjNmQ4ZTlmMGcxaDJfMjAyNjA1MjNUMTAwMDAwWiB0ZWFtQGdyb3VwLmNhbGVuZGFyLmdvb2dsZS5jb20",
+		},
+		{
+			name:       "missing event ID",
+			calendarID: "alice@example.com",
+			want:       "",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := eventViewLink(tt.calendarID, tt.eventID); got != tt.want {
+				t.Errorf("eventViewLink() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestViewLinkFor(t *testing.T) {
+	owned := &calendar.Event{Id: "abc123", Organizer: &calendar.EventOrganizer{Email: "alice@example.com", Self: true}}
+	invited := &calendar.Event{Id: "abc123", Organizer: &calendar.EventOrganizer{Email: "bob@example.com"}}
+
+	tests := []struct {
+		name       string
+		calendarID string
+		event      *calendar.Event
+		want       string
+	}{
+		{
+			name:       "primary resolves to the owner's address",
+			calendarID: "primary",
+			event:      owned,
+			want:       eventViewLink("alice@example.com", "abc123"),
+		},
+		{
+			name:       "primary without a self organizer has no link",
+			calendarID: "primary",
+			event:      invited,
+			want:       "",
+		},
+		{
+			name:       "explicit calendar ID is used as is",
+			calendarID: "alice@example.com",
+			event:      invited,
+			want:       eventViewLink("alice@example.com", "abc123"),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := viewLinkFor(tt.calendarID, tt.event); got != tt.want {
+				t.Errorf("viewLinkFor() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
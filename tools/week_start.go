@@ -0,0 +1,37 @@
+package tools
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// loadWeekStart reads GOOGLE_CALENDAR_WEEK_START.
+func loadWeekStart() (time.Weekday, error) {
+	cfg, err := loadCalendarSettings()
+	if err != nil {
+		return time.Monday, err
+	}
+	return parseWeekStart(cfg.WeekStart)
+}
+
+// parseWeekStart accepts "sunday" or "monday", ignoring case.
+func parseWeekStart(value string) (time.Weekday, error) {
+	switch strings.ToLower(strings.TrimSpace(value)) {
+	case "sunday":
+		return time.Sunday, nil
+	case "monday":
+		return time.Monday, nil
+	default:
+		return time.Monday, fmt.Errorf("invalid week start %q (expected sunday or monday)", value)
+	}
+}
+
+// weekBounds returns the midnight start and exclusive end of the week
+// containing t in loc, with weeks beginning on first.
+func weekBounds(t time.Time, loc *time.Location, first time.Weekday) (time.Time, time.Time) {
+	t = t.In(loc)
+	offset := (int(t.Weekday()) - int(first) + 7) % 7
+	start := time.Date(t.Year(), t.Month(), t.Day()-offset, 0, 0, 0, 0, loc)
+	return start, start.AddDate(0, 0, 7)
+}
@@ -0,0 +1,74 @@
+package tools
+
+import (
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestWeekBounds(t *testing.T) {
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Skipf("tzdata unavailable: %v", err)
+	}
+
+	tests := []struct {
+		name      string
+		t         time.Time
+		first     time.Weekday
+		wantStart string
+	}{
+		{"monday: midweek", time.Date(2026, 5, 20, 15, 0, 0, 0, time.UTC), time.Monday, "2026-05-18T00:00:00+02:00"},
+		{"monday: sunday belongs to the week before", time.Date(2026, 5, 24, 12, 0, 0, 0, time.UTC), time.Monday, "2026-05-18T00:00:00+02:00"},
+		{"monday: monday morning in Berlin is still Sunday in UTC", time.Date(2026, 5, 24, 22, 30, 0, 0, time.UTC), time.Monday, "2026-05-25T00:00:00+02:00"},
+		{"sunday: midweek", time.Date(2026, 5, 20, 15, 0, 0, 0, time.UTC), time.Sunday, "2026-05-17T00:00:00+02:00"},
+		{"sunday: sunday starts a new week", time.Date(2026, 5, 24, 12, 0, 0, 0, time.UTC), time.Sunday, "2026-05-24T00:00:00+02:00"},
+		{"sunday: saturday closes the week", time.Date(2026, 5, 23, 12, 0, 0, 0, time.UTC), time.Sunday, "2026-05-17T00:00:00+02:00"},
+		{"sunday: sunday in Berlin is still Saturday in UTC", time.Date(2026, 5, 23, 22, 30, 0, 0, time.UTC), time.Sunday, "2026-05-24T00:00:00+02:00"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			start, end := weekBounds(tc.t, berlin, tc.first)
+			if got := start.Format(time.RFC3339); got != tc.wantStart {
+				t.Errorf("start = %s, want %s", got, tc.wantStart)
+			}
+			if start.Weekday() != tc.first {
+				t.Errorf("week starts on %s, want %s", start.Weekday(), tc.first)
+			}
+			if got := end.Sub(start); got != 7*24*time.Hour {
+				t.Errorf("week length = %v, want 7 days", got)
+			}
+		})
+	}
+}
+
+func TestParseWeekStart(t *testing.T) {
+	tests := []struct {
+		value      string
+		want       time.Weekday
+		wantErrSub string
+	}{
+		{value: "monday", want: time.Monday},
+		{value: "Sunday", want: time.Sunday},
+		{value: " SUNDAY ", want: time.Sunday},
+		{value: "saturday", wantErrSub: "expected sunday or monday"},
+		{value: "", wantErrSub: "invalid week start"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.value, func(t *testing.T) {
+			got, err := parseWeekStart(tc.value)
+			if tc.wantErrSub != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErrSub) {
+					t.Fatalf("error = %v, want substring %q", err, tc.wantErrSub)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tc.want {
+				t.Errorf("parseWeekStart(%q) = %s, want %s", tc.value, got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,93 @@
+package tools
+
+import (
+	"fmt"
+	"time"
+)
+
+// workingHours is the daily window, as offsets from local midnight, in
+// which tools may place events on the user's behalf.
+type workingHours struct {
+	start time.Duration
+	end   time.Duration
+}
+
+// loadWorkingHours reads GOOGLE_CALENDAR_WORKING_HOURS_START/END.
+func loadWorkingHours() (workingHours, error) {
+	cfg, err := loadCalendarSettings()
+	if err != nil {
+		return workingHours{}, err
+	}
+	return parseWorkingHours(cfg.WorkingHoursStart, cfg.WorkingHoursEnd)
+}
+
+// parseWorkingHours parses an HH:MM start and end. The end must be after
+// the start.
+func parseWorkingHours(start, end string) (workingHours, error) {
+	s, err := parseClock(start)
+	if err != nil {
+		return workingHours{}, fmt.Errorf("invalid working hours start %q: %w", start, err)
+	}
+	e, err := parseClock(end)
+	if err != nil {
+		return workingHours{}, fmt.Errorf("invalid working hours end %q: %w", end, err)
+	}
+	if e <= s {
+		return workingHours{}, fmt.Errorf("working hours end %s must be after start %s", end, start)
+	}
+	return workingHours{start: s, end: e}, nil
+}
+
+// parseClock parses HH:MM into an offset from midnight.
+func parseClock(value string) (time.Duration, error) {
+	t, err := time.Parse("15:04", value)
+	if err != nil {
+		return 0, err
+	}
+	return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute, nil
+}
+
+// window returns the working hours of the day containing day, in day's
+// location. ok is false on weekends.
+func (w workingHours) window(day time.Time) (start, end time.Time, ok bool) {
+	if wd := day.Weekday(); wd == time.Saturday || wd == time.Sunday {
+		return time.Time{}, time.Time{}, false
+	}
+	midnight := time.Date(day.Year(), day.Month(), day.Day(), 0, 0, 0, 0, day.Location())
+	return midnight.Add(w.start), midnight.Add(w.end), true
+}
+
+// nextFreeSlot returns the earliest start at or after from such that
+// [start, start+duration) lies within working hours, ends by until, and
+// does not overlap any of the sorted busy periods.
+func (w workingHours) nextFreeSlot(busy []timeSlot, from, until time.Time, duration time.Duration, loc *time.Location) (time.Time, bool) {
+	busy = mergeBusyPeriods(busy)
+	from = from.In(loc)
+	first := time.Date(from.Year(), from.Month(), from.Day(), 0, 0, 0, 0, loc)
+	for day := first; day.Before(until); day = day.AddDate(0, 0, 1) {
+		dayStart, dayEnd, ok := w.window(day)
+		if !ok {
+			continue
+		}
+		candidate := dayStart
+		if from.After(candidate) {
+			candidate = from
+		}
+		if until.Before(dayEnd) {
+			dayEnd = until
+		}
+		for _, b := range busy {
+			if !b.endTime.After(candidate) {
+				continue
+			}
+			if !b.startTime.Before(candidate.Add(duration)) {
+				break
+			}
+			candidate = b.endTime.In(loc)
+		}
+		if !candidate.Add(duration).After(dayEnd) {
+			return candidate, true
+		}
+	}
+	return time.Time{}, false
+}